@@ -0,0 +1,143 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Description describes a short Weierstrass curve Y² = X³ + aX + b over 𝔽p, and its subgroup of
+// prime order r.
+//
+// The integers are given as strings, in base 10 or in hexadecimal with the 0x prefix.
+type Description struct {
+	Name string `json:"name" yaml:"name"` // name of the generated package
+	Fp   string `json:"fp" yaml:"fp"`     // modulus p of the base field
+	Fr   string `json:"fr" yaml:"fr"`     // modulus r of the scalar field, the order of the subgroup
+	A    string `json:"a" yaml:"a"`       // defaults to 0
+	B    string `json:"b" yaml:"b"`
+
+	// Cofactor h is such that #E(𝔽p) = h⋅r; defaults to 1 (prime order curve).
+	Cofactor string `json:"cofactor,omitempty" yaml:"cofactor,omitempty"`
+
+	G1 *PointDescription `json:"g1" yaml:"g1"` // generator of the subgroup of order r
+
+	// GLV is used for the scalar multiplication when the curve has an endomorphism
+	// (x,y) → (ωx,y) (a = 0 and p ≡ 1 mod 3); the constants are computed when omitted.
+	GLV *GLVDescription `json:"glv,omitempty" yaml:"glv,omitempty"`
+
+	// HashToCurve defaults to the Shallue-van de Woestijne map, of the smallest valid z.
+	HashToCurve *HashToCurveDescription `json:"hashToCurve,omitempty" yaml:"hashToCurve,omitempty"`
+
+	// Pairing makes the curve pairing-friendly. The parameters of the curve (fp, fr, b, cofactor,
+	// g1), of the extension tower and of G2 are then derived from the seed of the family when
+	// they are omitted, and checked otherwise.
+	Pairing *PairingDescription `json:"pairing,omitempty" yaml:"pairing,omitempty"`
+	Tower   *TowerDescription   `json:"tower,omitempty" yaml:"tower,omitempty"`
+	G2      *G2Description      `json:"g2,omitempty" yaml:"g2,omitempty"`
+}
+
+// PointDescription describes an affine point
+type PointDescription struct {
+	X string `json:"x" yaml:"x"`
+	Y string `json:"y" yaml:"y"`
+}
+
+// GLVDescription describes the endomorphism ϕ: (x,y) → (ωx,y) of eigenvalue λ
+type GLVDescription struct {
+	ThirdRootOne string `json:"thirdRootOne,omitempty" yaml:"thirdRootOne,omitempty"` // ω, a primitive third root of 1 in 𝔽p
+	Lambda       string `json:"lambda,omitempty" yaml:"lambda,omitempty"`             // λ, such that ϕ(P) = [λ]P
+}
+
+// HashToCurveDescription describes the map to the curve used by the hash to curve
+// (RFC 9380, section 6.6)
+type HashToCurveDescription struct {
+	Map string `json:"map" yaml:"map"` // "svdw" or "sswu"
+	Z   string `json:"z,omitempty" yaml:"z,omitempty"`
+
+	// Isogeny is needed by the simplified SWU map when a = 0 or b = 0: the map is then
+	// evaluated on an isogenous curve with a ≠ 0 and b ≠ 0.
+	Isogeny *IsogenyDescription `json:"isogeny,omitempty" yaml:"isogeny,omitempty"`
+}
+
+// IsogenyDescription describes the isogeny (x', y') → (xNum(x')/xDen(x'), y'⋅yNum(x')/yDen(x'))
+// from the curve Y² = X³ + AX + B to the generated curve. The coefficients of the polynomials
+// are listed by increasing degree, and the leading coefficient (1) of the denominators is omitted.
+type IsogenyDescription struct {
+	A    string   `json:"a" yaml:"a"`
+	B    string   `json:"b" yaml:"b"`
+	XNum []string `json:"xNum" yaml:"xNum"`
+	XDen []string `json:"xDen" yaml:"xDen"`
+	YNum []string `json:"yNum" yaml:"yNum"`
+	YDen []string `json:"yDen" yaml:"yDen"`
+}
+
+// PairingDescription describes the family of a pairing-friendly curve
+type PairingDescription struct {
+	// Family is "bls12": p = (x₀-1)²⋅r/3 + x₀ and r = x₀⁴-x₀²+1, with embedding degree 12.
+	Family string `json:"family" yaml:"family"`
+	Seed   string `json:"seed" yaml:"seed"` // x₀
+}
+
+// TowerDescription describes the extension tower
+//
+//	𝔽p²[u] = 𝔽p/u²-β
+//	𝔽p⁶[v] = 𝔽p²/v³-ξ
+//	𝔽p¹²[w] = 𝔽p⁶/w²-v
+type TowerDescription struct {
+	// Beta is β, a small negative integer which is not a square in 𝔽p. It must be -1 if p ≡ 3 mod 4,
+	// and defaults to the largest such integer.
+	Beta string `json:"beta,omitempty" yaml:"beta,omitempty"`
+	// Xi is ξ = ξ₀ + u, by its coordinates (ξ₀, 1) where ξ₀ is a small non-negative integer, and
+	// ξ is neither a square nor a cube in 𝔽p². Defaults to the smallest such ξ₀.
+	Xi []string `json:"xi,omitempty" yaml:"xi,omitempty"`
+}
+
+// G2Description describes G2, the subgroup of order r of the sextic twist of the curve over 𝔽p²
+// whose order is divisible by r. The elements of 𝔽p² are given by their coordinates (A0, A1).
+type G2Description struct {
+	X []string `json:"x,omitempty" yaml:"x,omitempty"` // generator; computed when omitted
+	Y []string `json:"y,omitempty" yaml:"y,omitempty"`
+
+	// HashToCurve only supports the Shallue-van de Woestijne map, with z in 𝔽p.
+	HashToCurve *HashToCurveDescription `json:"hashToCurve,omitempty" yaml:"hashToCurve,omitempty"`
+}
+
+// ReadDescription reads a curve description from a JSON (.json) or YAML file
+func ReadDescription(path string) (*Description, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var d Description
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&d)
+	} else {
+		err = yaml.UnmarshalStrict(b, &d)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't parse %s: %w", path, err)
+	}
+	return &d, nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"math/big"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// e2 is an element a0 + a1⋅u of 𝔽p², with reduced coordinates
+type e2 struct {
+	a0, a1 big.Int
+}
+
+// fp2 implements the arithmetic of 𝔽p² = 𝔽p[u]/(u²-β), without any concern for efficiency
+type fp2 struct {
+	p, beta *big.Int
+}
+
+func (f *fp2) fromInt(a0, a1 int64) *e2 {
+	var z e2
+	z.a0.SetInt64(a0).Mod(&z.a0, f.p)
+	z.a1.SetInt64(a1).Mod(&z.a1, f.p)
+	return &z
+}
+
+func (f *fp2) add(x, y *e2) *e2 {
+	var z e2
+	z.a0.Add(&x.a0, &y.a0).Mod(&z.a0, f.p)
+	z.a1.Add(&x.a1, &y.a1).Mod(&z.a1, f.p)
+	return &z
+}
+
+func (f *fp2) sub(x, y *e2) *e2 {
+	var z e2
+	z.a0.Sub(&x.a0, &y.a0).Mod(&z.a0, f.p)
+	z.a1.Sub(&x.a1, &y.a1).Mod(&z.a1, f.p)
+	return &z
+}
+
+func (f *fp2) neg(x *e2) *e2 {
+	return f.sub(&e2{}, x)
+}
+
+func (f *fp2) mul(x, y *e2) *e2 {
+	var z e2
+	var t big.Int
+	z.a0.Mul(&x.a0, &y.a0)
+	t.Mul(&x.a1, &y.a1).Mul(&t, f.beta)
+	z.a0.Add(&z.a0, &t).Mod(&z.a0, f.p)
+	z.a1.Mul(&x.a0, &y.a1)
+	t.Mul(&x.a1, &y.a0)
+	z.a1.Add(&z.a1, &t).Mod(&z.a1, f.p)
+	return &z
+}
+
+// mulByElement multiplies x by c in 𝔽p
+func (f *fp2) mulByElement(x *e2, c *big.Int) *e2 {
+	var z e2
+	z.a0.Mul(&x.a0, c).Mod(&z.a0, f.p)
+	z.a1.Mul(&x.a1, c).Mod(&z.a1, f.p)
+	return &z
+}
+
+// conjugate is the Frobenius x → xᵖ
+func (f *fp2) conjugate(x *e2) *e2 {
+	var z e2
+	z.a0.Set(&x.a0)
+	z.a1.Neg(&x.a1).Mod(&z.a1, f.p)
+	return &z
+}
+
+// norm returns x⋅x̄ = a0² - βa1²
+func (f *fp2) norm(x *e2) *big.Int {
+	var n, t big.Int
+	n.Mul(&x.a0, &x.a0)
+	t.Mul(&x.a1, &x.a1).Mul(&t, f.beta)
+	return n.Sub(&n, &t).Mod(&n, f.p)
+}
+
+func (f *fp2) inverse(x *e2) *e2 {
+	n := f.norm(x)
+	n.ModInverse(n, f.p)
+	return f.mulByElement(f.conjugate(x), n)
+}
+
+func (f *fp2) exp(x *e2, k *big.Int) *e2 {
+	z := f.fromInt(1, 0)
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		z = f.mul(z, z)
+		if k.Bit(i) == 1 {
+			z = f.mul(z, x)
+		}
+	}
+	return z
+}
+
+func (f *fp2) isZero(x *e2) bool {
+	return x.a0.Sign() == 0 && x.a1.Sign() == 0
+}
+
+func (f *fp2) equal(x, y *e2) bool {
+	return x.a0.Cmp(&y.a0) == 0 && x.a1.Cmp(&y.a1) == 0
+}
+
+// isSquare returns true if x is a square in 𝔽p², i.e. if its norm is a square in 𝔽p
+func (f *fp2) isSquare(x *e2) bool {
+	return big.Jacobi(f.norm(x), f.p) >= 0
+}
+
+// isCube returns true if x is a cube in 𝔽p², for p² ≡ 1 mod 3
+func (f *fp2) isCube(x *e2) bool {
+	var e big.Int
+	e.Mul(f.p, f.p).Sub(&e, big.NewInt(1)).Div(&e, big.NewInt(3))
+	return f.equal(f.exp(x, &e), f.fromInt(1, 0))
+}
+
+// sqrt returns a square root of x, or nil if x is not a square
+func (f *fp2) sqrt(x *e2) *e2 {
+	var z e2
+	if x.a1.Sign() == 0 {
+		// x ∈ 𝔽p: either x or x/β is a square in 𝔽p
+		if z.a0.ModSqrt(&x.a0, f.p) != nil {
+			return &z
+		}
+		var t big.Int
+		t.ModInverse(f.beta, f.p).Mul(&t, &x.a0).Mod(&t, f.p)
+		if z.a1.ModSqrt(&t, f.p) == nil {
+			return nil
+		}
+		return &z
+	}
+	// z0² = (a0 ± √N(x)) / 2 and z1 = a1 / 2z0
+	var n, t, twoInv big.Int
+	if n.ModSqrt(f.norm(x), f.p) == nil {
+		return nil
+	}
+	twoInv.ModInverse(big.NewInt(2), f.p)
+	t.Add(&x.a0, &n).Mul(&t, &twoInv).Mod(&t, f.p)
+	if z.a0.ModSqrt(&t, f.p) == nil {
+		t.Sub(&x.a0, &n).Mul(&t, &twoInv).Mod(&t, f.p)
+		if z.a0.ModSqrt(&t, f.p) == nil {
+			return nil
+		}
+	}
+	t.Lsh(&z.a0, 1).ModInverse(&t, f.p)
+	z.a1.Mul(&x.a1, &t).Mod(&z.a1, f.p)
+	return &z
+}
+
+// sgn0 is the sign of x, as defined in RFC 9380, section 4.1
+func (f *fp2) sgn0(x *e2) uint {
+	if x.a0.Sign() == 0 {
+		return x.a1.Bit(0)
+	}
+	return x.a0.Bit(0)
+}
+
+func (x *e2) element() field.Element {
+	return field.Element{x.a0, x.a1}
+}
+
+// affine2 is a point of a short Weierstrass curve over 𝔽p²; nil is the point at infinity
+type affine2 struct {
+	x, y *e2
+}
+
+// twistCurve implements the arithmetic of the curve Y² = X³ + b over 𝔽p², without any
+// concern for efficiency
+type twistCurve struct {
+	f *fp2
+	b *e2
+}
+
+// rhs returns x³ + b
+func (e *twistCurve) rhs(x *e2) *e2 {
+	return e.f.add(e.f.mul(e.f.mul(x, x), x), e.b)
+}
+
+func (e *twistCurve) isOnCurve(q *affine2) bool {
+	return e.f.equal(e.f.mul(q.y, q.y), e.rhs(q.x))
+}
+
+func (e *twistCurve) add(p1, p2 *affine2) *affine2 {
+	if p1 == nil {
+		return p2
+	}
+	if p2 == nil {
+		return p1
+	}
+	f := e.f
+	var l *e2
+	if f.equal(p1.x, p2.x) {
+		if f.isZero(f.add(p1.y, p2.y)) {
+			return nil
+		}
+		// λ = 3x² / 2y
+		l = f.mul(f.mul(p1.x, p1.x), f.fromInt(3, 0))
+		l = f.mul(l, f.inverse(f.add(p1.y, p1.y)))
+	} else {
+		// λ = (y₂ - y₁) / (x₂ - x₁)
+		l = f.mul(f.sub(p2.y, p1.y), f.inverse(f.sub(p2.x, p1.x)))
+	}
+	x := f.sub(f.sub(f.mul(l, l), p1.x), p2.x)
+	y := f.sub(f.mul(f.sub(p1.x, x), l), p1.y)
+	return &affine2{x: x, y: y}
+}
+
+func (e *twistCurve) neg(q *affine2) *affine2 {
+	if q == nil {
+		return nil
+	}
+	return &affine2{x: q.x, y: e.f.neg(q.y)}
+}
+
+func (e *twistCurve) scalarMul(s *big.Int, q *affine2) *affine2 {
+	var res *affine2
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = e.add(res, res)
+		if s.Bit(i) == 1 {
+			res = e.add(res, q)
+		}
+	}
+	return res
+}
+
+func (e *twistCurve) equal(p1, p2 *affine2) bool {
+	if p1 == nil || p2 == nil {
+		return p1 == p2
+	}
+	return e.f.equal(p1.x, p2.x) && e.f.equal(p1.y, p2.y)
+}
+
+// point returns the point of abscissa x, of sgn0(y) = 0, or nil if there is none
+func (e *twistCurve) point(x *e2) *affine2 {
+	y := e.f.sqrt(e.rhs(x))
+	if y == nil {
+		return nil
+	}
+	if e.f.sgn0(y) == 1 {
+		y = e.f.neg(y)
+	}
+	return &affine2{x: x, y: y}
+}
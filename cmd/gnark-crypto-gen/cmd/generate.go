@@ -0,0 +1,445 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/ecc"
+	"github.com/consensys/gnark-crypto/internal/generator/pairing"
+	"github.com/consensys/gnark-crypto/internal/generator/tower"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// templates holds the templates of the files which are hand-written for the curves of gnark-crypto
+//
+//go:embed template
+var templates embed.FS
+
+const parallelImportPath = "github.com/consensys/gnark-crypto/internal/parallel"
+
+// fptowerImportPath is the import path of the extension tower in the templates, for the curve name
+const fptowerImportPath = "github.com/consensys/gnark-crypto/ecc/%s/internal/fptower"
+
+// newCurveConfig returns the configuration of the generators for the curve c, whose package
+// has the import path importPath
+func newCurveConfig(c *curveParams, importPath string) (config.Curve, error) {
+	conf := config.Curve{
+		Name:          c.name,
+		CurvePackage:  c.name,
+		EnumID:        strings.ToUpper(c.name),
+		FpModulus:     c.p.String(),
+		FrModulus:     c.r.String(),
+		FpPackagePath: importPath + "/fp",
+		FrPackagePath: importPath + "/fr",
+		G1: config.Point{
+			CoordType:        "fp.Element",
+			CoordExtDegree:   1,
+			PointName:        "g1",
+			GLV:              c.glv,
+			CofactorCleaning: false,
+			B:                []string{c.b.String()},
+		},
+	}
+	if c.a.Sign() != 0 {
+		conf.G1.A = []string{c.a.String()}
+	}
+
+	var err error
+	if conf.Fp, err = field.NewFieldConfig("fp", "Element", conf.FpModulus, false); err != nil {
+		return conf, err
+	}
+	if conf.Fr, err = field.NewFieldConfig("fr", "Element", conf.FrModulus, false); err != nil {
+		return conf, err
+	}
+	conf.FpUnusedBits = (64 - conf.Fp.NbBits%64) % 64
+
+	// window sizes of the multi-exponentiation; the last window must accommodate a carry,
+	// and the digits must fit on 16 bits
+	cmax := 16
+	if conf.FrFullLastWord() {
+		cmax = 15
+	}
+	for c := 4; c <= cmax; c++ {
+		nbChunks := (conf.Fr.NbBits + c - 1) / c
+		if lastC := c + 1 - (nbChunks*c - conf.Fr.NbBits); lastC > 16 {
+			return conf, fmt.Errorf("fr: the multi-exponentiation doesn't support %d-bit scalars", conf.Fr.NbBits)
+		}
+		conf.G1.CRange = append(conf.G1.CRange, c)
+	}
+
+	// G1 is the full group of points when the curve is of prime order
+	conf.G1.CofactorCleaning = c.cofactor.Cmp(big.NewInt(1)) != 0
+	if pp := c.pairing; pp != nil {
+		conf.Family = "bls12"
+		conf.SeedNeg = pp.seed.Sign() < 0
+		conf.G2 = config.Point{
+			CoordType:        "fptower.E2",
+			CoordExtDegree:   2,
+			CoordExtRoot:     pp.beta,
+			PointName:        "g2",
+			GLV:              true,
+			CofactorCleaning: true,
+			CRange:           append([]int(nil), conf.G1.CRange...),
+			Projective:       true,
+		}
+		conf.HashE2 = config.NewHashSuiteSvdw(e2Strings(pp.z), e2Strings(pp.c1), e2Strings(pp.c2), e2Strings(pp.c3), e2Strings(pp.c4))
+	}
+
+	h := &c.hash
+	if h.svdw {
+		conf.HashE1 = config.NewHashSuiteSvdw([]string{h.z.String()}, []string{h.c1.String()}, []string{h.c2.String()}, []string{h.c3.String()}, []string{h.c4.String()})
+		return conf, nil
+	}
+	sswu := &config.HashSuiteSswu{
+		A: []string{h.a.String()},
+		B: []string{h.b.String()},
+		Z: []int{int(h.z.Int64())},
+	}
+	if h.isogeny != nil {
+		sswu.Isogeny = &config.Isogeny{
+			XMap: config.RationalPolynomial{Num: toStrings(h.isogeny.xNum), Den: toStrings(h.isogeny.xDen)},
+			YMap: config.RationalPolynomial{Num: toStrings(h.isogeny.yNum), Den: toStrings(h.isogeny.yDen)},
+		}
+	}
+	conf.HashE1 = sswu
+	return conf, nil
+}
+
+func e2Strings(x *e2) []string {
+	return []string{x.a0.String(), x.a1.String()}
+}
+
+func toStrings(coeffs []big.Int) [][]string {
+	res := make([][]string, len(coeffs))
+	for i := range coeffs {
+		res[i] = []string{coeffs[i].String()}
+	}
+	return res
+}
+
+// curveData is the input of the templates of the curve package
+type curveData struct {
+	Package, Name      string
+	P, R, A, B, GX, GY string
+	A0, GLV            bool
+	ThirdRootOne       string
+	Lambda             string
+	Cofactor           string // h, if the curve isn't of prime order
+	FpImport, FrImport string
+
+	Pairing *pairingData // nil if the curve isn't pairing-friendly
+}
+
+// pairingData is the input of the templates of the pairing and of the extension tower
+type pairingData struct {
+	Seed, XGen  string // x₀ and |x₀|
+	SeedNeg     bool
+	LoopCounter []int8 // binary decomposition of |x₀|, little endian
+	Expt        []exptStep
+
+	Beta, K, KMinus1 int64 // β and k = -β, with 𝔽p² = 𝔽p[u]/(u²+k)
+	Xi0              int64 // ξ = ξ₀ + u
+	XiInv            field.Element
+	XiInvString      string
+
+	MTwist         bool
+	BTwist         field.Element
+	BTwistCoords   []string
+	BTwistString   string
+	G2X, G2Y       []string
+	ThirdRootOneG2 string
+	EndoU, EndoV   []string
+
+	// Frobenius[j-1][i-1] = ξ^{i(pʲ-1)/6}
+	Frobenius [2][5]frobeniusConstant
+
+	RBits, P12Bits int
+}
+
+// exptStep is a step of the exponentiation by |x₀| in the cyclotomic subgroup: N squarings,
+// compressed (Karabina) for the long runs, followed by a multiplication if Mul
+type exptStep struct {
+	N          int
+	Compressed bool
+	Mul        bool
+}
+
+// nbCompressedSquaresMin is the number of squarings from which the compressed squarings, followed by
+// the decompression, are faster than the cyclotomic squarings
+const nbCompressedSquaresMin = 16
+
+// frobeniusConstant is an element of 𝔽p², of length 1 if it is in 𝔽p
+type frobeniusConstant struct {
+	Value  field.Element
+	String string
+}
+
+func newCurveData(c *curveParams, conf *config.Curve) *curveData {
+	data := &curveData{
+		Package:      c.name,
+		Name:         c.name,
+		P:            c.p.String(),
+		R:            c.r.String(),
+		A:            c.a.String(),
+		B:            c.b.String(),
+		GX:           c.gx.String(),
+		GY:           c.gy.String(),
+		A0:           c.a.Sign() == 0,
+		GLV:          c.glv,
+		ThirdRootOne: c.thirdRootOne.String(),
+		Lambda:       c.lambda.String(),
+		FpImport:     conf.FpImport(),
+		FrImport:     conf.FrImport(),
+	}
+	pp := c.pairing
+	if pp == nil {
+		if c.cofactor.Cmp(big.NewInt(1)) != 0 {
+			data.Cofactor = c.cofactor.String()
+		}
+		return data
+	}
+
+	var xAbs big.Int
+	xAbs.Abs(&pp.seed)
+	f := &fp2{p: &c.p, beta: big.NewInt(pp.beta)}
+	d := &pairingData{
+		Seed:           pp.seed.String(),
+		XGen:           xAbs.String(),
+		SeedNeg:        pp.seed.Sign() < 0,
+		Beta:           pp.beta,
+		K:              -pp.beta,
+		KMinus1:        -pp.beta - 1,
+		Xi0:            pp.xi0,
+		MTwist:         pp.mTwist,
+		BTwist:         pp.bTwist.element(),
+		BTwistCoords:   e2Strings(pp.bTwist),
+		BTwistString:   e2String(pp.bTwist),
+		G2X:            e2Strings(pp.gx),
+		G2Y:            e2Strings(pp.gy),
+		ThirdRootOneG2: pp.thirdRootOne.String(),
+		EndoU:          e2Strings(pp.endoU),
+		EndoV:          e2Strings(pp.endoV),
+		RBits:          c.r.BitLen(),
+		P12Bits:        new(big.Int).Exp(&c.p, big.NewInt(12), nil).BitLen(),
+	}
+	xiInv := f.inverse(f.fromInt(pp.xi0, 1))
+	d.XiInv, d.XiInvString = xiInv.element(), e2String(xiInv)
+
+	d.LoopCounter = make([]int8, xAbs.BitLen())
+	for i := range d.LoopCounter {
+		d.LoopCounter[i] = int8(xAbs.Bit(i))
+	}
+	n := 0
+	for i := xAbs.BitLen() - 2; i >= 0; i-- {
+		n++
+		if xAbs.Bit(i) == 1 {
+			d.Expt = append(d.Expt, exptStep{N: n, Compressed: n >= nbCompressedSquaresMin, Mul: true})
+			n = 0
+		}
+	}
+	if n != 0 {
+		d.Expt = append(d.Expt, exptStep{N: n, Compressed: n >= nbCompressedSquaresMin})
+	}
+
+	for j := range pp.frobenius {
+		for i, gamma := range pp.frobenius[j] {
+			if gamma.a1.Sign() == 0 {
+				d.Frobenius[j][i] = frobeniusConstant{Value: field.Element{gamma.a0}, String: gamma.a0.String()}
+			} else {
+				d.Frobenius[j][i] = frobeniusConstant{Value: gamma.element(), String: e2String(gamma)}
+			}
+		}
+	}
+
+	data.Pairing = d
+	return data
+}
+
+func e2String(x *e2) string {
+	return fmt.Sprintf("%s,%s", &x.a0, &x.a1)
+}
+
+// generate generates the package of the curve c in outputDir, whose import path is importPath.
+//
+// The fp and fr packages are generated in the fp and fr sub-directories, and the package parallel
+// of gnark-crypto, which is internal, is copied in the internal/parallel sub-directory.
+func generate(c *curveParams, outputDir, importPath string) error {
+	conf, err := newCurveConfig(c, importPath)
+	if err != nil {
+		return err
+	}
+	if outputDir, err = filepath.Abs(outputDir); err != nil {
+		return err
+	}
+
+	if err := generator.GenerateFF(conf.Fp, filepath.Join(outputDir, "fp")); err != nil {
+		return err
+	}
+	if err := generator.GenerateFF(conf.Fr, filepath.Join(outputDir, "fr")); err != nil {
+		return err
+	}
+
+	// the generators read their templates relatively to internal/generator; we extract them
+	// in a temporary directory from which they run.
+	tmpDir, err := os.MkdirTemp("", "gnark-crypto-gen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	for _, t := range []struct {
+		fsys embed.FS
+		dir  string
+	}{{ecc.Templates, "ecc"}, {tower.Templates, "tower"}, {pairing.Templates, "pairing"}} {
+		sub, err := fs.Sub(t.fsys, "template")
+		if err != nil {
+			return err
+		}
+		if err := extract(sub, filepath.Join(tmpDir, t.dir, "template")); err != nil {
+			return err
+		}
+	}
+	if err := extract(templates, tmpDir); err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := os.Chdir(tmpDir); err != nil {
+		return err
+	}
+	defer os.Chdir(wd)
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	// G1, multi-exponentiation, hash to curve and marshal
+	if err := ecc.Generate(conf, outputDir, bgen); err != nil {
+		return err
+	}
+
+	// parameters of the curve, and the pairing
+	data := newCurveData(c, &conf)
+	funcs := template.FuncMap{
+		"asElement": conf.Fp.WriteElement,
+		// fpConst returns the literal of the element n of 𝔽p, in Montgomery form
+		"fpConst": func(n int64) string {
+			e := make(field.Element, 1)
+			e[0].SetInt64(n).Mod(&e[0], &c.p)
+			return conf.Fp.WriteElement(e)
+		},
+	}
+	opts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
+	if err := bgen.GenerateWithOptions(data, c.name, "./template", opts,
+		bavard.Entry{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		bavard.Entry{File: filepath.Join(outputDir, c.name+".go"), Templates: []string{"curve.go.tmpl"}},
+	); err != nil {
+		return err
+	}
+
+	if c.pairing != nil {
+		if err := bgen.GenerateWithOptions(data, c.name, "./template", opts,
+			bavard.Entry{File: filepath.Join(outputDir, "pairing.go"), Templates: []string{"pairing.go.tmpl"}},
+		); err != nil {
+			return err
+		}
+		if err := pairing.Generate(conf, outputDir, bgen); err != nil {
+			return err
+		}
+
+		// the extension tower: its arithmetic is generated, except the parts which depend on β, ξ
+		// and the seed
+		towerDir := filepath.Join(outputDir, "internal", "fptower")
+		if err := tower.Generate(conf, towerDir, bgen); err != nil {
+			return err
+		}
+		if err := bgen.GenerateWithOptions(data, "fptower", "./template/fptower", opts,
+			bavard.Entry{File: filepath.Join(towerDir, "e2_"+c.name+".go"), Templates: []string{"e2.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(towerDir, "e12_pairing.go"), Templates: []string{"e12_pairing.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(towerDir, "frobenius.go"), Templates: []string{"frobenius.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(towerDir, "parameters.go"), Templates: []string{"parameters.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(towerDir, "generators_test.go"), Templates: []string{"tests/generators.go.tmpl"}},
+		); err != nil {
+			return err
+		}
+		if err := replaceImport(outputDir, fmt.Sprintf(fptowerImportPath, conf.Name), importPath+"/internal/fptower"); err != nil {
+			return err
+		}
+	}
+
+	// the generated code can't import an internal package of gnark-crypto
+	dir := filepath.Join(outputDir, "internal", "parallel")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "execute.go"), parallel.Source, 0o644); err != nil {
+		return err
+	}
+	return replaceImport(outputDir, parallelImportPath, importPath+"/internal/parallel")
+}
+
+// replaceImport replaces the import path old by new in the Go files of dir, recursively,
+// and formats them
+func replaceImport(dir, old, new string) error {
+	oldQuoted, newQuoted := []byte(fmt.Sprintf("%q", old)), []byte(fmt.Sprintf("%q", new))
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".go" {
+			return nil
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		src, err = format.Source(bytes.ReplaceAll(src, oldQuoted, newQuoted))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return os.WriteFile(path, src, 0o644)
+	})
+}
+
+// extract copies the files of fsys in dir
+func extract(fsys fs.FS, dir string) error {
+	return fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, filepath.FromSlash(path))
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		b, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, b, 0o600)
+	})
+}
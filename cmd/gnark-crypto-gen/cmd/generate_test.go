@@ -0,0 +1,95 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateExternalModule checks that the generated packages build and pass their tests in a
+// module other than gnark-crypto
+func TestGenerateExternalModule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the build of the generated packages in short mode")
+	}
+
+	root, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goSum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a module with the requirements of gnark-crypto, which is replaced by this tree
+	dir := t.TempDir()
+	mod := strings.Replace(string(goMod), "module github.com/consensys/gnark-crypto", "module example.com/curves", 1)
+	mod += "\nrequire github.com/consensys/gnark-crypto v0.0.0\n\nreplace github.com/consensys/gnark-crypto => " + root + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// secp256k1 (GLV), and the bls12 curves of the seeds of BLS12-381 (M-twist) and BLS12-377 (D-twist)
+	descriptions := []*Description{readTestDescription(t, "k256.yaml"), readTestDescription(t, "bls12.yaml")}
+	d := readTestDescription(t, "bls12.yaml")
+	d.Name = "bls12d"
+	d.Pairing.Seed = "0x8508c00000000001"
+	descriptions = append(descriptions, d)
+
+	// the G1 curve of BLS12-377 alone, for the scalar multiplication by the cofactor
+	c, err := d.validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptions = append(descriptions, &Description{
+		Name:     "cofactor",
+		Fp:       c.p.String(),
+		Fr:       c.r.String(),
+		B:        c.b.String(),
+		Cofactor: c.cofactor.String(),
+		G1:       &PointDescription{X: c.gx.String(), Y: c.gy.String()},
+	})
+
+	for _, d := range descriptions {
+		c, err := d.validate()
+		if err != nil {
+			t.Fatalf("%s: %v", d.Name, err)
+		}
+		if err := generate(c, filepath.Join(dir, d.Name), "example.com/curves/"+d.Name); err != nil {
+			t.Fatalf("%s: %v", d.Name, err)
+		}
+	}
+
+	for _, args := range [][]string{{"build", "./..."}, {"vet", "./..."}, {"test", "-short", "./..."}} {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// pairingParams holds the parameters of a pairing-friendly curve of the BLS12 family
+type pairingParams struct {
+	seed big.Int // x₀
+
+	// extension tower: 𝔽p² = 𝔽p[u]/(u²-β), 𝔽p⁶ = 𝔽p²[v]/(v³-ξ) with ξ = ξ₀ + u, 𝔽p¹² = 𝔽p⁶[w]/(w²-v)
+	beta, xi0 int64
+
+	// sextic twist Y² = X³ + b' over 𝔽p², of order h₂⋅r, with b' = b/ξ (D-type) or b' = b⋅ξ (M-type)
+	mTwist   bool
+	bTwist   *e2
+	cofactor big.Int // h₂
+	gx, gy   *e2     // generator of G2
+
+	// ω such that ϕ: (x,y) → (ωx,y) is [λ] on G2, with the λ of G1
+	thirdRootOne big.Int
+
+	// ψ: (x,y) → (u⋅x̄, v⋅ȳ), the untwist-Frobenius-twist endomorphism, is [x₀] on G2
+	endoU, endoV *e2
+
+	// frobenius[j-1][i-1] = ξ^{i(pʲ-1)/6}, the constants of the Frobenius maps of 𝔽p¹²
+	frobenius [2][5]*e2
+
+	// Shallue-van de Woestijne map to the twist
+	z, c1, c2, c3, c4 *e2
+}
+
+// setFamily sets the moduli and the cofactor of the curve from the seed of its family,
+// and checks the ones of the description against them
+func (c *curveParams) setFamily(d *Description) error {
+	if !strings.EqualFold(d.Pairing.Family, "bls12") {
+		return fmt.Errorf("pairing.family: unknown family %q, expected bls12", d.Pairing.Family)
+	}
+	c.pairing = &pairingParams{}
+	x := &c.pairing.seed
+	if err := parseInt(x, d.Pairing.Seed, "pairing.seed"); err != nil {
+		return err
+	}
+	// the optimal ate loop and the cofactor clearings use |x₀| as a uint64
+	if new(big.Int).Abs(x).BitLen() > 64 {
+		return errors.New("pairing.seed: |x₀| must fit on 64 bits")
+	}
+
+	one := big.NewInt(1)
+	var x2, t, rem big.Int
+	// r = x₀⁴ - x₀² + 1
+	x2.Mul(x, x)
+	c.r.Mul(&x2, &x2).Sub(&c.r, &x2).Add(&c.r, one)
+	// h₁ = (x₀-1)²/3 and p = h₁⋅r + x₀
+	t.Sub(x, one)
+	t.Mul(&t, &t)
+	c.cofactor.DivMod(&t, big.NewInt(3), &rem)
+	if rem.Sign() != 0 {
+		return errors.New("pairing.seed: x₀ must be 1 mod 3")
+	}
+	c.p.Mul(&c.cofactor, &c.r).Add(&c.p, x)
+
+	for _, param := range []struct {
+		s, name string
+		v       *big.Int
+	}{{d.Fp, "fp", &c.p}, {d.Fr, "fr", &c.r}, {d.Cofactor, "cofactor", &c.cofactor}} {
+		if param.s == "" {
+			continue
+		}
+		if err := parseInt(&t, param.s, param.name); err != nil {
+			return err
+		}
+		if t.Cmp(param.v) != 0 {
+			return fmt.Errorf("%s: doesn't match the seed, expected %s", param.name, param.v)
+		}
+	}
+	if c.p.BitLen()%64 == 0 {
+		// the 𝔽p² additions in assembly don't handle a carry
+		return errors.New("fp: the extension tower needs a spare bit in the last 64-bit word of p")
+	}
+	return nil
+}
+
+// setCoefficient sets b to the smallest positive integer such that the curve Y² = X³ + b is of
+// order h₁⋅r
+func (c *curveParams) setCoefficient() {
+	var n big.Int
+	n.Mul(&c.cofactor, &c.r)
+	for b := int64(1); ; b++ {
+		c.b.SetInt64(b)
+		e := weierstrass{p: &c.p, a: &c.a, b: &c.b}
+		if e.hasOrder(&n) {
+			return
+		}
+	}
+}
+
+// setGenerator sets the generator of G1 to [h₁](x,y) for the smallest positive x
+func (c *curveParams) setGenerator(e *weierstrass) {
+	for _, q := range e.points(nbOrderChecks) {
+		if g := e.scalarMul(&c.cofactor, q); g != nil {
+			c.gx.Set(g.x)
+			c.gy.Set(g.y)
+			return
+		}
+	}
+}
+
+// setG2 sets the extension tower, the twist and G2, and checks the ones of the description
+func (c *curveParams) setG2(d *Description) error {
+	pp := c.pairing
+	p := &c.p
+	if d.Tower == nil {
+		d.Tower = &TowerDescription{}
+	}
+	if d.G2 == nil {
+		d.G2 = &G2Description{}
+	}
+
+	// 𝔽p² = 𝔽p[u]/(u²-β); the square root in 𝔽p² of the templates assumes β = -1 if p ≡ 3 mod 4,
+	// and u not a square otherwise
+	var beta big.Int
+	if d.Tower.Beta != "" {
+		if err := parseInt(&beta, d.Tower.Beta, "tower.beta"); err != nil {
+			return err
+		}
+		if beta.Sign() >= 0 || !beta.IsInt64() {
+			return errors.New("tower.beta: β must be a small negative integer")
+		}
+	} else {
+		for beta.SetInt64(-1); big.Jacobi(new(big.Int).Mod(&beta, p), p) != -1; beta.Sub(&beta, big.NewInt(1)) {
+		}
+	}
+	if big.Jacobi(new(big.Int).Mod(&beta, p), p) != -1 {
+		return errors.New("tower.beta: β must not be a square in 𝔽p")
+	}
+	if p.Bit(1) == 1 && beta.Int64() != -1 {
+		return errors.New("tower.beta: β must be -1 when p ≡ 3 mod 4")
+	}
+	pp.beta = beta.Int64()
+	f := &fp2{p: p, beta: &beta}
+
+	// ξ = ξ₀ + u, such that v⁶ - ξ is irreducible over 𝔽p²
+	var xi *e2
+	if len(d.Tower.Xi) != 0 {
+		var xi0, xi1 big.Int
+		if len(d.Tower.Xi) != 2 {
+			return errors.New("tower.xi: expected the 2 coordinates of ξ")
+		}
+		if err := parseInt(&xi0, d.Tower.Xi[0], "tower.xi"); err != nil {
+			return err
+		}
+		if err := parseInt(&xi1, d.Tower.Xi[1], "tower.xi"); err != nil {
+			return err
+		}
+		if xi0.Sign() < 0 || !xi0.IsInt64() || xi1.Cmp(big.NewInt(1)) != 0 {
+			return errors.New("tower.xi: ξ must be ξ₀ + u, for a small non-negative integer ξ₀")
+		}
+		pp.xi0 = xi0.Int64()
+		xi = f.fromInt(pp.xi0, 1)
+		if f.isSquare(xi) || f.isCube(xi) {
+			return errors.New("tower.xi: ξ must be neither a square nor a cube in 𝔽p²")
+		}
+	} else {
+		for pp.xi0 = 0; ; pp.xi0++ {
+			xi = f.fromInt(pp.xi0, 1)
+			if !f.isSquare(xi) && !f.isCube(xi) {
+				break
+			}
+		}
+	}
+
+	// the twist of order h₂⋅r, with h₂ = (x₀⁸ - 4x₀⁷ + 5x₀⁶ - 4x₀⁴ + 6x₀³ - 4x₀² - 4x₀ + 13)/9
+	x := &pp.seed
+	for _, coeff := range []int64{1, -4, 5, 0, -4, 6, -4, -4, 13} {
+		pp.cofactor.Mul(&pp.cofactor, x).Add(&pp.cofactor, big.NewInt(coeff))
+	}
+	pp.cofactor.Div(&pp.cofactor, big.NewInt(9))
+	var n2 big.Int
+	n2.Mul(&pp.cofactor, &c.r)
+
+	b := &e2{}
+	b.a0.Set(&c.b)
+	dTwist := &twistCurve{f: f, b: f.mul(b, f.inverse(xi))}
+	mTwist := &twistCurve{f: f, b: f.mul(b, xi)}
+	var twist *twistCurve
+	var g *affine2
+	if len(d.G2.X) != 0 || len(d.G2.Y) != 0 {
+		var err error
+		g = &affine2{}
+		if g.x, err = parseE2(d.G2.X, "g2.x", p); err != nil {
+			return err
+		}
+		if g.y, err = parseE2(d.G2.Y, "g2.y", p); err != nil {
+			return err
+		}
+		switch {
+		case dTwist.isOnCurve(g):
+			twist = dTwist
+		case mTwist.isOnCurve(g):
+			twist = mTwist
+		default:
+			return errors.New("g2: the generator is not on the twists of the curve")
+		}
+		if !twist.hasOrder(&n2) {
+			return errors.New("g2: the generator is not on the twist of order divisible by r")
+		}
+		if twist.scalarMul(&c.r, g) != nil {
+			return errors.New("g2: the generator is not of order r")
+		}
+	} else {
+		switch {
+		case dTwist.hasOrder(&n2):
+			twist = dTwist
+		case mTwist.hasOrder(&n2):
+			twist = mTwist
+		default:
+			return errors.New("pairing: none of the sextic twists has the order of the family")
+		}
+		for _, q := range twist.points(nbOrderChecks) {
+			if g = twist.scalarMul(&pp.cofactor, q); g != nil {
+				break
+			}
+		}
+	}
+	pp.mTwist = twist == mTwist
+	pp.bTwist = twist.b
+	pp.gx, pp.gy = g.x, g.y
+
+	// Frobenius constants: ξ^{i(p-1)/6} and ξ^{i(p²-1)/6}
+	var e big.Int
+	for j := range pp.frobenius {
+		e.Exp(p, big.NewInt(int64(j+1)), nil).Sub(&e, big.NewInt(1)).Div(&e, big.NewInt(6))
+		gamma := f.exp(xi, &e)
+		pp.frobenius[j][0] = gamma
+		for i := 1; i < 5; i++ {
+			pp.frobenius[j][i] = f.mul(pp.frobenius[j][i-1], gamma)
+		}
+	}
+
+	// ψ = twist ∘ Frobenius ∘ untwist, where the untwist is (x,y) → (x/v, y/(vw)) for the D-twist,
+	// and (x,y) → (xv/ξ, yvw/ξ) for the M-twist; u = ξ^{±(p-1)/3} and v = ξ^{±(p-1)/2}
+	pp.endoU, pp.endoV = pp.frobenius[0][1], pp.frobenius[0][2]
+	if pp.mTwist {
+		pp.endoU, pp.endoV = f.inverse(pp.endoU), f.inverse(pp.endoV)
+	}
+	psi := func(q *affine2) *affine2 {
+		return &affine2{x: f.mul(f.conjugate(q.x), pp.endoU), y: f.mul(f.conjugate(q.y), pp.endoV)}
+	}
+	var s big.Int
+	s.Mod(x, &c.r)
+	if !twist.equal(psi(g), twist.scalarMul(&s, g)) {
+		return errors.New("g2: ψ is not [x₀] on G2")
+	}
+
+	// ϕ on G2, of eigenvalue λ; the tests also check that ψ² = -ϕ₁, for ϕ₁ of G1
+	phi := func(q *affine2, omega *big.Int) *affine2 {
+		return &affine2{x: f.mulByElement(q.x, omega), y: q.y}
+	}
+	lg := twist.scalarMul(&c.lambda, g)
+	var omega2 big.Int
+	omega2.Mul(&c.thirdRootOne, &c.thirdRootOne).Mod(&omega2, p)
+	switch {
+	case twist.equal(lg, phi(g, &omega2)):
+		pp.thirdRootOne.Set(&omega2)
+	case twist.equal(lg, phi(g, &c.thirdRootOne)):
+		pp.thirdRootOne.Set(&c.thirdRootOne)
+	default:
+		return errors.New("g2: no endomorphism (x,y) → (ωx,y) of eigenvalue λ")
+	}
+	if !twist.equal(psi(psi(g)), twist.neg(phi(g, &c.thirdRootOne))) {
+		return errors.New("g2: ψ² ≠ -ϕ₁ on G2")
+	}
+
+	return c.setHashToG2(d.G2.HashToCurve, twist)
+}
+
+// setHashToG2 sets the constants of the Shallue-van de Woestijne map to the twist
+func (c *curveParams) setHashToG2(d *HashToCurveDescription, e *twistCurve) error {
+	pp := c.pairing
+	f := e.f
+	if d == nil {
+		d = &HashToCurveDescription{Map: "svdw"}
+	}
+	if !strings.EqualFold(d.Map, "svdw") || d.Isogeny != nil {
+		return errors.New("g2.hashToCurve: only the Shallue-van de Woestijne map is supported")
+	}
+	if d.Z != "" {
+		pp.z = &e2{}
+		if err := parseElement(&pp.z.a0, d.Z, "g2.hashToCurve.z", f.p); err != nil {
+			return err
+		}
+		if !e.isSvdwZ(pp.z) {
+			return errors.New("g2.hashToCurve.z: invalid Shallue-van de Woestijne parameter (RFC 9380, section 6.6.1)")
+		}
+	} else {
+		// RFC 9380, appendix H.1, among the elements of 𝔽p
+		for ctr := int64(1); pp.z == nil; ctr++ {
+			for _, zc := range []int64{ctr, -ctr} {
+				if cand := f.fromInt(zc, 0); e.isSvdwZ(cand) {
+					pp.z = cand
+					break
+				}
+			}
+		}
+	}
+
+	// c1 = g(z), c2 = -z/2, c3 = √(-g(z)⋅3z²) with sgn0(c3) = 0, c4 = -4g(z)/3z²
+	gz := e.rhs(pp.z)
+	d3 := f.mul(f.mul(pp.z, pp.z), f.fromInt(3, 0))
+	pp.c1 = gz
+	pp.c2 = f.mul(f.neg(pp.z), f.inverse(f.fromInt(2, 0)))
+	pp.c3 = f.sqrt(f.neg(f.mul(gz, d3)))
+	if f.sgn0(pp.c3) == 1 {
+		pp.c3 = f.neg(pp.c3)
+	}
+	pp.c4 = f.mul(f.mul(f.neg(gz), f.fromInt(4, 0)), f.inverse(d3))
+	return nil
+}
+
+// isSvdwZ returns true if z satisfies the criteria of RFC 9380, section 6.6.1, for a = 0
+func (e *twistCurve) isSvdwZ(z *e2) bool {
+	f := e.f
+	gz := e.rhs(z)
+	if f.isZero(gz) {
+		return false
+	}
+	// h(z) = -3z² / 4g(z)
+	h := f.mul(f.neg(f.mul(f.mul(z, z), f.fromInt(3, 0))), f.inverse(f.mul(gz, f.fromInt(4, 0))))
+	if f.isZero(h) || !f.isSquare(h) {
+		return false
+	}
+	// g(z) or g(-z/2) is a square
+	return f.isSquare(gz) || f.isSquare(e.rhs(f.mul(f.neg(z), f.inverse(f.fromInt(2, 0)))))
+}
+
+// points returns the first n points (x, y) of the curve with x = 1, 2, … in 𝔽p
+func (e *twistCurve) points(n int) []*affine2 {
+	res := make([]*affine2, 0, n)
+	for x := int64(1); len(res) < n; x++ {
+		if q := e.point(e.f.fromInt(x, 0)); q != nil {
+			res = append(res, q)
+		}
+	}
+	return res
+}
+
+// hasOrder returns true if [n] kills a few points of the curve
+func (e *twistCurve) hasOrder(n *big.Int) bool {
+	for _, q := range e.points(nbOrderChecks) {
+		if e.scalarMul(n, q) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func parseE2(s []string, name string, p *big.Int) (*e2, error) {
+	if len(s) != 2 {
+		return nil, fmt.Errorf("%s: expected the 2 coordinates of an element of 𝔽p²", name)
+	}
+	var z e2
+	if err := parseElement(&z.a0, s[0], name, p); err != nil {
+		return nil, err
+	}
+	if err := parseElement(&z.a1, s[1], name, p); err != nil {
+		return nil, err
+	}
+	return &z, nil
+}
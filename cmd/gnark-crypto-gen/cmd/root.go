@@ -0,0 +1,124 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cmd is the CLI interface for gnark-crypto-gen
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "gnark-crypto-gen",
+	Short: "gnark-crypto-gen generates the package of a short Weierstrass curve from its description",
+	Run:   cmdGenerate,
+}
+
+// flags
+var (
+	fDescription string
+	fOutputDir   string
+	fImportPath  string
+	fCheck       bool
+)
+
+func init() {
+	cobra.OnInitialize()
+	rootCmd.PersistentFlags().StringVarP(&fDescription, "description", "d", "", "curve description, in JSON (.json) or YAML")
+	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path of the curve package")
+	rootCmd.PersistentFlags().StringVar(&fImportPath, "import", "", "import path of the curve package (default: resolved with go list)")
+	rootCmd.PersistentFlags().BoolVar(&fCheck, "check", false, "validate the description, and print the computed parameters, without generating code")
+}
+
+func cmdGenerate(cmd *cobra.Command, args []string) {
+	if fDescription == "" || (fOutputDir == "" && !fCheck) {
+		_ = cmd.Usage()
+		fmt.Printf("\n%s\n", errors.New("missing argument"))
+		os.Exit(-1)
+	}
+
+	d, err := ReadDescription(fDescription)
+	if err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+	c, err := d.validate()
+	if err != nil {
+		fmt.Printf("\ninvalid description %s: %s\n", fDescription, err.Error())
+		os.Exit(-1)
+	}
+	if fCheck {
+		fmt.Print(c)
+		return
+	}
+
+	fOutputDir = filepath.Clean(fOutputDir)
+	if err := os.MkdirAll(fOutputDir, 0o755); err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+	importPath := fImportPath
+	if importPath == "" {
+		if importPath, err = resolveImportPath(fOutputDir); err != nil {
+			fmt.Printf("\ncan't resolve the import path of %s, use --import: %s\n", fOutputDir, err.Error())
+			os.Exit(-1)
+		}
+	}
+	if err := generate(c, fOutputDir, importPath); err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+}
+
+// resolveImportPath returns the import path of dir, in the module which contains it
+func resolveImportPath(dir string) (string, error) {
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Path}}\n{{.Dir}}")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 2 {
+		return "", fmt.Errorf("unexpected output of go list: %q", out)
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(lines[1], absDir)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return lines[0], nil
+	}
+	return lines[0] + "/" + filepath.ToSlash(rel), nil
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
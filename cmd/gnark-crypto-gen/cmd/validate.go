@@ -0,0 +1,745 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// nbPrimalityRounds is the number of Miller-Rabin rounds of the primality tests
+const nbPrimalityRounds = 32
+
+// nbOrderChecks is the number of points on which the order of a curve is checked
+const nbOrderChecks = 4
+
+var rePackageName = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// curveParams holds the parameters of a validated Description
+type curveParams struct {
+	name   string
+	p, r   big.Int
+	a, b   big.Int
+	gx, gy big.Int
+
+	cofactor big.Int // h, such that #E(𝔽p) = h⋅r
+
+	// GLV
+	glv          bool
+	thirdRootOne big.Int
+	lambda       big.Int
+
+	hash hashParams
+
+	pairing *pairingParams // nil if the curve isn't pairing-friendly
+}
+
+// hashParams holds the parameters of the map to the curve
+type hashParams struct {
+	svdw bool
+	z    big.Int // in ]-p/2, p/2]
+
+	// Shallue-van de Woestijne constants
+	c1, c2, c3, c4 big.Int
+
+	// simplified SWU map, evaluated on the curve Y² = X³ + AX + B
+	a, b    big.Int
+	isogeny *isogenyParams
+}
+
+type isogenyParams struct {
+	xNum, xDen, yNum, yDen []big.Int
+}
+
+// String returns the parameters in the format of a Description, including the computed ones
+func (c *curveParams) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "name: %s\nfp: \"%s\"\nfr: \"%s\"\na: \"%s\"\nb: \"%s\"\n", c.name, &c.p, &c.r, &c.a, &c.b)
+	if c.cofactor.Cmp(big.NewInt(1)) != 0 {
+		fmt.Fprintf(&sb, "cofactor: \"%s\"\n", &c.cofactor)
+	}
+	fmt.Fprintf(&sb, "g1:\n  x: \"%s\"\n  y: \"%s\"\n", &c.gx, &c.gy)
+	if c.glv {
+		fmt.Fprintf(&sb, "glv:\n  thirdRootOne: \"%s\"\n  lambda: \"%s\"\n", &c.thirdRootOne, &c.lambda)
+	}
+	h := &c.hash
+	if h.svdw {
+		fmt.Fprintf(&sb, "hashToCurve:\n  map: svdw\n  z: \"%s\"\n", &h.z)
+		fmt.Fprintf(&sb, "  # c1: %s\n  # c2: %s\n  # c3: %s\n  # c4: %s\n", &h.c1, &h.c2, &h.c3, &h.c4)
+	} else {
+		fmt.Fprintf(&sb, "hashToCurve:\n  map: sswu\n  z: \"%s\"\n", &h.z)
+		if h.isogeny != nil {
+			fmt.Fprintf(&sb, "  isogeny:\n    a: \"%s\"\n    b: \"%s\"\n", &h.a, &h.b)
+			for _, poly := range []struct {
+				name   string
+				coeffs []big.Int
+			}{{"xNum", h.isogeny.xNum}, {"xDen", h.isogeny.xDen}, {"yNum", h.isogeny.yNum}, {"yDen", h.isogeny.yDen}} {
+				fmt.Fprintf(&sb, "    %s:\n", poly.name)
+				for i := range poly.coeffs {
+					fmt.Fprintf(&sb, "      - \"%s\"\n", &poly.coeffs[i])
+				}
+			}
+		}
+	}
+	if pp := c.pairing; pp != nil {
+		fmt.Fprintf(&sb, "pairing:\n  family: bls12\n  seed: \"%s\"\n", &pp.seed)
+		fmt.Fprintf(&sb, "tower:\n  beta: \"%d\"\n  xi: [\"%d\", \"1\"]\n", pp.beta, pp.xi0)
+		twist := "D"
+		if pp.mTwist {
+			twist = "M"
+		}
+		fmt.Fprintf(&sb, "g2:\n  # %s-twist, b': [%s, %s], cofactor: %s\n", twist, &pp.bTwist.a0, &pp.bTwist.a1, &pp.cofactor)
+		fmt.Fprintf(&sb, "  x: [\"%s\", \"%s\"]\n  y: [\"%s\", \"%s\"]\n", &pp.gx.a0, &pp.gx.a1, &pp.gy.a0, &pp.gy.a1)
+		fmt.Fprintf(&sb, "  hashToCurve:\n    map: svdw\n    z: \"%s\"\n", &pp.z.a0)
+	}
+	return sb.String()
+}
+
+// validate checks the description and computes the parameters which are not given
+func (d *Description) validate() (*curveParams, error) {
+	var c curveParams
+	c.name = d.Name
+	if !rePackageName.MatchString(c.name) || token.IsKeyword(c.name) {
+		return nil, fmt.Errorf("invalid name %q: must be a valid package name (lower case letters and digits)", c.name)
+	}
+	for _, curve := range config.Curves {
+		// the templates are specialized for some of the curves of gnark-crypto
+		if strings.ReplaceAll(curve.Name, "-", "") == c.name {
+			return nil, fmt.Errorf("invalid name %q: reserved for the curve of gnark-crypto", c.name)
+		}
+	}
+
+	if d.Pairing != nil {
+		if err := c.setFamily(d); err != nil {
+			return nil, err
+		}
+	} else {
+		if d.Tower != nil || d.G2 != nil {
+			return nil, errors.New("the tower and g2 sections need a pairing section")
+		}
+		if err := parseInt(&c.p, d.Fp, "fp"); err != nil {
+			return nil, err
+		}
+		if err := parseInt(&c.r, d.Fr, "fr"); err != nil {
+			return nil, err
+		}
+		c.cofactor.SetUint64(1)
+		if d.Cofactor != "" {
+			if err := parseInt(&c.cofactor, d.Cofactor, "cofactor"); err != nil {
+				return nil, err
+			}
+			if c.cofactor.Sign() <= 0 {
+				return nil, errors.New("cofactor: must be positive")
+			}
+		}
+	}
+	if c.p.Cmp(big.NewInt(3)) <= 0 || !c.p.ProbablyPrime(nbPrimalityRounds) {
+		return nil, errors.New("fp: the modulus must be a prime greater than 3")
+	}
+	if c.r.Cmp(big.NewInt(3)) <= 0 || !c.r.ProbablyPrime(nbPrimalityRounds) {
+		return nil, errors.New("fr: the order of the subgroup must be a prime greater than 3")
+	}
+	if c.p.Cmp(&c.r) == 0 {
+		return nil, errors.New("fr: the curve is anomalous (p = r), the discrete logarithm is easy")
+	}
+	if new(big.Int).Mod(&c.cofactor, &c.r).Sign() == 0 {
+		return nil, errors.New("cofactor: must not be divisible by r")
+	}
+
+	if d.A != "" {
+		if err := parseElement(&c.a, d.A, "a", &c.p); err != nil {
+			return nil, err
+		}
+	}
+	if c.pairing != nil && c.a.Sign() != 0 {
+		return nil, errors.New("a: must be 0 for the curves of the bls12 family")
+	}
+	if d.B == "" && c.pairing != nil {
+		c.setCoefficient()
+	} else if err := parseElement(&c.b, d.B, "b", &c.p); err != nil {
+		return nil, err
+	}
+	if isSingular(&c.a, &c.b, &c.p) {
+		return nil, errors.New("the curve is singular: 4a³ + 27b² = 0")
+	}
+
+	// the curve is of order n = h⋅r if n lies in the Hasse interval and kills a few points
+	var n, t, tt, fourP big.Int
+	n.Mul(&c.cofactor, &c.r)
+	t.Add(&c.p, big.NewInt(1)).Sub(&t, &n)
+	tt.Mul(&t, &t)
+	fourP.Lsh(&c.p, 2)
+	e := weierstrass{p: &c.p, a: &c.a, b: &c.b}
+	if tt.Cmp(&fourP) > 0 || !e.hasOrder(&n) {
+		return nil, errors.New("fr: the curve is not of order h⋅r, with h the cofactor (1 by default)")
+	}
+
+	if d.G1 == nil && c.pairing != nil {
+		c.setGenerator(&e)
+	} else {
+		if d.G1 == nil {
+			return nil, errors.New("g1: missing generator")
+		}
+		if err := parseElement(&c.gx, d.G1.X, "g1.x", &c.p); err != nil {
+			return nil, err
+		}
+		if err := parseElement(&c.gy, d.G1.Y, "g1.y", &c.p); err != nil {
+			return nil, err
+		}
+	}
+	g := &affine{x: &c.gx, y: &c.gy}
+	if !e.isOnCurve(g) {
+		return nil, errors.New("g1: the generator is not on the curve")
+	}
+	if e.scalarMul(&c.r, g) != nil {
+		return nil, errors.New("g1: the generator is not of order r")
+	}
+
+	glv := d.GLV
+	var lambda big.Int
+	if c.pairing != nil {
+		// the subgroup check of G1 is ϕ(P) = [-x₀²]⁻¹P = [x₀²-1]P
+		lambda.Mul(&c.pairing.seed, &c.pairing.seed).Sub(&lambda, big.NewInt(1)).Mod(&lambda, &c.r)
+		glv = &GLVDescription{Lambda: lambda.String()}
+		if d.GLV != nil {
+			glv.ThirdRootOne = d.GLV.ThirdRootOne
+			if d.GLV.Lambda != "" {
+				if err := parseElement(&t, d.GLV.Lambda, "glv.lambda", &c.r); err != nil {
+					return nil, err
+				}
+				if t.Cmp(&lambda) != 0 {
+					return nil, fmt.Errorf("glv.lambda: must be x₀²-1 = %s for the bls12 family", &lambda)
+				}
+			}
+		}
+	}
+	if err := c.setGLV(glv, &e, g); err != nil {
+		return nil, err
+	}
+	if err := c.setHashToCurve(d.HashToCurve, &e); err != nil {
+		return nil, err
+	}
+	if c.pairing != nil {
+		if err := c.setG2(d); err != nil {
+			return nil, err
+		}
+	}
+
+	return &c, nil
+}
+
+// setGLV sets the GLV parameters if the curve has the endomorphism (x,y) → (ωx,y)
+func (c *curveParams) setGLV(d *GLVDescription, e *weierstrass, g *affine) error {
+	var three big.Int
+	three.SetUint64(3)
+	hasEndomorphism := c.a.Sign() == 0 && new(big.Int).Mod(&c.p, &three).Uint64() == 1
+	if !hasEndomorphism {
+		if d != nil {
+			return errors.New("glv: the endomorphism (x,y) → (ωx,y) needs a = 0 and p ≡ 1 mod 3")
+		}
+		return nil
+	}
+	if d == nil {
+		d = &GLVDescription{}
+	}
+
+	omegas, err := thirdRootsOfOne(d.ThirdRootOne, "glv.thirdRootOne", &c.p)
+	if err != nil {
+		return err
+	}
+	lambdas, err := thirdRootsOfOne(d.Lambda, "glv.lambda", &c.r)
+	if err != nil {
+		return err
+	}
+
+	// ϕ(g) = [λ]g for exactly one of the pairs
+	for _, lambda := range lambdas {
+		lg := e.scalarMul(lambda, g)
+		for _, omega := range omegas {
+			var x big.Int
+			x.Mul(g.x, omega).Mod(&x, &c.p)
+			if lg != nil && lg.x.Cmp(&x) == 0 && lg.y.Cmp(g.y) == 0 {
+				c.glv = true
+				c.thirdRootOne.Set(omega)
+				c.lambda.Set(lambda)
+				return nil
+			}
+		}
+	}
+	return errors.New("glv: ϕ: (x,y) → (ωx,y) and [λ] don't match on the generator")
+}
+
+// thirdRootsOfOne returns the primitive third roots of 1 modulo q, or the given one after checking it
+func thirdRootsOfOne(s, name string, q *big.Int) ([]*big.Int, error) {
+	var one, three, e big.Int
+	one.SetUint64(1)
+	three.SetUint64(3)
+	if s != "" {
+		var w, w3 big.Int
+		if err := parseElement(&w, s, name, q); err != nil {
+			return nil, err
+		}
+		w3.Exp(&w, &three, q)
+		if w.Cmp(&one) == 0 || w3.Cmp(&one) != 0 {
+			return nil, fmt.Errorf("%s: not a primitive third root of 1", name)
+		}
+		return []*big.Int{&w}, nil
+	}
+	if new(big.Int).Mod(q, &three).Uint64() != 1 {
+		return nil, fmt.Errorf("%s: there is no third root of 1 (the modulus is not 1 mod 3)", name)
+	}
+	e.Sub(q, &one).Div(&e, &three)
+	for g := int64(2); ; g++ {
+		w := new(big.Int).Exp(big.NewInt(g), &e, q)
+		if w.Cmp(&one) != 0 {
+			w2 := new(big.Int).Mul(w, w)
+			return []*big.Int{w, w2.Mod(w2, q)}, nil
+		}
+	}
+}
+
+// setHashToCurve sets the parameters of the map to the curve
+func (c *curveParams) setHashToCurve(d *HashToCurveDescription, e *weierstrass) error {
+	if d == nil {
+		d = &HashToCurveDescription{Map: "svdw"}
+	}
+	h := &c.hash
+	var z *big.Int
+	if d.Z != "" {
+		z = new(big.Int)
+		if err := parseElement(z, d.Z, "hashToCurve.z", &c.p); err != nil {
+			return err
+		}
+	}
+
+	switch strings.ToLower(d.Map) {
+	case "svdw":
+		if d.Isogeny != nil {
+			return errors.New("hashToCurve: the Shallue-van de Woestijne map doesn't need an isogeny")
+		}
+		h.svdw = true
+		if z == nil {
+			// RFC 9380, appendix H.1
+			for ctr := int64(1); z == nil; ctr++ {
+				for _, zc := range []int64{ctr, -ctr} {
+					cand := new(big.Int).Mod(big.NewInt(zc), &c.p)
+					if e.isSvdwZ(cand) {
+						z = cand
+						break
+					}
+				}
+			}
+		} else if !e.isSvdwZ(z) {
+			return errors.New("hashToCurve.z: invalid Shallue-van de Woestijne parameter (RFC 9380, section 6.6.1)")
+		}
+		e.svdwConstants(h, z)
+	case "sswu":
+		iso := e
+		if d.Isogeny != nil {
+			var err error
+			if iso, err = c.setIsogeny(d.Isogeny, e); err != nil {
+				return err
+			}
+		}
+		if iso.a.Sign() == 0 || iso.b.Sign() == 0 {
+			return errors.New("hashToCurve: the simplified SWU map needs a ≠ 0 and b ≠ 0; give an isogeny to such a curve")
+		}
+		h.a.Set(iso.a)
+		h.b.Set(iso.b)
+		if z == nil {
+			// RFC 9380, appendix H.2
+			for ctr := int64(1); z == nil; ctr++ {
+				for _, zc := range []int64{ctr, -ctr} {
+					cand := new(big.Int).Mod(big.NewInt(zc), &c.p)
+					if iso.isSswuZ(cand) {
+						z = cand
+						break
+					}
+				}
+			}
+		} else if !iso.isSswuZ(z) {
+			return errors.New("hashToCurve.z: invalid simplified SWU parameter (RFC 9380, section 6.6.2)")
+		}
+	default:
+		return fmt.Errorf("hashToCurve.map: unknown map %q, expected svdw or sswu", d.Map)
+	}
+
+	// z is written as a small signed integer when possible
+	var half big.Int
+	half.Rsh(&c.p, 1)
+	if z.Cmp(&half) > 0 {
+		h.z.Sub(z, &c.p)
+	} else {
+		h.z.Set(z)
+	}
+	if !h.svdw && !h.z.IsInt64() {
+		return errors.New("hashToCurve.z: the simplified SWU parameter must be a small integer")
+	}
+	return nil
+}
+
+// setIsogeny parses the isogeny and checks that it maps points of the isogenous curve to e
+func (c *curveParams) setIsogeny(d *IsogenyDescription, e *weierstrass) (*weierstrass, error) {
+	iso := &weierstrass{p: e.p, a: new(big.Int), b: new(big.Int)}
+	if err := parseElement(iso.a, d.A, "hashToCurve.isogeny.a", e.p); err != nil {
+		return nil, err
+	}
+	if err := parseElement(iso.b, d.B, "hashToCurve.isogeny.b", e.p); err != nil {
+		return nil, err
+	}
+	if isSingular(iso.a, iso.b, e.p) {
+		return nil, errors.New("hashToCurve.isogeny: the isogenous curve is singular")
+	}
+	p := &isogenyParams{}
+	var err error
+	for _, poly := range []struct {
+		dst  *[]big.Int
+		src  []string
+		name string
+	}{
+		{&p.xNum, d.XNum, "xNum"},
+		{&p.xDen, d.XDen, "xDen"},
+		{&p.yNum, d.YNum, "yNum"},
+		{&p.yDen, d.YDen, "yDen"},
+	} {
+		if len(poly.src) == 0 {
+			return nil, fmt.Errorf("hashToCurve.isogeny.%s: missing coefficients", poly.name)
+		}
+		*poly.dst = make([]big.Int, len(poly.src))
+		for i, s := range poly.src {
+			if err = parseElement(&(*poly.dst)[i], s, "hashToCurve.isogeny."+poly.name, e.p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// map a few points of the isogenous curve
+	nbChecked := 0
+	for x := int64(1); nbChecked < 8; x++ {
+		var xp, y2 big.Int
+		xp.SetInt64(x)
+		iso.rhs(&y2, &xp)
+		yp := new(big.Int).ModSqrt(&y2, e.p)
+		if yp == nil {
+			continue
+		}
+		xn, xd := evalPoly(p.xNum, &xp, e.p, false), evalPoly(p.xDen, &xp, e.p, true)
+		yn, yd := evalPoly(p.yNum, &xp, e.p, false), evalPoly(p.yDen, &xp, e.p, true)
+		if xd.Sign() == 0 || yd.Sign() == 0 {
+			continue // kernel of the isogeny
+		}
+		var mx, my big.Int
+		mx.Mul(xn, xd.ModInverse(xd, e.p)).Mod(&mx, e.p)
+		my.Mul(yn, yd.ModInverse(yd, e.p)).Mul(&my, yp).Mod(&my, e.p)
+		if !e.isOnCurve(&affine{x: &mx, y: &my}) {
+			return nil, errors.New("hashToCurve.isogeny: the isogeny doesn't map the isogenous curve to the curve")
+		}
+		nbChecked++
+	}
+
+	c.hash.isogeny = p
+	return iso, nil
+}
+
+// evalPoly evaluates the polynomial of coefficients coeffs (by increasing degree) at x;
+// monic adds the leading coefficient 1.
+func evalPoly(coeffs []big.Int, x, p *big.Int, monic bool) *big.Int {
+	res := new(big.Int)
+	if monic {
+		res.SetUint64(1)
+	}
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		res.Mul(res, x).Add(res, &coeffs[i]).Mod(res, p)
+	}
+	return res
+}
+
+func parseInt(dst *big.Int, s, name string) error {
+	if s == "" {
+		return fmt.Errorf("%s: missing value", name)
+	}
+	if _, ok := dst.SetString(strings.TrimSpace(s), 0); !ok {
+		return fmt.Errorf("%s: can't parse %q as an integer", name, s)
+	}
+	return nil
+}
+
+// parseElement parses s and reduces it modulo p; negative integers are accepted
+func parseElement(dst *big.Int, s, name string, p *big.Int) error {
+	if err := parseInt(dst, s, name); err != nil {
+		return err
+	}
+	dst.Mod(dst, p)
+	return nil
+}
+
+func isSingular(a, b, p *big.Int) bool {
+	var d, t big.Int
+	d.Exp(a, big.NewInt(3), p).Lsh(&d, 2)
+	t.Mul(b, b).Mul(&t, big.NewInt(27))
+	d.Add(&d, &t).Mod(&d, p)
+	return d.Sign() == 0
+}
+
+// affine is a point of a short Weierstrass curve; nil is the point at infinity
+type affine struct {
+	x, y *big.Int
+}
+
+// weierstrass implements the arithmetic of the curve Y² = X³ + aX + b over 𝔽p, without
+// any concern for efficiency
+type weierstrass struct {
+	p, a, b *big.Int
+}
+
+// rhs sets res to x³ + ax + b
+func (e *weierstrass) rhs(res, x *big.Int) *big.Int {
+	var t big.Int
+	res.Mul(x, x).Mul(res, x)
+	t.Mul(e.a, x)
+	return res.Add(res, &t).Add(res, e.b).Mod(res, e.p)
+}
+
+func (e *weierstrass) isOnCurve(q *affine) bool {
+	var l, r big.Int
+	l.Mul(q.y, q.y).Mod(&l, e.p)
+	e.rhs(&r, q.x)
+	return l.Cmp(&r) == 0
+}
+
+func (e *weierstrass) add(p1, p2 *affine) *affine {
+	if p1 == nil {
+		return p2
+	}
+	if p2 == nil {
+		return p1
+	}
+	var l, t big.Int
+	if p1.x.Cmp(p2.x) == 0 {
+		t.Add(p1.y, p2.y).Mod(&t, e.p)
+		if t.Sign() == 0 {
+			return nil
+		}
+		// λ = (3x² + a) / 2y
+		l.Mul(p1.x, p1.x).Mul(&l, big.NewInt(3)).Add(&l, e.a)
+		t.Lsh(p1.y, 1).ModInverse(&t, e.p)
+	} else {
+		// λ = (y₂ - y₁) / (x₂ - x₁)
+		l.Sub(p2.y, p1.y)
+		t.Sub(p2.x, p1.x).Mod(&t, e.p).ModInverse(&t, e.p)
+	}
+	l.Mul(&l, &t).Mod(&l, e.p)
+	x, y := new(big.Int), new(big.Int)
+	x.Mul(&l, &l).Sub(x, p1.x).Sub(x, p2.x).Mod(x, e.p)
+	y.Sub(p1.x, x).Mul(y, &l).Sub(y, p1.y).Mod(y, e.p)
+	return &affine{x: x, y: y}
+}
+
+func (e *weierstrass) scalarMul(s *big.Int, q *affine) *affine {
+	var res *affine
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = e.add(res, res)
+		if s.Bit(i) == 1 {
+			res = e.add(res, q)
+		}
+	}
+	return res
+}
+
+// points returns the first n points (x, y) of the curve with x = 1, 2, …
+func (e *weierstrass) points(n int) []*affine {
+	res := make([]*affine, 0, n)
+	for x := int64(1); len(res) < n; x++ {
+		var xp, y2 big.Int
+		xp.SetInt64(x)
+		y := new(big.Int).ModSqrt(e.rhs(&y2, &xp), e.p)
+		if y == nil {
+			continue
+		}
+		if y.Bit(0) == 1 {
+			y.Sub(e.p, y)
+		}
+		res = append(res, &affine{x: &xp, y: y})
+	}
+	return res
+}
+
+// hasOrder returns true if [n] kills a few points of the curve
+func (e *weierstrass) hasOrder(n *big.Int) bool {
+	for _, q := range e.points(nbOrderChecks) {
+		if e.scalarMul(n, q) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *weierstrass) isSquare(x *big.Int) bool {
+	return big.Jacobi(x, e.p) >= 0
+}
+
+// isSvdwZ returns true if z satisfies the criteria of RFC 9380, section 6.6.1
+func (e *weierstrass) isSvdwZ(z *big.Int) bool {
+	var gz, h, t big.Int
+	e.rhs(&gz, z)
+	if gz.Sign() == 0 {
+		return false
+	}
+	// h(z) = -(3z² + 4a) / 4g(z)
+	h.Mul(z, z).Mul(&h, big.NewInt(3))
+	t.Lsh(e.a, 2)
+	h.Add(&h, &t).Neg(&h)
+	t.Lsh(&gz, 2).ModInverse(&t, e.p)
+	h.Mul(&h, &t).Mod(&h, e.p)
+	if h.Sign() == 0 || !e.isSquare(&h) {
+		return false
+	}
+	// g(z) or g(-z/2) is a square
+	if e.isSquare(&gz) {
+		return true
+	}
+	t.Neg(z).Mul(&t, new(big.Int).ModInverse(big.NewInt(2), e.p)).Mod(&t, e.p)
+	e.rhs(&t, &t)
+	return e.isSquare(&t)
+}
+
+// svdwConstants sets the constants of the Shallue-van de Woestijne map (RFC 9380, section 6.6.1)
+func (e *weierstrass) svdwConstants(h *hashParams, z *big.Int) {
+	var gz, d, t big.Int
+	e.rhs(&gz, z)
+	// d = 3z² + 4a
+	d.Mul(z, z).Mul(&d, big.NewInt(3))
+	t.Lsh(e.a, 2)
+	d.Add(&d, &t).Mod(&d, e.p)
+
+	// c1 = g(z)
+	h.c1.Set(&gz)
+	// c2 = -z / 2
+	h.c2.Neg(z).Mul(&h.c2, t.ModInverse(big.NewInt(2), e.p)).Mod(&h.c2, e.p)
+	// c3 = √(-g(z)⋅(3z² + 4a)), with sgn0(c3) = 0
+	t.Mul(&gz, &d).Neg(&t).Mod(&t, e.p)
+	h.c3.ModSqrt(&t, e.p)
+	if h.c3.Bit(0) == 1 {
+		h.c3.Sub(e.p, &h.c3)
+	}
+	// c4 = -4g(z) / (3z² + 4a)
+	h.c4.Lsh(&gz, 2).Neg(&h.c4).Mul(&h.c4, t.ModInverse(&d, e.p)).Mod(&h.c4, e.p)
+}
+
+// isSswuZ returns true if z satisfies the criteria of RFC 9380, section 6.6.2
+func (e *weierstrass) isSswuZ(z *big.Int) bool {
+	var t big.Int
+	// z is not a square, and z ≠ -1
+	if z.Sign() == 0 || e.isSquare(z) {
+		return false
+	}
+	t.Add(z, big.NewInt(1))
+	if t.Cmp(e.p) == 0 {
+		return false
+	}
+	// g(x) - z is irreducible: a cubic is irreducible iff it has no root
+	c0 := new(big.Int).Sub(e.b, z)
+	if e.cubicHasRoot(c0.Mod(c0, e.p)) {
+		return false
+	}
+	// g(b / (z⋅a)) is a square
+	t.Mul(z, e.a).ModInverse(&t, e.p)
+	t.Mul(&t, e.b).Mod(&t, e.p)
+	e.rhs(&t, &t)
+	return e.isSquare(&t)
+}
+
+// cubicHasRoot returns true if f = x³ + ax + c0 has a root in 𝔽p, i.e. if gcd(f, xᵖ - x) ≠ 1
+func (e *weierstrass) cubicHasRoot(c0 *big.Int) bool {
+	p := e.p
+	// polynomials of degree < 3 modulo f, by increasing degree
+	mulMod := func(u, v [3]big.Int) [3]big.Int {
+		var prod [5]big.Int
+		var t big.Int
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				t.Mul(&u[i], &v[j])
+				prod[i+j].Add(&prod[i+j], &t)
+			}
+		}
+		// x³ = -ax - c0
+		for k := 4; k >= 3; k-- {
+			prod[k].Mod(&prod[k], p)
+			t.Mul(&prod[k], e.a)
+			prod[k-2].Sub(&prod[k-2], &t)
+			t.Mul(&prod[k], c0)
+			prod[k-3].Sub(&prod[k-3], &t)
+		}
+		var res [3]big.Int
+		for i := range res {
+			res[i].Mod(&prod[i], p)
+		}
+		return res
+	}
+
+	// xᵖ mod f
+	var acc, base [3]big.Int
+	acc[0].SetUint64(1)
+	base[1].SetUint64(1)
+	for i := p.BitLen() - 1; i >= 0; i-- {
+		acc = mulMod(acc, acc)
+		if p.Bit(i) == 1 {
+			acc = mulMod(acc, base)
+		}
+	}
+	acc[1].Sub(&acc[1], big.NewInt(1)).Mod(&acc[1], p)
+
+	// gcd(f, xᵖ - x mod f)
+	f := []*big.Int{c0, e.a, big.NewInt(0), big.NewInt(1)}
+	g := []*big.Int{&acc[0], &acc[1], &acc[2]}
+	return polyGcdDegree(f, g, p) > 0
+}
+
+// polyGcdDegree returns the degree of gcd(f, g) over 𝔽p, -1 if both are zero
+func polyGcdDegree(f, g []*big.Int, p *big.Int) int {
+	trim := func(u []*big.Int) []*big.Int {
+		for len(u) > 0 && u[len(u)-1].Sign() == 0 {
+			u = u[:len(u)-1]
+		}
+		return u
+	}
+	f, g = trim(f), trim(g)
+	for len(g) > 0 {
+		// f mod g
+		r := make([]*big.Int, len(f))
+		for i := range f {
+			r[i] = new(big.Int).Set(f[i])
+		}
+		inv := new(big.Int).ModInverse(g[len(g)-1], p)
+		for len(r) >= len(g) {
+			q := new(big.Int).Mul(r[len(r)-1], inv)
+			q.Mod(q, p)
+			shift := len(r) - len(g)
+			for i := range g {
+				t := new(big.Int).Mul(q, g[i])
+				r[shift+i].Sub(r[shift+i], t).Mod(r[shift+i], p)
+			}
+			r = trim(r[:len(r)-1])
+		}
+		f, g = g, r
+	}
+	return len(f) - 1
+}
@@ -0,0 +1,158 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readTestDescription(t *testing.T, name string) *Description {
+	t.Helper()
+	d, err := ReadDescription(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	// secp256k1: the computed constants match the ones of ecc/secp256k1
+	c, err := readTestDescription(t, "k256.yaml").validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.glv {
+		t.Fatal("secp256k1 has a GLV endomorphism")
+	}
+	h := &c.hash
+	if !h.svdw || h.z.String() != "1" || h.c1.String() != "8" ||
+		h.c2.String() != "57896044618658097711785492504343953926634992332820282019728792003954417335831" ||
+		h.c3.String() != "10388779673325959979325452626823788324994718367665745800388075445979975427086" ||
+		h.c4.String() != "77194726158210796949047323339125271902179989777093709359638389338605889781098" {
+		t.Fatal("wrong Shallue-van de Woestijne constants for secp256k1")
+	}
+
+	// p256 (JSON): no endomorphism, simplified SWU on the curve itself
+	c, err = readTestDescription(t, "nistp256.json").validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.glv || c.hash.svdw || c.hash.isogeny != nil || c.hash.z.String() != "-10" {
+		t.Fatal("wrong parameters for p256")
+	}
+
+	// pallas: simplified SWU on a 3-isogenous curve
+	c, err = readTestDescription(t, "pasta.yaml").validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.glv || c.hash.svdw || c.hash.isogeny == nil || c.hash.b.String() != "1265" {
+		t.Fatal("wrong parameters for pallas")
+	}
+
+	// the printed parameters are a valid description
+	d := readTestDescription(t, "pasta.yaml")
+	d.GLV = &GLVDescription{ThirdRootOne: c.thirdRootOne.String(), Lambda: c.lambda.String()}
+	if _, err := d.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(c.String(), "lambda: \""+c.lambda.String()+"\"") {
+		t.Fatal("the GLV parameters should be printed")
+	}
+
+	// bls12: the seed of BLS12-381 gives its curve, tower and twist
+	c, err = readTestDescription(t, "bls12.yaml").validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pp := c.pairing
+	if c.b.String() != "4" || c.cofactor.String() != "76329603384216526031706109802092473003" ||
+		pp.beta != -1 || pp.xi0 != 1 || !pp.mTwist || !c.hash.svdw {
+		t.Fatal("wrong parameters for the seed of bls12-381")
+	}
+
+	// bls12: the seed of BLS12-377 gives its curve, tower and twist
+	d = readTestDescription(t, "bls12.yaml")
+	d.Pairing.Seed = "0x8508c00000000001"
+	c, err = d.validate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pp = c.pairing
+	if c.b.String() != "1" || pp.beta != -5 || pp.xi0 != 0 || pp.mTwist {
+		t.Fatal("wrong parameters for the seed of bls12-377")
+	}
+
+	// the printed parameters are a valid description
+	if !strings.Contains(c.String(), "beta: \"-5\"") {
+		t.Fatal("the tower parameters should be printed")
+	}
+	d.Tower = &TowerDescription{Beta: "-5", Xi: []string{"0", "1"}}
+	d.G2 = &G2Description{X: e2Strings(pp.gx), Y: e2Strings(pp.gy)}
+	if _, err := d.validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		file   string
+		modify func(d *Description)
+		err    string
+	}{
+		{"invalid name", "k256.yaml", func(d *Description) { d.Name = "K-256" }, "invalid name"},
+		{"keyword", "k256.yaml", func(d *Description) { d.Name = "func" }, "invalid name"},
+		{"reserved name", "k256.yaml", func(d *Description) { d.Name = "bls12381" }, "reserved"},
+		{"composite modulus", "k256.yaml", func(d *Description) { d.Fp = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2d" }, "fp:"},
+		{"unparsable", "k256.yaml", func(d *Description) { d.B = "seven" }, "can't parse"},
+		{"singular", "k256.yaml", func(d *Description) { d.B = "0" }, "singular"},
+		{"not on curve", "k256.yaml", func(d *Description) { d.G1.Y = "1" }, "not on the curve"},
+		{"wrong order", "k256.yaml", func(d *Description) { d.Fr = "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364143" }, "fr:"},
+		{"glv without endomorphism", "nistp256.json", func(d *Description) { d.GLV = &GLVDescription{} }, "glv:"},
+		{"wrong lambda", "k256.yaml", func(d *Description) { d.GLV = &GLVDescription{Lambda: "2"} }, "glv.lambda"},
+		{"unknown map", "k256.yaml", func(d *Description) { d.HashToCurve = &HashToCurveDescription{Map: "elligator"} }, "unknown map"},
+		{"invalid svdw z", "k256.yaml", func(d *Description) { d.HashToCurve = &HashToCurveDescription{Map: "svdw", Z: "-7"} }, "hashToCurve.z"},
+		{"sswu without isogeny", "k256.yaml", func(d *Description) { d.HashToCurve = &HashToCurveDescription{Map: "sswu"} }, "isogeny"},
+		{"invalid sswu z", "nistp256.json", func(d *Description) { d.HashToCurve.Z = "4" }, "hashToCurve.z"},
+		{"wrong isogeny", "pasta.yaml", func(d *Description) { d.HashToCurve.Isogeny.XNum[0] = "1" }, "isogeny"},
+		{"tower without pairing", "k256.yaml", func(d *Description) { d.Tower = &TowerDescription{} }, "need a pairing section"},
+		{"unknown family", "bls12.yaml", func(d *Description) { d.Pairing.Family = "bn" }, "pairing.family"},
+		{"seed not 1 mod 3", "bls12.yaml", func(d *Description) { d.Pairing.Seed = "-0xd201000000010001" }, "pairing.seed"},
+		{"fp not of the family", "bls12.yaml", func(d *Description) { d.Fp = "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f" }, "fp:"},
+		{"a not 0", "bls12.yaml", func(d *Description) { d.A = "1" }, "a:"},
+		{"wrong beta", "bls12.yaml", func(d *Description) { d.Tower = &TowerDescription{Beta: "-2"} }, "tower.beta"},
+		{"wrong xi", "bls12.yaml", func(d *Description) { d.Tower = &TowerDescription{Xi: []string{"1", "2"}} }, "tower.xi"},
+		{"g2 not on the twist", "bls12.yaml", func(d *Description) { d.G2 = &G2Description{X: []string{"1", "0"}, Y: []string{"1", "0"}} }, "g2:"},
+		{"wrong bls12 lambda", "bls12.yaml", func(d *Description) { d.GLV = &GLVDescription{Lambda: "2"} }, "glv.lambda"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := readTestDescription(t, tc.file)
+			tc.modify(d)
+			_, err := d.validate()
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command gnark-crypto-gen generates the package of an elliptic curve from its description, in any Go module.
+//
+// The description is a JSON or YAML file, describing a short Weierstrass curve Y² = X³ + aX + b over 𝔽p
+// and its subgroup of prime order r:
+//
+//	name: k256
+//	fp: "0xfffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f"
+//	fr: "0xfffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"
+//	a: "0"
+//	b: "7"
+//	g1:
+//	  x: "0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+//	  y: "0x483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+//
+// The parameters are validated before generation: the moduli are prime, the curve is not singular, the
+// generator is on the curve and of order r, and h⋅r is in the Hasse interval of p, where h is the
+// optional cofactor (1 by default). The constants of the GLV endomorphism (for j=0 curves) and of the
+// hash to curve (Shallue-van de Woestijne by default, or simplified SWU with an optional isogeny) are
+// computed when they are not given, and checked otherwise; --check prints them.
+//
+// A pairing-friendly curve of the BLS12 family is described by its seed x₀:
+//
+//	name: bls12
+//	pairing:
+//	  family: bls12
+//	  seed: "-0xd201000000010000"
+//
+// The curve (fp, fr, b, cofactor, g1), the extension tower 𝔽p² → 𝔽p⁶ → 𝔽p¹² (tower.beta, tower.xi)
+// and G2 on the sextic twist (g2) are derived from the seed, or checked when they are given.
+//
+//	gnark-crypto-gen -d k256.yaml -o ./k256/
+//
+// generates, as for the curves of gnark-crypto, the fp and fr packages, G1 (GLV when available),
+// the multi-exponentiation, the hash to curve and the Encoder/Decoder; and for a BLS12 curve, G2, the
+// extension tower (in the internal/fptower sub-package) and the optimal Ate pairing. The generated
+// code depends on gnark-crypto, except for the internal package parallel, which is copied in the
+// output directory.
+package main
+
+import "github.com/consensys/gnark-crypto/cmd/gnark-crypto-gen/cmd"
+
+func main() {
+	cmd.Execute()
+}
@@ -47,7 +47,16 @@
 //	q[base10] = 258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177
 //	q[base16] = 0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f1ef3622fba094800170b5d44300000008508c00000000001
 //
+// # Constant time
+//
+// Inverse, Exp, Sqrt and Legendre branch on their inputs and are meant for public values.
+// InverseCT, ExpCT, SqrtCT and LegendreCT are their constant-time counterparts, for secret values:
+// the sequence of operations and memory accesses doesn't depend on the input (nor on the bits of the exponent in ExpCT),
+// at the cost of a slower running time. The rule in this library is that a code path handling secrets (the signature
+// schemes) uses the CT variants for these operations; the other methods (Add, Mul, ...) make no such guarantee.
+// The harness in internal/dudect measures the CT variants; it is meant to be run locally.
+//
 // # Warning
 //
-// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance, beyond the best effort of the CT variants above.
 package fp
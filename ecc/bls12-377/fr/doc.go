@@ -47,7 +47,16 @@
 //	q[base10] = 8444461749428370424248824938781546531375899335154063827935233455917409239041
 //	q[base16] = 0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a11800000000001
 //
+// # Constant time
+//
+// Inverse, Exp, Sqrt and Legendre branch on their inputs and are meant for public values.
+// InverseCT, ExpCT, SqrtCT and LegendreCT are their constant-time counterparts, for secret values:
+// the sequence of operations and memory accesses doesn't depend on the input (nor on the bits of the exponent in ExpCT),
+// at the cost of a slower running time. The rule in this library is that a code path handling secrets (the signature
+// schemes) uses the CT variants for these operations; the other methods (Add, Mul, ...) make no such guarantee.
+// The harness in internal/dudect measures the CT variants; it is meant to be run locally.
+//
 // # Warning
 //
-// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance, beyond the best effort of the CT variants above.
 package fr
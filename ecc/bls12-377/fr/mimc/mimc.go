@@ -65,7 +65,7 @@ func NewMiMC() hash.Hash {
 // Reset resets the Hash to its initial state.
 func (d *digest) Reset() {
 	d.data = d.data[:0]
-	d.h = fr.Element{0, 0, 0, 0}
+	d.h.SetZero()
 }
 
 // Sum appends the current hash to b and returns the resulting slice.
@@ -152,7 +152,7 @@ func (d *digest) encrypt(m fr.Element) fr.Element {
 
 	var tmp fr.Element
 	for i := 0; i < mimcNbRounds; i++ {
-		// m = (m+k+c)^**17
+		// m = (m+k+c)^17
 		tmp.Add(&m, &d.h).Add(&tmp, &mimcConstants[i])
 		m.Square(&tmp).
 			Square(&m).
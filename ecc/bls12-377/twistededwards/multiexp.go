@@ -0,0 +1,669 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/big"
+	"runtime"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MultiExp computes ∑ᵢ [scalars[i]]points[i], see PointExtended.MultiExp, and sets p to the result.
+func (p *PointAffine) MultiExp(points []PointAffine, scalars []big.Int, config ecc.MultiExpConfig) (*PointAffine, error) {
+	var _p PointExtended
+	if _, err := _p.MultiExp(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromExtended(&_p)
+	return p, nil
+}
+
+// MultiExp computes ∑ᵢ [scalars[i]]points[i] with the bucket method of Pippenger, and sets p to the result.
+//
+// The scalars are reduced modulo the order of the curve h⋅r, so that the points don't need to be in the
+// prime order subgroup, and may be negative. They are split in
+// c-bit signed windows; each window is processed in parallel, with 2^{c-1} buckets in extended coordinates
+// for the small windows, and in affine coordinates on the birationally equivalent Montgomery curve for the
+// large ones (see processChunkBatchAffine).
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *PointExtended) MultiExp(points []PointAffine, scalars []big.Int, config ecc.MultiExpConfig) (*PointExtended, error) {
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	initOnce.Do(initCurveParams)
+
+	words, nbBits := scalarsToWords(scalars, config.NbTasks)
+	return p.multiExp(points, words, nbBits, bestC(nbPoints, nbBits), config), nil
+}
+
+// multiExp computes ∑ᵢ [scalars[i]]points[i] with c-bit windows, where the scalars are given as
+// little-endian words, and are at most nbBits long.
+func (p *PointExtended) multiExp(points []PointAffine, scalars [][fr.Limbs]uint64, nbBits int, c uint64, config ecc.MultiExpConfig) *PointExtended {
+	p.setInfinity()
+	if nbBits == 0 {
+		return p
+	}
+
+	nbChunks := computeNbChunks(c, nbBits)
+	digits := partitionScalars(scalars, c, nbChunks, config.NbTasks)
+
+	// the additions in the buckets take the points in the form matching the bucket coordinates,
+	// computed once for all the windows
+	var processChunk func(chRes chan<- PointExtended, c uint64, digits []uint16, sem chan struct{})
+	if c < batchAffineMinC {
+		_points := toPrecomputed(points, config.NbTasks)
+		processChunk = func(chRes chan<- PointExtended, c uint64, digits []uint16, sem chan struct{}) {
+			processChunkExtended(chRes, c, _points, digits, sem)
+		}
+	} else {
+		_points := toMontgomery(points, config.NbTasks)
+		processChunk = func(chRes chan<- PointExtended, c uint64, digits []uint16, sem chan struct{}) {
+			processChunkBatchAffine(chRes, c, points, _points, digits, sem)
+		}
+	}
+
+	// if we don't have enough tasks for all the windows, we limit the number of go routines
+	// running at the same time with a semaphore
+	var sem chan struct{}
+	if config.NbTasks < nbChunks {
+		sem = make(chan struct{}, config.NbTasks)
+		for i := 0; i < config.NbTasks; i++ {
+			sem <- struct{}{}
+		}
+	}
+
+	n := len(points)
+	chChunks := make([]chan PointExtended, nbChunks)
+	for j := nbChunks - 1; j >= 0; j-- {
+		chChunks[j] = make(chan PointExtended, 1)
+		go processChunk(chChunks[j], c, digits[j*n:(j+1)*n], sem)
+	}
+
+	// reduce the weighted sums of the windows into the result
+	total := <-chChunks[nbChunks-1]
+	for j := nbChunks - 2; j >= 0; j-- {
+		for l := uint64(0); l < c; l++ {
+			total.Double(&total)
+		}
+		s := <-chChunks[j]
+		total.Add(&total, &s)
+	}
+
+	return p.Set(&total)
+}
+
+// batchAffineMinC is the smallest window size for which the buckets are accumulated with batch affine
+// additions; below, the number of buckets is too small to fill the batches without conflicts, and the
+// saved multiplications don't make up for the bookkeeping.
+const batchAffineMinC = 12
+
+// bestC returns the window size minimizing the approximate cost (in group operations) of a multiExp
+// of nbPoints with nbBits-bit scalars, nbChunks ⋅ (nbPoints + 2^c).
+func bestC(nbPoints int, nbBits int) uint64 {
+	var C uint64
+	min := math.MaxFloat64
+	// c <= 15 so that the digits (and their sign) fit in an uint16
+	for c := uint64(2); c <= 15; c++ {
+		cost := float64(computeNbChunks(c, nbBits)) * float64(nbPoints+(1<<c))
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
+// computeNbChunks returns the number of c-bit windows of the nbBits-bit scalars; the most significant
+// window has a spare bit for the carry of the signed digits.
+func computeNbChunks(c uint64, nbBits int) int {
+	return (nbBits + int(c)) / int(c)
+}
+
+// scalarsToWords reduces the scalars modulo the order of the curve h⋅r, and returns them as little-endian
+// words along with their maximum bit length.
+func scalarsToWords(scalars []big.Int, nbTasks int) ([][fr.Limbs]uint64, int) {
+	// h⋅r may be larger than the modulus of fr, but fits in fr.Limbs words
+	var order big.Int
+	curveParams.Cofactor.BigInt(&order)
+	order.Mul(&order, &curveParams.Order)
+
+	words := make([][fr.Limbs]uint64, len(scalars))
+	nbBits := make([]int, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		var s big.Int
+		var buf [fr.Limbs * 8]byte
+		for i := start; i < end; i++ {
+			reduce(&s, &scalars[i], &order)
+			s.FillBytes(buf[:])
+			for j := 0; j < fr.Limbs; j++ {
+				words[i][j] = binary.BigEndian.Uint64(buf[(fr.Limbs-1-j)*8:])
+			}
+			nbBits[i] = s.BitLen()
+		}
+	}, nbTasks)
+
+	max := 0
+	for _, b := range nbBits {
+		if b > max {
+			max = b
+		}
+	}
+	return words, max
+}
+
+// reduce sets s to scalar mod order.
+func reduce(s, scalar, order *big.Int) {
+	if scalar.Sign() >= 0 && scalar.Cmp(order) < 0 {
+		s.Set(scalar)
+		return
+	}
+	s.Mod(scalar, order)
+}
+
+// partitionScalars computes, for each scalar, the signed digits of its nbChunks c-bit windows:
+// if a digit is larger than 2^{c-1}, then, we borrow 2^c from the next window and subtract 2^{c} to the
+// current digit, making it negative. The digits are stored window by window, as digit << 1 if
+// the digit is positive and (-digit-1) << 1 + 1 if it is negative.
+func partitionScalars(scalars [][fr.Limbs]uint64, c uint64, nbChunks int, nbTasks int) []uint16 {
+	// no benefit here to have more tasks than CPUs
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+
+	digits := make([]uint16, len(scalars)*nbChunks)
+	mask := uint64((1 << c) - 1)
+	max := int(1<<(c-1)) - 1
+
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			scalar := &scalars[i]
+			var carry int
+			for chunk := 0; chunk < nbChunks; chunk++ {
+				// digit = value of the c-bit window, plus the carry of the previous one
+				digit := carry
+				carry = 0
+				jc := uint64(chunk) * c
+				if index := jc / 64; index < fr.Limbs {
+					shift := jc % 64
+					w := scalar[index] >> shift
+					if shift > 64-c && index < fr.Limbs-1 {
+						// we are selecting bits over 2 words
+						w |= scalar[index+1] << (64 - shift)
+					}
+					digit += int(w & mask)
+				}
+
+				// the most significant window has a spare bit and never borrows
+				if digit > max && chunk != nbChunks-1 {
+					digit -= (1 << c)
+					carry = 1
+				}
+
+				var bits uint16
+				if digit >= 0 {
+					bits = uint16(digit) << 1
+				} else {
+					bits = (uint16(-digit-1) << 1) + 1
+				}
+				digits[chunk*len(scalars)+i] = bits
+			}
+		}
+	}, nbTasks)
+
+	return digits
+}
+
+// precomputedPoint is an affine point with d⋅x⋅y precomputed, for the mixed additions in the buckets.
+type precomputedPoint struct {
+	X, Y, DT fr.Element
+}
+
+// toPrecomputed returns the points with d⋅x⋅y precomputed.
+func toPrecomputed(points []PointAffine, nbTasks int) []precomputedPoint {
+	res := make([]precomputedPoint, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].X = points[i].X
+			res[i].Y = points[i].Y
+			res[i].DT.Mul(&points[i].X, &points[i].Y).Mul(&res[i].DT, &curveParams.D)
+		}
+	}, nbTasks)
+	return res
+}
+
+// addPrecomputed sets p to p + q, or p - q if neg is set, with the unified mixed addition (8M)
+// See https://hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-madd-2008-hwcd
+func (p *PointExtended) addPrecomputed(q *precomputedPoint, neg bool) *PointExtended {
+	var A, B, C, E, F, G, H, tmp fr.Element
+	A.Mul(&p.X, &q.X)
+	B.Mul(&p.Y, &q.Y)
+	C.Mul(&p.T, &q.DT)
+	if neg {
+		// -q = (-x, y, -d⋅x⋅y)
+		A.Neg(&A)
+		C.Neg(&C)
+		E.Sub(&q.Y, &q.X)
+	} else {
+		E.Add(&q.X, &q.Y)
+	}
+	tmp.Add(&p.X, &p.Y)
+	E.Mul(&E, &tmp).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	F.Sub(&p.Z, &C)
+	G.Add(&p.Z, &C)
+	H.Set(&A)
+	mulByA(&H)
+	H.Sub(&B, &H)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
+
+// processChunkExtended processes a window of the scalars during the msm, with the buckets in extended
+// coordinates, and sends the weighted sum of the buckets to chRes.
+func processChunkExtended(chRes chan<- PointExtended, c uint64, points []precomputedPoint, digits []uint16, sem chan struct{}) {
+	if sem != nil {
+		// if we are limited, wait for a token in the semaphore
+		<-sem
+	}
+
+	buckets := make([]PointExtended, 1<<(c-1))
+	for i := range buckets {
+		buckets[i].setInfinity()
+	}
+
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+
+		// if msbWindow bit is set, we need to subtract
+		if digit&1 == 0 {
+			// add
+			buckets[(digit>>1)-1].addPrecomputed(&points[i], false)
+		} else {
+			// sub
+			buckets[(digit>>1)].addPrecomputed(&points[i], true)
+		}
+	}
+
+	total := reduceBuckets(buckets, func(k int, runningSum *PointExtended) {
+		runningSum.Add(runningSum, &buckets[k])
+	})
+
+	if sem != nil {
+		// release a token to the semaphore
+		// before sending to chRes
+		sem <- struct{}{}
+	}
+
+	chRes <- total
+}
+
+// reduceBuckets returns the weighted sum of the buckets
+// total = bucket[0] + 2*bucket[1] + 3*bucket[2] ... + n*bucket[n-1]
+// where addBucket adds the bucket k to the running sum.
+func reduceBuckets(buckets []PointExtended, addBucket func(k int, runningSum *PointExtended)) PointExtended {
+	var runningSum, total PointExtended
+	runningSum.setInfinity()
+	total.setInfinity()
+	for k := len(buckets) - 1; k >= 0; k-- {
+		addBucket(k, &runningSum)
+		total.Add(&total, &runningSum)
+	}
+	return total
+}
+
+// pointMontgomery is a point (u/B, v/B) of the Montgomery curve B⋅v² = u³ + A⋅u² + u, birationally
+// equivalent to the twisted Edwards curve with A = 2(a+d)/(a-d) and B = 4/(a-d). The coordinates are
+// scaled by 1/B, so that the curve equation is y² = x³ + (A/B)⋅x² + x/B², and the affine addition
+// needs a single inversion and no multiplication by B.
+type pointMontgomery struct {
+	X, Y fr.Element
+}
+
+var montgomeryOnce sync.Once
+
+// montgomeryParams holds (a-d)/4 = 1/B, and (a+d)/2 = A/B
+var montgomeryParams struct {
+	invB, AdivB fr.Element
+}
+
+func initMontgomeryParams() {
+	var tmp fr.Element
+	montgomeryParams.invB.Sub(&curveParams.A, &curveParams.D)
+	tmp.SetUint64(4)
+	tmp.Inverse(&tmp)
+	montgomeryParams.invB.Mul(&montgomeryParams.invB, &tmp)
+
+	montgomeryParams.AdivB.Add(&curveParams.A, &curveParams.D)
+	tmp.SetUint64(2)
+	tmp.Inverse(&tmp)
+	montgomeryParams.AdivB.Mul(&montgomeryParams.AdivB, &tmp)
+}
+
+// toMontgomery returns the points on the Montgomery curve, (x, y) ↦ ((1+y)/(1-y), (1+y)/((1-y)⋅x)) / B,
+// with a single inversion per task. The points with x = 0, for which the map is not defined, are left to (0, 0),
+// the only affine point with y = 0 that is the image of an affine twisted Edwards point, (0, -1).
+func toMontgomery(points []PointAffine, nbTasks int) []pointMontgomery {
+	montgomeryOnce.Do(initMontgomeryParams)
+
+	res := make([]pointMontgomery, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		// (1-y)⋅x is stored in res[i].X, then inverted
+		den := make([]fr.Element, end-start)
+		var one fr.Element
+		one.SetOne()
+		for i := start; i < end; i++ {
+			den[i-start].Sub(&one, &points[i].Y).Mul(&den[i-start], &points[i].X)
+		}
+		den = fr.BatchInvert(den)
+		for i := start; i < end; i++ {
+			if points[i].X.IsZero() {
+				continue
+			}
+			// Y = (1+y)/((1-y)⋅x) / B and X = Y⋅x
+			res[i].Y.Add(&one, &points[i].Y).
+				Mul(&res[i].Y, &den[i-start]).
+				Mul(&res[i].Y, &montgomeryParams.invB)
+			res[i].X.Mul(&res[i].Y, &points[i].X)
+		}
+	}, nbTasks)
+	return res
+}
+
+// fromMontgomery sets p to the twisted Edwards point (x, y) = (X/Y, (X-1/B)/(X+1/B)) of q (4M).
+func (p *PointExtended) fromMontgomery(q *pointMontgomery) *PointExtended {
+	if q.Y.IsZero() {
+		// (0, 0) ↦ (0, -1); the other points of order 2 map to points at infinity of the
+		// twisted Edwards curve, that are not on the affine chart
+		p.X.SetZero()
+		p.Y.SetOne().Neg(&p.Y)
+		p.Z.SetOne()
+		p.T.SetZero()
+		return p
+	}
+	var s, t fr.Element
+	s.Add(&q.X, &montgomeryParams.invB)
+	t.Sub(&q.X, &montgomeryParams.invB)
+	p.X.Mul(&q.X, &s)
+	p.Y.Mul(&q.Y, &t)
+	p.Z.Mul(&q.Y, &s)
+	p.T.Mul(&q.X, &t)
+	return p
+}
+
+// batchAddMontgomery sets R[j] to R[j] + P[j] for all j, using batch inversion; lambda and lambdain are
+// scratch space of len(R). The special cases (doubling, opposite points) must be filtered out before this call.
+func batchAddMontgomery(R []*pointMontgomery, P []pointMontgomery, lambda, lambdain []fr.Element) {
+	batchSize := len(R)
+	if batchSize == 0 {
+		return
+	}
+
+	for j := 0; j < batchSize; j++ {
+		lambdain[j].Sub(&P[j].X, &R[j].X)
+	}
+
+	// invert denominator using montgomery batch invert technique
+	{
+		var accumulator fr.Element
+		lambda[0].SetOne()
+		accumulator.Set(&lambdain[0])
+
+		for i := 1; i < batchSize; i++ {
+			lambda[i] = accumulator
+			accumulator.Mul(&accumulator, &lambdain[i])
+		}
+
+		accumulator.Inverse(&accumulator)
+
+		for i := batchSize - 1; i > 0; i-- {
+			lambda[i].Mul(&lambda[i], &accumulator)
+			accumulator.Mul(&accumulator, &lambdain[i])
+		}
+		lambda[0].Set(&accumulator)
+	}
+
+	var d fr.Element
+	for j := 0; j < batchSize; j++ {
+		// compute lambda
+		d.Sub(&P[j].Y, &R[j].Y)
+		lambda[j].Mul(&lambda[j], &d)
+
+		// compute X, Y
+		d.Set(&R[j].X)
+		R[j].X.Square(&lambda[j])
+		R[j].X.Sub(&R[j].X, &montgomeryParams.AdivB)
+		R[j].X.Sub(&R[j].X, &d)
+		R[j].X.Sub(&R[j].X, &P[j].X)
+		d.Sub(&d, &R[j].X)
+		d.Mul(&lambda[j], &d)
+		R[j].Y.Sub(&d, &R[j].Y)
+	}
+}
+
+// batchOp is an addition of the point i (or its opposite) to a bucket, postponed after a conflict.
+type batchOp struct {
+	bucketID uint16
+	isAdd    bool
+	i        int
+}
+
+// batchSize returns the number of affine additions sharing an inversion, for a window of size c.
+func batchSize(c uint64) int {
+	// larger batches means fewer inversions, but more conflicts when the number of buckets is small
+	switch c {
+	case 12:
+		return 200
+	case 13:
+		return 350
+	case 14:
+		return 400
+	default:
+		return 500
+	}
+}
+
+// processChunkBatchAffine processes a window of the scalars during the msm, with the buckets in affine
+// coordinates on the Montgomery curve (mPoints are the points on this curve), and sends the weighted
+// sum of the buckets to chRes. To amortize the cost of the inverse in the affine addition we use a batch
+// affine addition, as in the multiExp of the short Weierstrass curves; an affine addition on the twisted
+// Edwards curve would need two inversions.
+//
+// The additions that can't be batched (doublings, points with x = 0) are done in a second set of buckets,
+// in extended coordinates.
+func processChunkBatchAffine(chRes chan<- PointExtended, c uint64, points []PointAffine, mPoints []pointMontgomery, digits []uint16, sem chan struct{}) {
+	if sem != nil {
+		// if we are limited, wait for a token in the semaphore
+		<-sem
+	}
+
+	// the batch affine addition needs independent points; if a bucket is already in the current batch,
+	// the point is pushed to a queue, and tentatively put in the next batch.
+	// if the queue is full, we flush it in the buckets in extended coordinates.
+	nbBuckets := 1 << (c - 1)
+	buckets := make([]pointMontgomery, nbBuckets)
+	isSet := make([]bool, nbBuckets) // the zero value of pointMontgomery is a point of the curve
+	bucketsE := make([]PointExtended, nbBuckets)
+	for i := range bucketsE {
+		bucketsE[i].setInfinity()
+	}
+
+	batchSize := batchSize(c)
+	var (
+		bucketIds = make([]bool, nbBuckets) // signify presence of a bucket in current batch
+		R         = make([]*pointMontgomery, 0, batchSize)
+		P         = make([]pointMontgomery, 0, batchSize)
+		queue     = make([]batchOp, 0, batchSize)
+		lambda    = make([]fr.Element, batchSize)
+		lambdain  = make([]fr.Element, batchSize)
+	)
+
+	executeAndReset := func() {
+		batchAddMontgomery(R, P, lambda, lambdain)
+		for i := range bucketIds {
+			bucketIds[i] = false
+		}
+		R = R[:0]
+		P = P[:0]
+	}
+
+	// addExtended adds the point i (or its opposite) to the bucket in extended coordinates
+	addExtended := func(op batchOp) {
+		var q PointExtended
+		q.FromAffine(&points[op.i])
+		if !op.isAdd {
+			q.Neg(&q)
+		}
+		bucketsE[op.bucketID].Add(&bucketsE[op.bucketID], &q)
+	}
+
+	add := func(op batchOp) {
+		// @precondition: the bucket is not in the current batch
+		var q pointMontgomery
+		q.X = mPoints[op.i].X
+		if op.isAdd {
+			q.Y = mPoints[op.i].Y
+		} else {
+			q.Y.Neg(&mPoints[op.i].Y)
+		}
+
+		// handle special cases with inf or -P / P
+		BK := &buckets[op.bucketID]
+		if !isSet[op.bucketID] {
+			*BK = q
+			isSet[op.bucketID] = true
+			return
+		}
+		if BK.X.Equal(&q.X) {
+			if BK.Y.Equal(&q.Y) {
+				// P + P: doubling, which should be quite rare
+				addExtended(op)
+				return
+			}
+			// P - P
+			isSet[op.bucketID] = false
+			return
+		}
+
+		bucketIds[op.bucketID] = true
+		R = append(R, BK)
+		P = append(P, q)
+	}
+
+	flushQueue := func() {
+		for _, op := range queue {
+			addExtended(op)
+		}
+		queue = queue[:0]
+	}
+
+	processTopQueue := func() {
+		for len(queue) != 0 {
+			op := queue[len(queue)-1]
+			if bucketIds[op.bucketID] {
+				return
+			}
+			add(op)
+			// len(queue) < batchSize so no need to check for full batch.
+			queue = queue[:len(queue)-1]
+		}
+	}
+
+	for i, digit := range digits {
+		if digit == 0 {
+			continue
+		}
+
+		op := batchOp{bucketID: digit >> 1, isAdd: digit&1 == 0, i: i}
+		if op.isAdd {
+			op.bucketID--
+		}
+
+		if mPoints[i].Y.IsZero() {
+			// (0, 1) and (0, -1) have no image on the Montgomery curve
+			addExtended(op)
+			continue
+		}
+
+		if bucketIds[op.bucketID] {
+			// put it in queue
+			queue = append(queue, op)
+			// queue is full, flush it.
+			if len(queue) == batchSize-1 {
+				flushQueue()
+			}
+			continue
+		}
+
+		// we add the point to the batch.
+		add(op)
+		if len(R) == batchSize {
+			executeAndReset()
+			processTopQueue()
+		}
+	}
+
+	// flush items in batch.
+	executeAndReset()
+
+	// empty the queue
+	flushQueue()
+
+	total := reduceBuckets(bucketsE, func(k int, runningSum *PointExtended) {
+		if isSet[k] {
+			var q PointExtended
+			q.fromMontgomery(&buckets[k])
+			runningSum.Add(runningSum, &q)
+		}
+		if !bucketsE[k].IsZero() {
+			runningSum.Add(runningSum, &bucketsE[k])
+		}
+	})
+
+	if sem != nil {
+		// release a token to the semaphore
+		// before sending to chRes
+		sem <- struct{}{}
+	}
+
+	chRes <- total
+}
@@ -47,7 +47,16 @@
 //	q[base10] = 605248206075306171733248481581800960739847691770924913753520744034740935903401304776283802348837311170974282940417
 //	q[base16] = 0x3eeb0416684d19053cb5d240ed107a284059eb647102326980dc360d0a49d7fce97f76a822c00009948a20000000001
 //
+// # Constant time
+//
+// Inverse, Exp, Sqrt and Legendre branch on their inputs and are meant for public values.
+// InverseCT, ExpCT, SqrtCT and LegendreCT are their constant-time counterparts, for secret values:
+// the sequence of operations and memory accesses doesn't depend on the input (nor on the bits of the exponent in ExpCT),
+// at the cost of a slower running time. The rule in this library is that a code path handling secrets (the signature
+// schemes) uses the CT variants for these operations; the other methods (Add, Mul, ...) make no such guarantee.
+// The harness in internal/dudect measures the CT variants; it is meant to be run locally.
+//
 // # Warning
 //
-// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance, beyond the best effort of the CT variants above.
 package fp
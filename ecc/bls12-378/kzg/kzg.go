@@ -45,6 +45,17 @@ type Digest = bls12378.G1Affine
 // ProvingKey used to create or open commitments
 type ProvingKey struct {
 	G1 []bls12378.G1Affine // [G₁ [α]G₁ , [α²]G₁, ... ]
+
+	// G1Table, if set, holds precomputed multiples of G1 used by Commit, see Precompute.
+	// It is not part of the binary encoding of the ProvingKey, and can be serialized separately.
+	G1Table *bls12378.G1MultiExpTable
+}
+
+// Precompute sets pk.G1Table to a table of multiples of pk.G1 of at most maxPoints points
+// (unbounded if maxPoints <= 0), so that the subsequent commitments are faster.
+// See bls12378.NewG1MultiExpTable.
+func (pk *ProvingKey) Precompute(maxPoints int) {
+	pk.G1Table = bls12378.NewG1MultiExpTable(pk.G1, maxPoints)
 }
 
 // VerifyingKey used to verify opening proofs
@@ -167,6 +178,8 @@ type BatchOpeningProof struct {
 
 // Commit commits to a polynomial using a multi exponentiation with the SRS.
 // It is assumed that the polynomial is in canonical form, in Montgomery form.
+//
+// If pk.G1Table is set, it is used instead of pk.G1.
 func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
 
 	if len(p) == 0 || len(p) > len(pk.G1) {
@@ -179,6 +192,12 @@ func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
 	if len(nbTasks) > 0 {
 		config.NbTasks = nbTasks[0]
 	}
+	if pk.G1Table != nil && len(p) <= pk.G1Table.Len() {
+		if _, err := res.MultiExpPrecomputed(pk.G1Table, p, config); err != nil {
+			return Digest{}, err
+		}
+		return res, nil
+	}
 	if _, err := res.MultiExp(pk.G1[:len(p)], p, config); err != nil {
 		return Digest{}, err
 	}
@@ -186,6 +205,37 @@ func Commit(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
 	return res, nil
 }
 
+// CommitBatch commits to several polynomials at once, see Commit. The multi exponentiations
+// share their resources, see bls12378.G1Jac.MultiExpBatch; pk.G1Table is not used.
+func CommitBatch(p [][]fr.Element, pk ProvingKey, nbTasks ...int) ([]Digest, error) {
+
+	// the polynomials are padded with zeroes to the same size
+	size := 0
+	for i := range p {
+		if len(p[i]) == 0 || len(p[i]) > len(pk.G1) {
+			return nil, ErrInvalidPolynomialSize
+		}
+		if len(p[i]) > size {
+			size = len(p[i])
+		}
+	}
+	scalars := make([][]fr.Element, len(p))
+	for i := range p {
+		scalars[i] = p[i]
+		if len(p[i]) < size {
+			scalars[i] = make([]fr.Element, size)
+			copy(scalars[i], p[i])
+		}
+	}
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	var res bls12378.G1Affine
+	return res.MultiExpBatch(pk.G1[:size], scalars, config)
+}
+
 // Open computes an opening proof of polynomial p at given point.
 // fft.Domain Cardinality must be larger than p.Degree()
 func Open(p []fr.Element, point fr.Element, pk ProvingKey) (OpeningProof, error) {
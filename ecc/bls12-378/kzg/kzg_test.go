@@ -196,6 +196,53 @@ func TestCommit(t *testing.T) {
 
 }
 
+func TestCommitPrecomputed(t *testing.T) {
+	assert := require.New(t)
+
+	f := randomPolynomial(60)
+
+	expected, err := Commit(f, testSrs.Pk)
+	assert.NoError(err)
+
+	pk := testSrs.Pk
+	pk.Precompute(4 * len(pk.G1))
+	digest, err := Commit(f, pk)
+	assert.NoError(err)
+	assert.True(expected.Equal(&digest), "commitment with the precomputed table doesn't match")
+
+	// an uninitialized table is ignored
+	pkEmpty := testSrs.Pk
+	pkEmpty.G1Table = &bls12378.G1MultiExpTable{}
+	digest, err = Commit(f, pkEmpty)
+	assert.NoError(err)
+	assert.True(expected.Equal(&digest), "commitment with an empty table doesn't match")
+
+	// an opening proof with the table verifies
+	var point fr.Element
+	point.SetRandom()
+	proof, err := Open(f, point, pk)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, testSrs.Vk))
+}
+
+func TestCommitBatch(t *testing.T) {
+	assert := require.New(t)
+
+	polynomials := [][]fr.Element{randomPolynomial(60), randomPolynomial(1), randomPolynomial(200)}
+
+	digests, err := CommitBatch(polynomials, testSrs.Pk)
+	assert.NoError(err)
+	assert.Equal(len(polynomials), len(digests))
+	for i := range polynomials {
+		expected, err := Commit(polynomials[i], testSrs.Pk)
+		assert.NoError(err)
+		assert.True(expected.Equal(&digests[i]), "batch commitment %d doesn't match", i)
+	}
+
+	_, err = CommitBatch([][]fr.Element{randomPolynomial(60), nil}, testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
 func TestVerifySinglePoint(t *testing.T) {
 
 	// create a polynomial
@@ -471,6 +518,18 @@ func BenchmarkKZGCommit(b *testing.B) {
 		// random polynomial
 		p := randomPolynomial(benchSize / 2)
 
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = Commit(p, srs.Pk)
+		}
+	})
+	b.Run("precomputed SRS", func(b *testing.B) {
+		srs, err := NewSRS(ecc.NextPowerOfTwo(benchSize), new(big.Int).SetInt64(42))
+		assert.NoError(b, err)
+		srs.Pk.Precompute(0)
+		// random polynomial
+		p := randomPolynomial(benchSize / 2)
+
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
 			_, _ = Commit(p, srs.Pk)
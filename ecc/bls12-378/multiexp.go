@@ -22,7 +22,10 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
 	"github.com/consensys/gnark-crypto/internal/parallel"
 	"math"
+	"math/big"
+	"math/bits"
 	"runtime"
+	"sync"
 )
 
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
@@ -37,10 +40,186 @@ func (p *G1Affine) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.
 	return p, nil
 }
 
+// MultiExpUint8 computes ∑ᵢ [scalars[i]]points[i], see G1Jac.MultiExpUint64.
+func (p *G1Affine) MultiExpUint8(points []G1Affine, scalars []uint8, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpUint8(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpUint32 computes ∑ᵢ [scalars[i]]points[i], see G1Jac.MultiExpUint64.
+func (p *G1Affine) MultiExpUint32(points []G1Affine, scalars []uint32, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpUint32(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpUint64 computes ∑ᵢ [scalars[i]]points[i], see G1Jac.MultiExpUint64.
+func (p *G1Affine) MultiExpUint64(points []G1Affine, scalars []uint64, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpUint64(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpInt64 computes ∑ᵢ [scalars[i]]points[i], see G1Jac.MultiExpInt64.
+func (p *G1Affine) MultiExpInt64(points []G1Affine, scalars []int64, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpInt64(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpBatch computes the multiExps ∑ᵢ [scalars[k][i]]points[i] for all k, and returns them; p is not used.
+// See G1Jac.MultiExpBatch.
+func (p *G1Affine) MultiExpBatch(points []G1Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G1Affine, error) {
+	var _p G1Jac
+	res, err := _p.MultiExpBatch(points, scalars, config)
+	if err != nil {
+		return nil, err
+	}
+	return BatchJacobianToAffineG1(res), nil
+}
+
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
 //
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
+//
+// If config.GLV is set, the scalars are first split along the endomorphism of the curve, see MultiExpGLV.
 func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// small scalars (e.g. bits or small integers, positive or negative) take a faster path,
+	// and the windows above the largest scalar are skipped
+	nbBits, small := scalarsBitLen(scalars, config.NbTasks)
+	if small {
+		return multiExpSmallG1(p, points, smallScalars(scalars, config.NbTasks), config)
+	}
+
+	if config.GLV {
+		return p.MultiExpGLV(newG1GLVBases(points, config.NbTasks), scalars, config)
+	}
+
+	return p.multiExp(points, scalars, nil, nbBits, config), nil
+}
+
+// MultiExpUint8 computes ∑ᵢ [scalars[i]]points[i], see MultiExpUint64.
+func (p *G1Jac) MultiExpUint8(points []G1Affine, scalars []uint8, config ecc.MultiExpConfig) (*G1Jac, error) {
+	return multiExpSmallG1(p, points, scalars, config)
+}
+
+// MultiExpUint32 computes ∑ᵢ [scalars[i]]points[i], see MultiExpUint64.
+func (p *G1Jac) MultiExpUint32(points []G1Affine, scalars []uint32, config ecc.MultiExpConfig) (*G1Jac, error) {
+	return multiExpSmallG1(p, points, scalars, config)
+}
+
+// MultiExpUint64 computes ∑ᵢ [scalars[i]]points[i] for small scalars.
+//
+// The points with scalars 0 and 1 are not processed by the buckets of the MultiExp, and
+// the windows above the largest scalar are skipped.
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *G1Jac) MultiExpUint64(points []G1Affine, scalars []uint64, config ecc.MultiExpConfig) (*G1Jac, error) {
+	return multiExpSmallG1(p, points, scalars, config)
+}
+
+// MultiExpInt64 computes ∑ᵢ [scalars[i]]points[i] for small signed scalars, see MultiExpUint64.
+// The negative scalars are processed with the opposite points.
+func (p *G1Jac) MultiExpInt64(points []G1Affine, scalars []int64, config ecc.MultiExpConfig) (*G1Jac, error) {
+	return multiExpSmallG1(p, points, scalars, config)
+}
+
+// multiExpSmallG1 computes the multiExp of small scalars: the points with scalars 0 and ±1 are
+// summed apart, and the other ones go through the multiExp with the windows above the largest scalar skipped.
+func multiExpSmallG1[T smallScalar](p *G1Jac, points []G1Affine, scalars []T, config ecc.MultiExpConfig) (*G1Jac, error) {
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// the points with a negative scalar are negated, in a copy
+	_points := points
+	for i := range scalars {
+		if scalars[i] < 0 {
+			_points = make([]G1Affine, nbPoints)
+			copy(_points, points)
+			break
+		}
+	}
+
+	_scalars := make([]fr.Element, nbPoints)
+	var lock sync.Mutex
+	var ones g1JacExtended
+	ones.setInfinity()
+	nbBits := 0
+	parallel.Execute(nbPoints, func(start, end int) {
+		var sum g1JacExtended
+		sum.setInfinity()
+		maxBits := 0
+		for i := start; i < end; i++ {
+			s := uint64(scalars[i])
+			if scalars[i] < 0 {
+				s = -s
+				_points[i].Neg(&points[i])
+			}
+			if s == 1 {
+				sum.addMixed(&_points[i])
+				continue
+			}
+			if l := bits.Len64(s); l > maxBits {
+				maxBits = l
+			}
+			_scalars[i].SetUint64(s)
+		}
+		lock.Lock()
+		ones.add(&sum)
+		if maxBits > nbBits {
+			nbBits = maxBits
+		}
+		lock.Unlock()
+	}, config.NbTasks)
+
+	p.fromJacExtended(&ones)
+	if nbBits != 0 {
+		var _p G1Jac
+		_p.multiExp(_points, _scalars, nil, uint64(nbBits), config)
+		p.AddAssign(&_p)
+	}
+	return p, nil
+}
+
+// multiExp computes the multiExp of scalars of at most nbBits bits, see MultiExp.
+// len(points) == len(scalars) and config is valid. If neg is not nil, the scalars[i] with neg[i] set are negated.
+func (p *G1Jac) multiExp(points []G1Affine, scalars []fr.Element, neg []bool, nbBits uint64, config ecc.MultiExpConfig) *G1Jac {
 	// TODO @gbotrel replace the ecc.MultiExpConfig by a Option pattern for maintainability.
 	// note:
 	// each of the msmCX method is the same, except for the c constant it declares
@@ -68,43 +247,12 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 	// step 3
 	// reduce the buckets weighed sums into our result (msmReduceChunk)
 
-	// ensure len(points) == len(scalars)
 	nbPoints := len(points)
-	if nbPoints != len(scalars) {
-		return nil, errors.New("len(points) != len(scalars)")
-	}
-
-	// if nbTasks is not set, use all available CPUs
-	if config.NbTasks <= 0 {
-		config.NbTasks = runtime.NumCPU() * 2
-	} else if config.NbTasks > 1024 {
-		return nil, errors.New("invalid config: config.NbTasks > 1024")
-	}
 
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
-	bestC := func(nbPoints int) uint64 {
-		// implemented msmC methods (the c we use must be in this slice)
-		implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
-		var C uint64
-		// approximate cost (in group operations)
-		// cost = bits/c * (nbPoints + 2^{c})
-		// this needs to be verified empirically.
-		// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
-		min := math.MaxFloat64
-		for _, c := range implementedCs {
-			cc := (fr.Bits + 1) * (nbPoints + (1 << c))
-			cost := float64(cc) / float64(c)
-			if cost < min {
-				min = cost
-				C = c
-			}
-		}
-		return C
-	}
-
-	C := bestC(nbPoints)
-	nbChunks := int(computeNbChunks(C))
+	C := bestCG1(nbPoints, nbBits)
+	nbChunks := int(computeNbChunksBits(C, nbBits))
 
 	// should we recursively split the msm in half? (see below)
 	// we want to minimize the execution time of the algorithm;
@@ -131,36 +279,154 @@ func (p *G1Jac) MultiExp(points []G1Affine, scalars []fr.Element, config ecc.Mul
 
 	costPreSplit := costFunction(nbChunks, config.NbTasks, costPerTask(C, nbPoints))
 
-	cPostSplit := bestC(nbPoints / 2)
-	nbChunksPostSplit := int(computeNbChunks(cPostSplit))
+	cPostSplit := bestCG1(nbPoints/2, nbBits)
+	nbChunksPostSplit := int(computeNbChunksBits(cPostSplit, nbBits))
 	costPostSplit := costFunction(nbChunksPostSplit*2, config.NbTasks, costPerTask(cPostSplit, nbPoints/2))
 
 	// if the cost of the split msm is lower than the cost of the non split msm, we split
 	if costPostSplit < costPreSplit {
 		config.NbTasks = int(math.Ceil(float64(config.NbTasks) / 2.0))
+		var neg0, neg1 []bool
+		if neg != nil {
+			neg0, neg1 = neg[:nbPoints/2], neg[nbPoints/2:]
+		}
 		var _p G1Jac
 		chDone := make(chan struct{}, 1)
 		go func() {
-			_p.MultiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
+			_p.multiExp(points[:nbPoints/2], scalars[:nbPoints/2], neg0, nbBits, config)
 			close(chDone)
 		}()
-		p.MultiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
+		p.multiExp(points[nbPoints/2:], scalars[nbPoints/2:], neg1, nbBits, config)
 		<-chDone
 		p.AddAssign(&_p)
-		return p, nil
+		return p
 	}
 
 	// if we don't split, we use the best C we found
-	_innerMsmG1(p, C, points, scalars, config)
+	return _innerMsmG1(p, C, points, scalars, neg, nbBits, config)
+}
 
-	return p, nil
+// bestCG1 returns the window size minimizing the approximate cost of a multiExp
+// of nbPoints points with scalars of at most nbBits bits.
+func bestCG1(nbPoints int, nbBits uint64) uint64 {
+	// implemented msmC methods (the c we use must be in this slice)
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var C uint64
+	// approximate cost (in group operations)
+	// cost = bits/c * (nbPoints + 2^{c})
+	// this needs to be verified empirically.
+	// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
+	min := math.MaxFloat64
+	for _, c := range implementedCs {
+		cc := int(nbBits+1) * (nbPoints + (1 << c))
+		cost := float64(cc) / float64(c)
+		if nbBits < fr.Bits {
+			// the scalars are short, count the windows that are processed
+			cost = float64(computeNbChunksBits(c, nbBits)) * float64(nbPoints+(1<<c))
+		}
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
+}
+
+// MultiExpBatch computes the multiExps ∑ᵢ [scalars[k][i]]points[i] for all k, and returns them; p is not used.
+//
+// The multiExps share the window size and a pool of config.NbTasks go routines, see MultiExp. Each task
+// processes the same window of a group of multiExps: it loads each point once and adds it to the buckets
+// of all the multiExps of the group, and the batch affine additions (and their inversions) are shared by
+// the group. config.GLV is ignored.
+//
+// This call return an error if len(scalars[k]) != len(points) or if provided config is invalid.
+func (p *G1Jac) MultiExpBatch(points []G1Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G1Jac, error) {
+	// ensure len(points) == len(scalars[k])
+	nbPoints := len(points)
+	for k := range scalars {
+		if len(scalars[k]) != nbPoints {
+			return nil, errors.New("len(points) != len(scalars[k])")
+		}
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	c := bestCG1(nbPoints, fr.Bits)
+	nbChunks := int(computeNbChunks(c))
+
+	digits := make([][]uint16, len(scalars))
+	chunkStats := make([][]chunkStat, len(scalars))
+	chChunks := make([][]chan g1JacExtended, len(scalars))
+	for k := range scalars {
+		digits[k], chunkStats[k] = partitionScalars(scalars[k], nil, c, fr.Bits, config.NbTasks)
+		chChunks[k] = make([]chan g1JacExtended, nbChunks)
+		for j := range chChunks[k] {
+			chChunks[k][j] = make(chan g1JacExtended, 1)
+		}
+	}
+
+	// a task holds the buckets of all the multiExps of its group; the buckets are accessed at random, and
+	// sharing the points is only worth it if they stay in the cache, so we bound their total number
+	const maxBuckets = 1 << 13
+	windowSize := c
+	if lastC(c) > c {
+		windowSize = lastC(c)
+	}
+	groupSize := maxBuckets >> (windowSize - 1)
+	if groupSize < 1 {
+		groupSize = 1
+	}
+
+	// each task processes a chunk of a group of multiExps, at most config.NbTasks at once
+	sem := make(chan struct{}, config.NbTasks)
+	for start := 0; start < len(scalars); start += groupSize {
+		end := start + groupSize
+		if end > len(scalars) {
+			end = len(scalars)
+		}
+		for j := nbChunks - 1; j >= 0; j-- {
+			windowSize := c
+			if j == nbChunks-1 {
+				windowSize = lastC(c)
+			}
+			chRes := make([]chan g1JacExtended, end-start)
+			groupDigits := make([][]uint16, end-start)
+			nbBucketFilled := 0
+			for k := start; k < end; k++ {
+				chRes[k-start] = chChunks[k][j]
+				groupDigits[k-start] = digits[k][j*nbPoints : (j+1)*nbPoints]
+				nbBucketFilled += chunkStats[k][j].nbBucketFilled
+			}
+			processChunk := getChunkProcessorMultiG1(windowSize, nbBucketFilled)
+			sem <- struct{}{}
+			go func(j int, windowSize uint64, chRes []chan g1JacExtended, groupDigits [][]uint16) {
+				processChunk(uint64(j), chRes, windowSize, points, groupDigits)
+				<-sem
+			}(j, windowSize, chRes, groupDigits)
+		}
+	}
+
+	res := make([]G1Jac, len(scalars))
+	parallel.Execute(len(res), func(start, end int) {
+		for k := start; k < end; k++ {
+			msmReduceChunkG1Affine(&res[k], int(c), chChunks[k])
+		}
+	})
+	return res, nil
 }
 
-func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
+func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, neg []bool, nbBits uint64, config ecc.MultiExpConfig) *G1Jac {
 	// partition the scalars
-	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	digits, chunkStats := partitionScalars(scalars, neg, c, nbBits, config.NbTasks)
 
-	nbChunks := computeNbChunks(c)
+	nbChunks := uint64(len(chunkStats))
+	// the last chunk is larger only if the scalars use all the bits
+	hasLastC := nbChunks == computeNbChunks(c)
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
 	// corresponding bit-window
@@ -190,7 +456,7 @@ func _innerMsmG1(p *G1Jac, c uint64, points []G1Affine, scalars []fr.Element, co
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := getChunkProcessorG1(c, chunkStats[j])
-		if j == int(nbChunks-1) {
+		if j == int(nbChunks-1) && hasLastC {
 			processChunk = getChunkProcessorG1(lastC(c), chunkStats[j])
 		}
 		if chunkStats[j].weight >= 115 {
@@ -309,6 +575,49 @@ func getChunkProcessorG1(c uint64, stat chunkStat) func(chunkID uint64, chRes ch
 	}
 }
 
+// getChunkProcessorMultiG1 returns the algorithm processing a chunk of a group of multiExps,
+// see getChunkProcessorG1; nbBucketFilled is the number of buckets hit by the group.
+func getChunkProcessorMultiG1(c uint64, nbBucketFilled int) func(chunkID uint64, chRes []chan g1JacExtended, c uint64, points []G1Affine, digits [][]uint16) {
+	switch c {
+	case 10:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 80 {
+			return processChunkG1BatchAffineMulti[pG1AffineC10, ppG1AffineC10, cG1AffineC10]
+		}
+	case 11:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 150 {
+			return processChunkG1BatchAffineMulti[pG1AffineC11, ppG1AffineC11, cG1AffineC11]
+		}
+	case 12:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 200 {
+			return processChunkG1BatchAffineMulti[pG1AffineC12, ppG1AffineC12, cG1AffineC12]
+		}
+	case 13:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 350 {
+			return processChunkG1BatchAffineMulti[pG1AffineC13, ppG1AffineC13, cG1AffineC13]
+		}
+	case 14:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 400 {
+			return processChunkG1BatchAffineMulti[pG1AffineC14, ppG1AffineC14, cG1AffineC14]
+		}
+	case 15:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 500 {
+			return processChunkG1BatchAffineMulti[pG1AffineC15, ppG1AffineC15, cG1AffineC15]
+		}
+	case 16:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 640 {
+			return processChunkG1BatchAffineMulti[pG1AffineC16, ppG1AffineC16, cG1AffineC16]
+		}
+	}
+	return processChunkG1JacobianMulti
+}
+
 // msmReduceChunkG1Affine reduces the weighted sum of the buckets into the result of the multiExp
 func msmReduceChunkG1Affine(p *G1Jac, c int, chChunks []chan g1JacExtended) *G1Jac {
 	var _p g1JacExtended
@@ -325,6 +634,112 @@ func msmReduceChunkG1Affine(p *G1Jac, c int, chChunks []chan g1JacExtended) *G1J
 	return p.unsafeFromJacExtended(&_p)
 }
 
+// nbGLVG1 is the number of images of a base used by MultiExpGLV: P and ϕ(P) (GLV).
+const nbGLVG1 = 2
+
+// G1GLVBases holds bases and their images under the endomorphism of the curve, used by
+// MultiExpGLV, so that the images aren't recomputed at each multiExp on the same bases.
+type G1GLVBases struct {
+	// points[nbGLVG1*i+j] is the j-th image of the i-th base
+	points []G1Affine
+}
+
+// NewG1GLVBases returns the bases and their images under the endomorphism of the curve:
+// P and ϕ(P).
+func NewG1GLVBases(bases []G1Affine) *G1GLVBases {
+	return newG1GLVBases(bases, runtime.NumCPU())
+}
+
+func newG1GLVBases(bases []G1Affine, nbTasks int) *G1GLVBases {
+	const d = nbGLVG1
+	points := make([]G1Affine, d*len(bases))
+	parallel.Execute(len(bases), func(start, end int) {
+		for i := start; i < end; i++ {
+			points[d*i] = bases[i]
+			points[d*i+1].phi(&bases[i])
+		}
+	}, nbTasks)
+	return &G1GLVBases{points: points}
+}
+
+// Len returns the number of bases.
+func (b *G1GLVBases) Len() int {
+	return len(b.points) / nbGLVG1
+}
+
+// MultiExpGLV computes ∑ᵢ [scalars[i]]Pᵢ where Pᵢ are the first len(scalars) bases, see MultiExpConfig.GLV.
+//
+// This call return an error if len(scalars) > bases.Len() or if provided config is invalid.
+func (p *G1Affine) MultiExpGLV(bases *G1GLVBases, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpGLV(bases, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpGLV computes ∑ᵢ [scalars[i]]Pᵢ where Pᵢ are the first len(scalars) bases, see MultiExpConfig.GLV.
+//
+// The scalars are split along the endomorphism, see splitScalarsG1: the multiExp runs on
+// 2 times more points with shorter scalars, and the signs of the
+// components are taken into account in the digits of the scalars, so that the bases aren't copied.
+//
+// This call return an error if len(scalars) > bases.Len() or if provided config is invalid.
+func (p *G1Jac) MultiExpGLV(bases *G1GLVBases, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	nbPoints := len(scalars)
+	if nbPoints > bases.Len() {
+		return nil, errors.New("len(scalars) > bases.Len()")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	_scalars, neg, nbBits := splitScalarsG1(scalars, config.NbTasks)
+	return p.multiExp(bases.points[:nbGLVG1*nbPoints], _scalars, neg, nbBits, config), nil
+}
+
+// splitScalarsG1 decomposes each scalar s as s = k₁ + λk₂ (see ecc.SplitScalar), with k₁, k₂
+// about half the size of r, and returns the scalars |k₁|, |k₂|, whether k₁, k₂ are negative and their maximum
+// bit length (GLV).
+func splitScalarsG1(scalars []fr.Element, nbTasks int) ([]fr.Element, []bool, uint64) {
+	_scalars := make([]fr.Element, 2*len(scalars))
+	neg := make([]bool, 2*len(scalars))
+
+	var lock sync.Mutex
+	nbBits := 0
+	parallel.Execute(len(scalars), func(start, end int) {
+		var s big.Int
+		var k [2]big.Int
+		var buf [3]big.Int
+		maxBits := 0
+		for i := start; i < end; i++ {
+			ecc.SplitScalarTo(&k, &buf, scalars[i].BigInt(&s), &glvBasis)
+			for j := 0; j < 2; j++ {
+				if k[j].Sign() == -1 {
+					k[j].Neg(&k[j])
+					neg[2*i+j] = true
+				}
+				if l := k[j].BitLen(); l > maxBits {
+					maxBits = l
+				}
+				_scalars[2*i+j].SetBigInt(&k[j])
+			}
+		}
+		lock.Lock()
+		if maxBits > nbBits {
+			nbBits = maxBits
+		}
+		lock.Unlock()
+	}, nbTasks)
+
+	return _scalars, neg, uint64(nbBits)
+}
+
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
 //
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
@@ -337,10 +752,186 @@ func (p *G2Affine) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.
 	return p, nil
 }
 
+// MultiExpUint8 computes ∑ᵢ [scalars[i]]points[i], see G2Jac.MultiExpUint64.
+func (p *G2Affine) MultiExpUint8(points []G2Affine, scalars []uint8, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpUint8(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpUint32 computes ∑ᵢ [scalars[i]]points[i], see G2Jac.MultiExpUint64.
+func (p *G2Affine) MultiExpUint32(points []G2Affine, scalars []uint32, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpUint32(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpUint64 computes ∑ᵢ [scalars[i]]points[i], see G2Jac.MultiExpUint64.
+func (p *G2Affine) MultiExpUint64(points []G2Affine, scalars []uint64, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpUint64(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpInt64 computes ∑ᵢ [scalars[i]]points[i], see G2Jac.MultiExpInt64.
+func (p *G2Affine) MultiExpInt64(points []G2Affine, scalars []int64, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpInt64(points, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpBatch computes the multiExps ∑ᵢ [scalars[k][i]]points[i] for all k, and returns them; p is not used.
+// See G2Jac.MultiExpBatch.
+func (p *G2Affine) MultiExpBatch(points []G2Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G2Affine, error) {
+	var _p G2Jac
+	res, err := _p.MultiExpBatch(points, scalars, config)
+	if err != nil {
+		return nil, err
+	}
+	return BatchJacobianToAffineG2(res), nil
+}
+
 // MultiExp implements section 4 of https://eprint.iacr.org/2012/549.pdf
 //
 // This call return an error if len(scalars) != len(points) or if provided config is invalid.
+//
+// If config.GLV is set, the scalars are first split along the endomorphism of the curve, see MultiExpGLV.
 func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// small scalars (e.g. bits or small integers, positive or negative) take a faster path,
+	// and the windows above the largest scalar are skipped
+	nbBits, small := scalarsBitLen(scalars, config.NbTasks)
+	if small {
+		return multiExpSmallG2(p, points, smallScalars(scalars, config.NbTasks), config)
+	}
+
+	if config.GLV {
+		return p.MultiExpGLV(newG2GLVBases(points, config.NbTasks), scalars, config)
+	}
+
+	return p.multiExp(points, scalars, nil, nbBits, config), nil
+}
+
+// MultiExpUint8 computes ∑ᵢ [scalars[i]]points[i], see MultiExpUint64.
+func (p *G2Jac) MultiExpUint8(points []G2Affine, scalars []uint8, config ecc.MultiExpConfig) (*G2Jac, error) {
+	return multiExpSmallG2(p, points, scalars, config)
+}
+
+// MultiExpUint32 computes ∑ᵢ [scalars[i]]points[i], see MultiExpUint64.
+func (p *G2Jac) MultiExpUint32(points []G2Affine, scalars []uint32, config ecc.MultiExpConfig) (*G2Jac, error) {
+	return multiExpSmallG2(p, points, scalars, config)
+}
+
+// MultiExpUint64 computes ∑ᵢ [scalars[i]]points[i] for small scalars.
+//
+// The points with scalars 0 and 1 are not processed by the buckets of the MultiExp, and
+// the windows above the largest scalar are skipped.
+//
+// This call return an error if len(scalars) != len(points) or if provided config is invalid.
+func (p *G2Jac) MultiExpUint64(points []G2Affine, scalars []uint64, config ecc.MultiExpConfig) (*G2Jac, error) {
+	return multiExpSmallG2(p, points, scalars, config)
+}
+
+// MultiExpInt64 computes ∑ᵢ [scalars[i]]points[i] for small signed scalars, see MultiExpUint64.
+// The negative scalars are processed with the opposite points.
+func (p *G2Jac) MultiExpInt64(points []G2Affine, scalars []int64, config ecc.MultiExpConfig) (*G2Jac, error) {
+	return multiExpSmallG2(p, points, scalars, config)
+}
+
+// multiExpSmallG2 computes the multiExp of small scalars: the points with scalars 0 and ±1 are
+// summed apart, and the other ones go through the multiExp with the windows above the largest scalar skipped.
+func multiExpSmallG2[T smallScalar](p *G2Jac, points []G2Affine, scalars []T, config ecc.MultiExpConfig) (*G2Jac, error) {
+	// ensure len(points) == len(scalars)
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// the points with a negative scalar are negated, in a copy
+	_points := points
+	for i := range scalars {
+		if scalars[i] < 0 {
+			_points = make([]G2Affine, nbPoints)
+			copy(_points, points)
+			break
+		}
+	}
+
+	_scalars := make([]fr.Element, nbPoints)
+	var lock sync.Mutex
+	var ones g2JacExtended
+	ones.setInfinity()
+	nbBits := 0
+	parallel.Execute(nbPoints, func(start, end int) {
+		var sum g2JacExtended
+		sum.setInfinity()
+		maxBits := 0
+		for i := start; i < end; i++ {
+			s := uint64(scalars[i])
+			if scalars[i] < 0 {
+				s = -s
+				_points[i].Neg(&points[i])
+			}
+			if s == 1 {
+				sum.addMixed(&_points[i])
+				continue
+			}
+			if l := bits.Len64(s); l > maxBits {
+				maxBits = l
+			}
+			_scalars[i].SetUint64(s)
+		}
+		lock.Lock()
+		ones.add(&sum)
+		if maxBits > nbBits {
+			nbBits = maxBits
+		}
+		lock.Unlock()
+	}, config.NbTasks)
+
+	p.fromJacExtended(&ones)
+	if nbBits != 0 {
+		var _p G2Jac
+		_p.multiExp(_points, _scalars, nil, uint64(nbBits), config)
+		p.AddAssign(&_p)
+	}
+	return p, nil
+}
+
+// multiExp computes the multiExp of scalars of at most nbBits bits, see MultiExp.
+// len(points) == len(scalars) and config is valid. If neg is not nil, the scalars[i] with neg[i] set are negated.
+func (p *G2Jac) multiExp(points []G2Affine, scalars []fr.Element, neg []bool, nbBits uint64, config ecc.MultiExpConfig) *G2Jac {
 	// TODO @gbotrel replace the ecc.MultiExpConfig by a Option pattern for maintainability.
 	// note:
 	// each of the msmCX method is the same, except for the c constant it declares
@@ -368,43 +959,12 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 	// step 3
 	// reduce the buckets weighed sums into our result (msmReduceChunk)
 
-	// ensure len(points) == len(scalars)
 	nbPoints := len(points)
-	if nbPoints != len(scalars) {
-		return nil, errors.New("len(points) != len(scalars)")
-	}
-
-	// if nbTasks is not set, use all available CPUs
-	if config.NbTasks <= 0 {
-		config.NbTasks = runtime.NumCPU() * 2
-	} else if config.NbTasks > 1024 {
-		return nil, errors.New("invalid config: config.NbTasks > 1024")
-	}
 
 	// here, we compute the best C for nbPoints
 	// we split recursively until nbChunks(c) >= nbTasks,
-	bestC := func(nbPoints int) uint64 {
-		// implemented msmC methods (the c we use must be in this slice)
-		implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
-		var C uint64
-		// approximate cost (in group operations)
-		// cost = bits/c * (nbPoints + 2^{c})
-		// this needs to be verified empirically.
-		// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
-		min := math.MaxFloat64
-		for _, c := range implementedCs {
-			cc := (fr.Bits + 1) * (nbPoints + (1 << c))
-			cost := float64(cc) / float64(c)
-			if cost < min {
-				min = cost
-				C = c
-			}
-		}
-		return C
-	}
-
-	C := bestC(nbPoints)
-	nbChunks := int(computeNbChunks(C))
+	C := bestCG2(nbPoints, nbBits)
+	nbChunks := int(computeNbChunksBits(C, nbBits))
 
 	// should we recursively split the msm in half? (see below)
 	// we want to minimize the execution time of the algorithm;
@@ -431,36 +991,154 @@ func (p *G2Jac) MultiExp(points []G2Affine, scalars []fr.Element, config ecc.Mul
 
 	costPreSplit := costFunction(nbChunks, config.NbTasks, costPerTask(C, nbPoints))
 
-	cPostSplit := bestC(nbPoints / 2)
-	nbChunksPostSplit := int(computeNbChunks(cPostSplit))
+	cPostSplit := bestCG2(nbPoints/2, nbBits)
+	nbChunksPostSplit := int(computeNbChunksBits(cPostSplit, nbBits))
 	costPostSplit := costFunction(nbChunksPostSplit*2, config.NbTasks, costPerTask(cPostSplit, nbPoints/2))
 
 	// if the cost of the split msm is lower than the cost of the non split msm, we split
 	if costPostSplit < costPreSplit {
 		config.NbTasks = int(math.Ceil(float64(config.NbTasks) / 2.0))
+		var neg0, neg1 []bool
+		if neg != nil {
+			neg0, neg1 = neg[:nbPoints/2], neg[nbPoints/2:]
+		}
 		var _p G2Jac
 		chDone := make(chan struct{}, 1)
 		go func() {
-			_p.MultiExp(points[:nbPoints/2], scalars[:nbPoints/2], config)
+			_p.multiExp(points[:nbPoints/2], scalars[:nbPoints/2], neg0, nbBits, config)
 			close(chDone)
 		}()
-		p.MultiExp(points[nbPoints/2:], scalars[nbPoints/2:], config)
+		p.multiExp(points[nbPoints/2:], scalars[nbPoints/2:], neg1, nbBits, config)
 		<-chDone
 		p.AddAssign(&_p)
-		return p, nil
+		return p
 	}
 
 	// if we don't split, we use the best C we found
-	_innerMsmG2(p, C, points, scalars, config)
+	return _innerMsmG2(p, C, points, scalars, neg, nbBits, config)
+}
 
-	return p, nil
+// bestCG2 returns the window size minimizing the approximate cost of a multiExp
+// of nbPoints points with scalars of at most nbBits bits.
+func bestCG2(nbPoints int, nbBits uint64) uint64 {
+	// implemented msmC methods (the c we use must be in this slice)
+	implementedCs := []uint64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var C uint64
+	// approximate cost (in group operations)
+	// cost = bits/c * (nbPoints + 2^{c})
+	// this needs to be verified empirically.
+	// for example, on a MBP 2016, for G2 MultiExp > 8M points, hand picking c gives better results
+	min := math.MaxFloat64
+	for _, c := range implementedCs {
+		cc := int(nbBits+1) * (nbPoints + (1 << c))
+		cost := float64(cc) / float64(c)
+		if nbBits < fr.Bits {
+			// the scalars are short, count the windows that are processed
+			cost = float64(computeNbChunksBits(c, nbBits)) * float64(nbPoints+(1<<c))
+		}
+		if cost < min {
+			min = cost
+			C = c
+		}
+	}
+	return C
 }
 
-func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
+// MultiExpBatch computes the multiExps ∑ᵢ [scalars[k][i]]points[i] for all k, and returns them; p is not used.
+//
+// The multiExps share the window size and a pool of config.NbTasks go routines, see MultiExp. Each task
+// processes the same window of a group of multiExps: it loads each point once and adds it to the buckets
+// of all the multiExps of the group, and the batch affine additions (and their inversions) are shared by
+// the group. config.GLV is ignored.
+//
+// This call return an error if len(scalars[k]) != len(points) or if provided config is invalid.
+func (p *G2Jac) MultiExpBatch(points []G2Affine, scalars [][]fr.Element, config ecc.MultiExpConfig) ([]G2Jac, error) {
+	// ensure len(points) == len(scalars[k])
+	nbPoints := len(points)
+	for k := range scalars {
+		if len(scalars[k]) != nbPoints {
+			return nil, errors.New("len(points) != len(scalars[k])")
+		}
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	c := bestCG2(nbPoints, fr.Bits)
+	nbChunks := int(computeNbChunks(c))
+
+	digits := make([][]uint16, len(scalars))
+	chunkStats := make([][]chunkStat, len(scalars))
+	chChunks := make([][]chan g2JacExtended, len(scalars))
+	for k := range scalars {
+		digits[k], chunkStats[k] = partitionScalars(scalars[k], nil, c, fr.Bits, config.NbTasks)
+		chChunks[k] = make([]chan g2JacExtended, nbChunks)
+		for j := range chChunks[k] {
+			chChunks[k][j] = make(chan g2JacExtended, 1)
+		}
+	}
+
+	// a task holds the buckets of all the multiExps of its group; the buckets are accessed at random, and
+	// sharing the points is only worth it if they stay in the cache, so we bound their total number
+	const maxBuckets = 1 << 13
+	windowSize := c
+	if lastC(c) > c {
+		windowSize = lastC(c)
+	}
+	groupSize := maxBuckets >> (windowSize - 1)
+	if groupSize < 1 {
+		groupSize = 1
+	}
+
+	// each task processes a chunk of a group of multiExps, at most config.NbTasks at once
+	sem := make(chan struct{}, config.NbTasks)
+	for start := 0; start < len(scalars); start += groupSize {
+		end := start + groupSize
+		if end > len(scalars) {
+			end = len(scalars)
+		}
+		for j := nbChunks - 1; j >= 0; j-- {
+			windowSize := c
+			if j == nbChunks-1 {
+				windowSize = lastC(c)
+			}
+			chRes := make([]chan g2JacExtended, end-start)
+			groupDigits := make([][]uint16, end-start)
+			nbBucketFilled := 0
+			for k := start; k < end; k++ {
+				chRes[k-start] = chChunks[k][j]
+				groupDigits[k-start] = digits[k][j*nbPoints : (j+1)*nbPoints]
+				nbBucketFilled += chunkStats[k][j].nbBucketFilled
+			}
+			processChunk := getChunkProcessorMultiG2(windowSize, nbBucketFilled)
+			sem <- struct{}{}
+			go func(j int, windowSize uint64, chRes []chan g2JacExtended, groupDigits [][]uint16) {
+				processChunk(uint64(j), chRes, windowSize, points, groupDigits)
+				<-sem
+			}(j, windowSize, chRes, groupDigits)
+		}
+	}
+
+	res := make([]G2Jac, len(scalars))
+	parallel.Execute(len(res), func(start, end int) {
+		for k := start; k < end; k++ {
+			msmReduceChunkG2Affine(&res[k], int(c), chChunks[k])
+		}
+	})
+	return res, nil
+}
+
+func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, neg []bool, nbBits uint64, config ecc.MultiExpConfig) *G2Jac {
 	// partition the scalars
-	digits, chunkStats := partitionScalars(scalars, c, config.NbTasks)
+	digits, chunkStats := partitionScalars(scalars, neg, c, nbBits, config.NbTasks)
 
-	nbChunks := computeNbChunks(c)
+	nbChunks := uint64(len(chunkStats))
+	// the last chunk is larger only if the scalars use all the bits
+	hasLastC := nbChunks == computeNbChunks(c)
 
 	// for each chunk, spawn one go routine that'll loop through all the scalars in the
 	// corresponding bit-window
@@ -490,7 +1168,7 @@ func _innerMsmG2(p *G2Jac, c uint64, points []G2Affine, scalars []fr.Element, co
 	n := len(points)
 	for j := int(nbChunks - 1); j >= 0; j-- {
 		processChunk := getChunkProcessorG2(c, chunkStats[j])
-		if j == int(nbChunks-1) {
+		if j == int(nbChunks-1) && hasLastC {
 			processChunk = getChunkProcessorG2(lastC(c), chunkStats[j])
 		}
 		if chunkStats[j].weight >= 115 {
@@ -609,6 +1287,49 @@ func getChunkProcessorG2(c uint64, stat chunkStat) func(chunkID uint64, chRes ch
 	}
 }
 
+// getChunkProcessorMultiG2 returns the algorithm processing a chunk of a group of multiExps,
+// see getChunkProcessorG2; nbBucketFilled is the number of buckets hit by the group.
+func getChunkProcessorMultiG2(c uint64, nbBucketFilled int) func(chunkID uint64, chRes []chan g2JacExtended, c uint64, points []G2Affine, digits [][]uint16) {
+	switch c {
+	case 10:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 80 {
+			return processChunkG2BatchAffineMulti[pG2AffineC10, ppG2AffineC10, cG2AffineC10]
+		}
+	case 11:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 150 {
+			return processChunkG2BatchAffineMulti[pG2AffineC11, ppG2AffineC11, cG2AffineC11]
+		}
+	case 12:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 200 {
+			return processChunkG2BatchAffineMulti[pG2AffineC12, ppG2AffineC12, cG2AffineC12]
+		}
+	case 13:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 350 {
+			return processChunkG2BatchAffineMulti[pG2AffineC13, ppG2AffineC13, cG2AffineC13]
+		}
+	case 14:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 400 {
+			return processChunkG2BatchAffineMulti[pG2AffineC14, ppG2AffineC14, cG2AffineC14]
+		}
+	case 15:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 500 {
+			return processChunkG2BatchAffineMulti[pG2AffineC15, ppG2AffineC15, cG2AffineC15]
+		}
+	case 16:
+		// the batch affine additions are worth it only if the batches are filled
+		if nbBucketFilled >= 640 {
+			return processChunkG2BatchAffineMulti[pG2AffineC16, ppG2AffineC16, cG2AffineC16]
+		}
+	}
+	return processChunkG2JacobianMulti
+}
+
 // msmReduceChunkG2Affine reduces the weighted sum of the buckets into the result of the multiExp
 func msmReduceChunkG2Affine(p *G2Jac, c int, chChunks []chan g2JacExtended) *G2Jac {
 	var _p g2JacExtended
@@ -625,6 +1346,102 @@ func msmReduceChunkG2Affine(p *G2Jac, c int, chChunks []chan g2JacExtended) *G2J
 	return p.unsafeFromJacExtended(&_p)
 }
 
+// nbGLVG2 is the number of images of a base used by MultiExpGLV: on G2, ψ acts as [x₀] and the
+// scalars are written in base |x₀|, r < |x₀|^4 (GLS).
+const nbGLVG2 = 4
+
+// G2GLVBases holds bases and their images under the endomorphism of the curve, used by
+// MultiExpGLV, so that the images aren't recomputed at each multiExp on the same bases.
+type G2GLVBases struct {
+	// points[nbGLVG2*i+j] is the j-th image of the i-th base
+	points []G2Affine
+}
+
+// NewG2GLVBases returns the bases and their images under the endomorphism of the curve:
+// [|x₀|ʲ]P = ψʲ(P) for j < 4.
+func NewG2GLVBases(bases []G2Affine) *G2GLVBases {
+	return newG2GLVBases(bases, runtime.NumCPU())
+}
+
+func newG2GLVBases(bases []G2Affine, nbTasks int) *G2GLVBases {
+	const d = nbGLVG2
+	points := make([]G2Affine, d*len(bases))
+	parallel.Execute(len(bases), func(start, end int) {
+		for i := start; i < end; i++ {
+			points[d*i] = bases[i]
+			for j := 1; j < d; j++ {
+				points[d*i+j].psi(&points[d*i+j-1])
+			}
+		}
+	}, nbTasks)
+	return &G2GLVBases{points: points}
+}
+
+// Len returns the number of bases.
+func (b *G2GLVBases) Len() int {
+	return len(b.points) / nbGLVG2
+}
+
+// MultiExpGLV computes ∑ᵢ [scalars[i]]Pᵢ where Pᵢ are the first len(scalars) bases, see MultiExpConfig.GLV.
+//
+// This call return an error if len(scalars) > bases.Len() or if provided config is invalid.
+func (p *G2Affine) MultiExpGLV(bases *G2GLVBases, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Affine, error) {
+	var _p G2Jac
+	if _, err := _p.MultiExpGLV(bases, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpGLV computes ∑ᵢ [scalars[i]]Pᵢ where Pᵢ are the first len(scalars) bases, see MultiExpConfig.GLV.
+//
+// The scalars are split along the endomorphism, see splitScalarsG2: the multiExp runs on
+// 4 times more points with shorter scalars, and the signs of the
+// components are taken into account in the digits of the scalars, so that the bases aren't copied.
+//
+// This call return an error if len(scalars) > bases.Len() or if provided config is invalid.
+func (p *G2Jac) MultiExpGLV(bases *G2GLVBases, scalars []fr.Element, config ecc.MultiExpConfig) (*G2Jac, error) {
+	nbPoints := len(scalars)
+	if nbPoints > bases.Len() {
+		return nil, errors.New("len(scalars) > bases.Len()")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	_scalars, neg, nbBits := splitScalarsG2(scalars, config.NbTasks)
+	return p.multiExp(bases.points[:nbGLVG2*nbPoints], _scalars, neg, nbBits, config), nil
+}
+
+// splitScalarsG2 writes each scalar s in base |x₀| (the seed of the curve), s = ∑ⱼ sⱼ⋅|x₀|ʲ,
+// and returns the digits sⱼ and their maximum bit length. The digits are non-negative, so the signs are nil.
+func splitScalarsG2(scalars []fr.Element, nbTasks int) ([]fr.Element, []bool, uint64) {
+	const d = nbGLVG2
+	_scalars := make([]fr.Element, d*len(scalars))
+	x := xGen.Uint64()
+
+	parallel.Execute(len(scalars), func(start, end int) {
+		for i := start; i < end; i++ {
+			s := scalars[i].Bits()
+			for j := 0; j < d; j++ {
+				// s, digit = s / |x₀|, s mod |x₀|
+				var digit uint64
+				for k := len(s) - 1; k >= 0; k-- {
+					s[k], digit = bits.Div64(digit, s[k], x)
+				}
+				_scalars[d*i+j].SetUint64(digit)
+			}
+		}
+	}, nbTasks)
+
+	return _scalars, nil, uint64(bits.Len64(x))
+}
+
 // selector stores the index, mask and shifts needed to select bits from a scalar
 // it is used during the multiExp algorithm or the batch scalar multiplication
 type selector struct {
@@ -643,6 +1460,78 @@ func computeNbChunks(c uint64) uint64 {
 	return (fr.Bits + c - 1) / c
 }
 
+// return the number of chunks for a given window size c, for scalars of at most nbBits bits
+// if nbBits < fr.Bits, the last chunk is not needed and the chunks above nbBits+1 are empty
+// (the extra bit accommodates the carry from the NAF decomposition)
+func computeNbChunksBits(c, nbBits uint64) uint64 {
+	nbChunks := computeNbChunks(c)
+	if nbBits < fr.Bits {
+		if n := (nbBits + c + 1) / c; n < nbChunks {
+			return n
+		}
+	}
+	return nbChunks
+}
+
+// smallScalar is the constraint on the types of small scalars, see G1Jac.MultiExpUint64.
+type smallScalar interface {
+	~uint8 | ~uint32 | ~uint64 | ~int64
+}
+
+// scalarsBitLen returns the maximum bit length of the scalars (in regular form), and whether they
+// all are small integers, that is s or -s < 2⁶³ for all s, see smallScalars.
+func scalarsBitLen(scalars []fr.Element, nbTasks int) (nbBits uint64, small bool) {
+	var lock sync.Mutex
+	small = true
+	parallel.Execute(len(scalars), func(start, end int) {
+		var neg fr.Element
+		maxBits, allSmall := 0, true
+		for i := start; i < end; i++ {
+			l := bitLen(scalars[i].Bits())
+			if l > maxBits {
+				maxBits = l
+			}
+			if allSmall && l > 63 {
+				neg.Neg(&scalars[i])
+				allSmall = bitLen(neg.Bits()) <= 63
+			}
+		}
+		lock.Lock()
+		if uint64(maxBits) > nbBits {
+			nbBits = uint64(maxBits)
+		}
+		small = small && allSmall
+		lock.Unlock()
+	}, nbTasks)
+	return nbBits, small
+}
+
+// smallScalars returns the scalars as int64, they must be small, see scalarsBitLen.
+func smallScalars(scalars []fr.Element, nbTasks int) []int64 {
+	res := make([]int64, len(scalars))
+	parallel.Execute(len(scalars), func(start, end int) {
+		var neg fr.Element
+		for i := start; i < end; i++ {
+			if s := scalars[i].Bits(); bitLen(s) <= 63 {
+				res[i] = int64(s[0])
+				continue
+			}
+			res[i] = -int64(neg.Neg(&scalars[i]).Bits()[0])
+		}
+	}, nbTasks)
+	return res
+}
+
+// bitLen returns the bit length of the scalar s in regular form
+func bitLen(s [fr.Limbs]uint64) int {
+	for i := fr.Limbs - 1; i >= 0; i-- {
+		if s[i] != 0 {
+			return i*64 + bits.Len64(s[i])
+		}
+	}
+	return 0
+}
+
 // return the last window size for a scalar;
 // this last window should accommodate a carry (from the NAF decomposition)
 // it can be == c if we have 1 available bit
@@ -667,14 +1556,17 @@ type chunkStat struct {
 // 2^{c} to the current digit, making it negative.
 // negative digits can be processed in a later step as adding -G into the bucket instead of G
 // (computing -G is cheap, and this saves us half of the buckets in the MultiExp or BatchScalarMultiplication)
-func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []chunkStat) {
+//
+// the scalars must have at most nbBits bits, see computeNbChunksBits. If neg is not nil, the digits of
+// scalars[i] are negated when neg[i] is set, so that the multiExp adds [-scalars[i]]points[i].
+func partitionScalars(scalars []fr.Element, neg []bool, c uint64, nbBits uint64, nbTasks int) ([]uint16, []chunkStat) {
 	// no benefit here to have more tasks than CPUs
 	if nbTasks > runtime.NumCPU() {
 		nbTasks = runtime.NumCPU()
 	}
 
 	// number of c-bit radixes in a scalar
-	nbChunks := computeNbChunks(c)
+	nbChunks := computeNbChunksBits(c, nbBits)
 
 	digits := make([]uint16, len(scalars)*int(nbChunks))
 
@@ -706,6 +1598,7 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 				continue
 			}
 			scalar := scalars[i].Bits()
+			negate := neg != nil && neg[i]
 
 			var carry int
 
@@ -736,6 +1629,9 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 				if digit == 0 {
 					continue
 				}
+				if negate {
+					digit = -digit
+				}
 
 				var bits uint16
 				if digit > 0 {
@@ -758,7 +1654,11 @@ func partitionScalars(scalars []fr.Element, c uint64, nbTasks int) ([]uint16, []
 				// we are selecting bits over 2 words
 				digit += int(scalar[s.index+1]&s.maskHigh) << s.shiftHigh
 			}
-			digits[int(chunk)*len(scalars)+i] = uint16(digit) << 1
+			if negate && digit != 0 {
+				digits[int(chunk)*len(scalars)+i] = (uint16(digit-1) << 1) + 1
+			} else {
+				digits[int(chunk)*len(scalars)+i] = uint16(digit) << 1
+			}
 		}
 
 	}, nbTasks)
@@ -18,6 +18,7 @@ package bls12378
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"math/bits"
 	"math/rand"
@@ -98,6 +99,146 @@ func TestMultiExpG1(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[G1] Multi exponentiation of small scalars should be consistent with the default one", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var (
+				scalarsUint8                                                 [nbSamples]uint8
+				scalarsUint32                                                [nbSamples]uint32
+				scalarsUint64                                                [nbSamples]uint64
+				scalarsInt64                                                 [nbSamples]int64
+				expectedUint8, expectedUint32, expectedUint64, expectedInt64 [nbSamples]fr.Element
+			)
+			m := mixer.Bits()
+			for i := 0; i < nbSamples; i++ {
+				r := m[i%fr.Limbs] * uint64(i+1)
+				// sprinkle some 0 and ±1
+				switch i % 7 {
+				case 0:
+					r = 0
+				case 1:
+					r = 1
+				case 2:
+					r = math.MaxUint64 // -1 as int64
+				}
+				scalarsUint8[i] = uint8(r)
+				scalarsUint32[i] = uint32(r)
+				scalarsUint64[i] = r
+				scalarsInt64[i] = int64(r)
+				expectedUint8[i].SetUint64(uint64(scalarsUint8[i]))
+				expectedUint32[i].SetUint64(uint64(scalarsUint32[i]))
+				expectedUint64[i].SetUint64(scalarsUint64[i])
+				expectedInt64[i].SetInt64(scalarsInt64[i])
+			}
+
+			// reference, without the detection of small scalars
+			c := bestCG1(nbSamples, fr.Bits)
+			config := ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}
+			var e8, e32, e64, eInt64 G1Jac
+			_innerMsmG1(&e8, c, samplePoints[:], expectedUint8[:], nil, fr.Bits, config)
+			_innerMsmG1(&e32, c, samplePoints[:], expectedUint32[:], nil, fr.Bits, config)
+			_innerMsmG1(&e64, c, samplePoints[:], expectedUint64[:], nil, fr.Bits, config)
+			_innerMsmG1(&eInt64, c, samplePoints[:], expectedInt64[:], nil, fr.Bits, config)
+
+			var r8, r32, r64, rInt64, rDetected G1Jac
+			r8.MultiExpUint8(samplePoints[:], scalarsUint8[:], ecc.MultiExpConfig{})
+			r32.MultiExpUint32(samplePoints[:], scalarsUint32[:], ecc.MultiExpConfig{})
+			r64.MultiExpUint64(samplePoints[:], scalarsUint64[:], ecc.MultiExpConfig{})
+			rInt64.MultiExpInt64(samplePoints[:], scalarsInt64[:], ecc.MultiExpConfig{NbTasks: 3})
+			rDetected.MultiExp(samplePoints[:], expectedInt64[:], ecc.MultiExpConfig{})
+
+			return e8.Equal(&r8) && e32.Equal(&r32) && e64.Equal(&r64) && eInt64.Equal(&rInt64) && eInt64.Equal(&rDetected)
+		},
+		genScalar,
+	))
+
+	properties.Property("[G1] MultiExpBatch should be consistent with MultiExp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			const nbBatch = 5
+			var sampleScalars [nbBatch][nbSamples]fr.Element
+			scalars := make([][]fr.Element, nbBatch)
+			for k := 0; k < nbBatch; k++ {
+				for i := 0; i < nbSamples; i++ {
+					sampleScalars[k][i].SetUint64(uint64(i*nbBatch+k)).
+						Mul(&sampleScalars[k][i], &mixer)
+				}
+				scalars[k] = sampleScalars[k][:]
+			}
+			// an empty multiExp
+			for i := range sampleScalars[2] {
+				sampleScalars[2][i].SetZero()
+			}
+
+			var p G1Affine
+			res, err := p.MultiExpBatch(samplePoints[:], scalars, ecc.MultiExpConfig{NbTasks: 3})
+			if err != nil || len(res) != nbBatch {
+				return false
+			}
+			for k := 0; k < nbBatch; k++ {
+				var expected G1Affine
+				expected.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+				if !expected.Equal(&res[k]) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.Property("[G1] Multi exponentiation with GLV should be consistent with the default one", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var samplePointsLarge [nbSamples * 13]G1Affine
+			for i := 0; i < 13; i++ {
+				copy(samplePointsLarge[i*nbSamples:], samplePoints[:])
+			}
+
+			var expected, glv, glvSplitted G1Jac
+
+			var sampleScalars [nbSamples * 13]fr.Element
+			for i := 0; i < len(sampleScalars); i++ {
+				sampleScalars[i].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i], &mixer)
+			}
+			// the largest scalar
+			sampleScalars[1].SetOne().Neg(&sampleScalars[1])
+
+			expected.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{})
+			glv.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{GLV: true})
+			glvSplitted.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{GLV: true, NbTasks: 128})
+			return expected.Equal(&glv) && expected.Equal(&glvSplitted)
+		},
+		genScalar,
+	))
+
+	properties.Property("[G1] Multi exponentiation with precomputed GLV bases should be consistent with the default one", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var sampleScalars [nbSamples]fr.Element
+			for i := 0; i < len(sampleScalars); i++ {
+				sampleScalars[i].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i], &mixer)
+			}
+			bases := NewG1GLVBases(samplePoints[:])
+			if bases.Len() != nbSamples {
+				return false
+			}
+
+			// all the bases, and a prefix of the bases
+			for _, n := range []int{nbSamples, nbSamples / 3} {
+				var expected, glv G1Jac
+				expected.MultiExp(samplePoints[:n], sampleScalars[:n], ecc.MultiExpConfig{})
+				if _, err := glv.MultiExpGLV(bases, sampleScalars[:n], ecc.MultiExpConfig{}); err != nil || !expected.Equal(&glv) {
+					return false
+				}
+			}
+
+			// more scalars than bases
+			var glv G1Jac
+			_, err := glv.MultiExpGLV(NewG1GLVBases(samplePoints[:nbSamples-1]), sampleScalars[:], ecc.MultiExpConfig{})
+			return err != nil
+		},
+		genScalar,
+	))
+
 	// cRange is generated from template and contains the available parameters for the multiexp window size
 	cRange := []uint64{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
 	if testing.Short() {
@@ -125,7 +266,7 @@ func TestMultiExpG1(t *testing.T) {
 
 			results := make([]G1Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 			}
 			for i := 1; i < len(results); i++ {
 				if !results[i].Equal(&results[i-1]) {
@@ -161,7 +302,7 @@ func TestMultiExpG1(t *testing.T) {
 
 			results := make([]G1Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG1(&results[i], c, samplePointsZero[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG1(&results[i], c, samplePointsZero[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -181,7 +322,7 @@ func TestMultiExpG1(t *testing.T) {
 
 			results := make([]G1Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -267,7 +408,7 @@ func TestCrossMultiExpG1(t *testing.T) {
 
 	results := make([]G1Jac, len(cRange))
 	for i, c := range cRange {
-		_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		_innerMsmG1(&results[i], c, samplePoints[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 	}
 
 	var r G1Jac
@@ -285,10 +426,72 @@ func TestCrossMultiExpG1(t *testing.T) {
 
 }
 
+func TestMultiExpBatchChunkG1(t *testing.T) {
+	// the chunk processors of MultiExpBatch, which share the points and the batch affine additions
+	// among several multiExps, should match the ones of MultiExp, for each multiExp
+	const (
+		c         = 16
+		nbSamples = 1 << 12
+		nbBatch   = 3
+	)
+
+	samplePoints := make([]G1Affine, nbSamples)
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := range samplePoints {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	// P + P and P - P in the buckets, and a point at infinity
+	samplePoints[1] = samplePoints[0]
+	samplePoints[2].Neg(&samplePoints[0])
+	samplePoints[3].setInfinity()
+
+	digits := make([][]uint16, nbBatch)
+	for k := range digits {
+		scalars := make([]fr.Element, nbSamples)
+		fillBenchScalars(scalars)
+		scalars[1], scalars[2] = scalars[0], scalars[0]
+		// the first window of the scalars
+		d, _ := partitionScalars(scalars, nil, c, fr.Bits, runtime.NumCPU())
+		digits[k] = d[:nbSamples]
+	}
+
+	check := func(name string, processChunk func(chRes chan<- g1JacExtended, digits []uint16), processChunkMulti func(chRes []chan g1JacExtended, digits [][]uint16)) {
+		t.Helper()
+		chRes := make([]chan g1JacExtended, nbBatch)
+		for k := range chRes {
+			chRes[k] = make(chan g1JacExtended, 1)
+		}
+		processChunkMulti(chRes, digits)
+		for k := range digits {
+			chExpected := make(chan g1JacExtended, 1)
+			processChunk(chExpected, digits[k])
+			var res, expected G1Jac
+			msmReduceChunkG1Affine(&res, c, chRes[k:k+1])
+			msmReduceChunkG1Affine(&expected, c, []chan g1JacExtended{chExpected})
+			if !res.Equal(&expected) {
+				t.Fatalf("%s: wrong total for the multiExp %d", name, k)
+			}
+		}
+	}
+
+	check("jacobian", func(chRes chan<- g1JacExtended, digits []uint16) {
+		processChunkG1Jacobian[bucketg1JacExtendedC16](0, chRes, c, samplePoints, digits, nil)
+	}, func(chRes []chan g1JacExtended, digits [][]uint16) {
+		processChunkG1JacobianMulti(0, chRes, c, samplePoints, digits)
+	})
+	check("batch affine", func(chRes chan<- g1JacExtended, digits []uint16) {
+		processChunkG1BatchAffine[bucketg1JacExtendedC16, bucketG1AffineC16, bitSetC16, pG1AffineC16, ppG1AffineC16, qG1AffineC16, cG1AffineC16](0, chRes, c, samplePoints, digits, nil)
+	}, func(chRes []chan g1JacExtended, digits [][]uint16) {
+		processChunkG1BatchAffineMulti[pG1AffineC16, ppG1AffineC16, cG1AffineC16](0, chRes, c, samplePoints, digits)
+	})
+}
+
 // _innerMsmG1Reference always do ext jacobian with c == 16
 func _innerMsmG1Reference(p *G1Jac, points []G1Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G1Jac {
 	// partition the scalars
-	digits, _ := partitionScalars(scalars, 16, config.NbTasks)
+	digits, _ := partitionScalars(scalars, nil, 16, fr.Bits, config.NbTasks)
 
 	nbChunks := computeNbChunks(16)
 
@@ -376,6 +579,141 @@ func BenchmarkMultiExpG1(b *testing.B) {
 		})
 	}
 }
+func BenchmarkMultiExpG1GLV(b *testing.B) {
+
+	const (
+		pow       = (bits.UintSize / 2) - (bits.UintSize / 8) // 24 on 64 bits arch, 12 on 32 bits
+		nbSamples = 1 << pow
+	)
+
+	var (
+		samplePoints  [nbSamples]G1Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG1(samplePoints[:])
+
+	var testPoint G1Affine
+
+	for i := pow - 8; i <= pow; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d points-glv", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{GLV: true})
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d points-glv-bases", using), func(b *testing.B) {
+			bases := NewG1GLVBases(samplePoints[:using])
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpGLV(bases, sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpSmallG1(b *testing.B) {
+	const nbSamples = 1 << 20
+
+	samplePoints := make([]G1Affine, nbSamples)
+	fillBenchBasesG1(samplePoints)
+	scalars := make([]fr.Element, nbSamples)
+	fillBenchScalars(scalars)
+
+	scalarsUint8 := make([]uint8, nbSamples)
+	scalarsBits := make([]uint8, nbSamples)
+	smallScalars := make([]fr.Element, nbSamples)
+	for i := range scalars {
+		scalarsUint8[i] = uint8(scalars[i][0])
+		scalarsBits[i] = uint8(scalars[i][0] & 1)
+		smallScalars[i].SetUint64(uint64(scalarsUint8[i]))
+	}
+
+	var testPoint G1Affine
+
+	for i := 16; i <= 20; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], scalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+		b.Run(fmt.Sprintf("%d points-uint8", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpUint8(samplePoints[:using], scalarsUint8[:using], ecc.MultiExpConfig{})
+			}
+		})
+		b.Run(fmt.Sprintf("%d points-uint8-detected", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], smallScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+		b.Run(fmt.Sprintf("%d points-bits", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpUint8(samplePoints[:using], scalarsBits[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpBatchG1(b *testing.B) {
+	const (
+		pow       = 16
+		nbSamples = 1 << pow
+		nbBatch   = 16
+	)
+
+	samplePoints := make([]G1Affine, nbSamples)
+	fillBenchBasesG1(samplePoints)
+	sampleScalars := make([][]fr.Element, nbBatch)
+	for k := range sampleScalars {
+		sampleScalars[k] = make([]fr.Element, nbSamples)
+		fillBenchScalars(sampleScalars[k])
+	}
+
+	var testPoint G1Affine
+
+	for i := 10; i <= pow; i += 3 {
+		using := 1 << i
+		scalars := make([][]fr.Element, nbBatch)
+		for k := range scalars {
+			scalars[k] = sampleScalars[k][:using]
+		}
+
+		// K sequential multiExps, against a batch of K
+		b.Run(fmt.Sprintf("%d x %d points", nbBatch, using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				for k := range scalars {
+					testPoint.MultiExp(samplePoints[:using], scalars[k], ecc.MultiExpConfig{})
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d x %d points-batch", nbBatch, using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpBatch(samplePoints[:using], scalars, ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
 
 func BenchmarkMultiExpG1Reference(b *testing.B) {
 	const nbSamples = 1 << 20
@@ -514,6 +852,146 @@ func TestMultiExpG2(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[G2] Multi exponentiation of small scalars should be consistent with the default one", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var (
+				scalarsUint8                                                 [nbSamples]uint8
+				scalarsUint32                                                [nbSamples]uint32
+				scalarsUint64                                                [nbSamples]uint64
+				scalarsInt64                                                 [nbSamples]int64
+				expectedUint8, expectedUint32, expectedUint64, expectedInt64 [nbSamples]fr.Element
+			)
+			m := mixer.Bits()
+			for i := 0; i < nbSamples; i++ {
+				r := m[i%fr.Limbs] * uint64(i+1)
+				// sprinkle some 0 and ±1
+				switch i % 7 {
+				case 0:
+					r = 0
+				case 1:
+					r = 1
+				case 2:
+					r = math.MaxUint64 // -1 as int64
+				}
+				scalarsUint8[i] = uint8(r)
+				scalarsUint32[i] = uint32(r)
+				scalarsUint64[i] = r
+				scalarsInt64[i] = int64(r)
+				expectedUint8[i].SetUint64(uint64(scalarsUint8[i]))
+				expectedUint32[i].SetUint64(uint64(scalarsUint32[i]))
+				expectedUint64[i].SetUint64(scalarsUint64[i])
+				expectedInt64[i].SetInt64(scalarsInt64[i])
+			}
+
+			// reference, without the detection of small scalars
+			c := bestCG2(nbSamples, fr.Bits)
+			config := ecc.MultiExpConfig{NbTasks: runtime.NumCPU()}
+			var e8, e32, e64, eInt64 G2Jac
+			_innerMsmG2(&e8, c, samplePoints[:], expectedUint8[:], nil, fr.Bits, config)
+			_innerMsmG2(&e32, c, samplePoints[:], expectedUint32[:], nil, fr.Bits, config)
+			_innerMsmG2(&e64, c, samplePoints[:], expectedUint64[:], nil, fr.Bits, config)
+			_innerMsmG2(&eInt64, c, samplePoints[:], expectedInt64[:], nil, fr.Bits, config)
+
+			var r8, r32, r64, rInt64, rDetected G2Jac
+			r8.MultiExpUint8(samplePoints[:], scalarsUint8[:], ecc.MultiExpConfig{})
+			r32.MultiExpUint32(samplePoints[:], scalarsUint32[:], ecc.MultiExpConfig{})
+			r64.MultiExpUint64(samplePoints[:], scalarsUint64[:], ecc.MultiExpConfig{})
+			rInt64.MultiExpInt64(samplePoints[:], scalarsInt64[:], ecc.MultiExpConfig{NbTasks: 3})
+			rDetected.MultiExp(samplePoints[:], expectedInt64[:], ecc.MultiExpConfig{})
+
+			return e8.Equal(&r8) && e32.Equal(&r32) && e64.Equal(&r64) && eInt64.Equal(&rInt64) && eInt64.Equal(&rDetected)
+		},
+		genScalar,
+	))
+
+	properties.Property("[G2] MultiExpBatch should be consistent with MultiExp", prop.ForAll(
+		func(mixer fr.Element) bool {
+			const nbBatch = 5
+			var sampleScalars [nbBatch][nbSamples]fr.Element
+			scalars := make([][]fr.Element, nbBatch)
+			for k := 0; k < nbBatch; k++ {
+				for i := 0; i < nbSamples; i++ {
+					sampleScalars[k][i].SetUint64(uint64(i*nbBatch+k)).
+						Mul(&sampleScalars[k][i], &mixer)
+				}
+				scalars[k] = sampleScalars[k][:]
+			}
+			// an empty multiExp
+			for i := range sampleScalars[2] {
+				sampleScalars[2][i].SetZero()
+			}
+
+			var p G2Affine
+			res, err := p.MultiExpBatch(samplePoints[:], scalars, ecc.MultiExpConfig{NbTasks: 3})
+			if err != nil || len(res) != nbBatch {
+				return false
+			}
+			for k := 0; k < nbBatch; k++ {
+				var expected G2Affine
+				expected.MultiExp(samplePoints[:], scalars[k], ecc.MultiExpConfig{})
+				if !expected.Equal(&res[k]) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.Property("[G2] Multi exponentiation with GLV should be consistent with the default one", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var samplePointsLarge [nbSamples * 13]G2Affine
+			for i := 0; i < 13; i++ {
+				copy(samplePointsLarge[i*nbSamples:], samplePoints[:])
+			}
+
+			var expected, glv, glvSplitted G2Jac
+
+			var sampleScalars [nbSamples * 13]fr.Element
+			for i := 0; i < len(sampleScalars); i++ {
+				sampleScalars[i].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i], &mixer)
+			}
+			// the largest scalar
+			sampleScalars[1].SetOne().Neg(&sampleScalars[1])
+
+			expected.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{})
+			glv.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{GLV: true})
+			glvSplitted.MultiExp(samplePointsLarge[:], sampleScalars[:], ecc.MultiExpConfig{GLV: true, NbTasks: 128})
+			return expected.Equal(&glv) && expected.Equal(&glvSplitted)
+		},
+		genScalar,
+	))
+
+	properties.Property("[G2] Multi exponentiation with precomputed GLV bases should be consistent with the default one", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var sampleScalars [nbSamples]fr.Element
+			for i := 0; i < len(sampleScalars); i++ {
+				sampleScalars[i].SetUint64(uint64(i)).
+					Mul(&sampleScalars[i], &mixer)
+			}
+			bases := NewG2GLVBases(samplePoints[:])
+			if bases.Len() != nbSamples {
+				return false
+			}
+
+			// all the bases, and a prefix of the bases
+			for _, n := range []int{nbSamples, nbSamples / 3} {
+				var expected, glv G2Jac
+				expected.MultiExp(samplePoints[:n], sampleScalars[:n], ecc.MultiExpConfig{})
+				if _, err := glv.MultiExpGLV(bases, sampleScalars[:n], ecc.MultiExpConfig{}); err != nil || !expected.Equal(&glv) {
+					return false
+				}
+			}
+
+			// more scalars than bases
+			var glv G2Jac
+			_, err := glv.MultiExpGLV(NewG2GLVBases(samplePoints[:nbSamples-1]), sampleScalars[:], ecc.MultiExpConfig{})
+			return err != nil
+		},
+		genScalar,
+	))
+
 	// cRange is generated from template and contains the available parameters for the multiexp window size
 	// for g2, CI suffers with large c size since it needs to allocate a lot of memory for the buckets.
 	// test only "odd" and "even" (ie windows size divide word size vs not)
@@ -539,7 +1017,7 @@ func TestMultiExpG2(t *testing.T) {
 
 			results := make([]G2Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 			}
 			for i := 1; i < len(results); i++ {
 				if !results[i].Equal(&results[i-1]) {
@@ -575,7 +1053,7 @@ func TestMultiExpG2(t *testing.T) {
 
 			results := make([]G2Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG2(&results[i], c, samplePointsZero[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG2(&results[i], c, samplePointsZero[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -595,7 +1073,7 @@ func TestMultiExpG2(t *testing.T) {
 
 			results := make([]G2Jac, len(cRange))
 			for i, c := range cRange {
-				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+				_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 			}
 			for i := 0; i < len(results); i++ {
 				if !results[i].Z.IsZero() {
@@ -679,7 +1157,7 @@ func TestCrossMultiExpG2(t *testing.T) {
 
 	results := make([]G2Jac, len(cRange))
 	for i, c := range cRange {
-		_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
+		_innerMsmG2(&results[i], c, samplePoints[:], sampleScalars[:], nil, fr.Bits, ecc.MultiExpConfig{NbTasks: runtime.NumCPU()})
 	}
 
 	var r G2Jac
@@ -697,10 +1175,72 @@ func TestCrossMultiExpG2(t *testing.T) {
 
 }
 
+func TestMultiExpBatchChunkG2(t *testing.T) {
+	// the chunk processors of MultiExpBatch, which share the points and the batch affine additions
+	// among several multiExps, should match the ones of MultiExp, for each multiExp
+	const (
+		c         = 16
+		nbSamples = 1 << 12
+		nbBatch   = 3
+	)
+
+	samplePoints := make([]G2Affine, nbSamples)
+	var g G2Jac
+	g.Set(&g2Gen)
+	for i := range samplePoints {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g2Gen)
+	}
+	// P + P and P - P in the buckets, and a point at infinity
+	samplePoints[1] = samplePoints[0]
+	samplePoints[2].Neg(&samplePoints[0])
+	samplePoints[3].setInfinity()
+
+	digits := make([][]uint16, nbBatch)
+	for k := range digits {
+		scalars := make([]fr.Element, nbSamples)
+		fillBenchScalars(scalars)
+		scalars[1], scalars[2] = scalars[0], scalars[0]
+		// the first window of the scalars
+		d, _ := partitionScalars(scalars, nil, c, fr.Bits, runtime.NumCPU())
+		digits[k] = d[:nbSamples]
+	}
+
+	check := func(name string, processChunk func(chRes chan<- g2JacExtended, digits []uint16), processChunkMulti func(chRes []chan g2JacExtended, digits [][]uint16)) {
+		t.Helper()
+		chRes := make([]chan g2JacExtended, nbBatch)
+		for k := range chRes {
+			chRes[k] = make(chan g2JacExtended, 1)
+		}
+		processChunkMulti(chRes, digits)
+		for k := range digits {
+			chExpected := make(chan g2JacExtended, 1)
+			processChunk(chExpected, digits[k])
+			var res, expected G2Jac
+			msmReduceChunkG2Affine(&res, c, chRes[k:k+1])
+			msmReduceChunkG2Affine(&expected, c, []chan g2JacExtended{chExpected})
+			if !res.Equal(&expected) {
+				t.Fatalf("%s: wrong total for the multiExp %d", name, k)
+			}
+		}
+	}
+
+	check("jacobian", func(chRes chan<- g2JacExtended, digits []uint16) {
+		processChunkG2Jacobian[bucketg2JacExtendedC16](0, chRes, c, samplePoints, digits, nil)
+	}, func(chRes []chan g2JacExtended, digits [][]uint16) {
+		processChunkG2JacobianMulti(0, chRes, c, samplePoints, digits)
+	})
+	check("batch affine", func(chRes chan<- g2JacExtended, digits []uint16) {
+		processChunkG2BatchAffine[bucketg2JacExtendedC16, bucketG2AffineC16, bitSetC16, pG2AffineC16, ppG2AffineC16, qG2AffineC16, cG2AffineC16](0, chRes, c, samplePoints, digits, nil)
+	}, func(chRes []chan g2JacExtended, digits [][]uint16) {
+		processChunkG2BatchAffineMulti[pG2AffineC16, ppG2AffineC16, cG2AffineC16](0, chRes, c, samplePoints, digits)
+	})
+}
+
 // _innerMsmG2Reference always do ext jacobian with c == 16
 func _innerMsmG2Reference(p *G2Jac, points []G2Affine, scalars []fr.Element, config ecc.MultiExpConfig) *G2Jac {
 	// partition the scalars
-	digits, _ := partitionScalars(scalars, 16, config.NbTasks)
+	digits, _ := partitionScalars(scalars, nil, 16, fr.Bits, config.NbTasks)
 
 	nbChunks := computeNbChunks(16)
 
@@ -788,6 +1328,141 @@ func BenchmarkMultiExpG2(b *testing.B) {
 		})
 	}
 }
+func BenchmarkMultiExpG2GLV(b *testing.B) {
+
+	const (
+		pow       = (bits.UintSize / 2) - (bits.UintSize / 8) // 24 on 64 bits arch, 12 on 32 bits
+		nbSamples = 1 << pow
+	)
+
+	var (
+		samplePoints  [nbSamples]G2Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG2(samplePoints[:])
+
+	var testPoint G2Affine
+
+	for i := pow - 8; i <= pow; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d points-glv", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{GLV: true})
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d points-glv-bases", using), func(b *testing.B) {
+			bases := NewG2GLVBases(samplePoints[:using])
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpGLV(bases, sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpSmallG2(b *testing.B) {
+	const nbSamples = 1 << 20
+
+	samplePoints := make([]G2Affine, nbSamples)
+	fillBenchBasesG2(samplePoints)
+	scalars := make([]fr.Element, nbSamples)
+	fillBenchScalars(scalars)
+
+	scalarsUint8 := make([]uint8, nbSamples)
+	scalarsBits := make([]uint8, nbSamples)
+	smallScalars := make([]fr.Element, nbSamples)
+	for i := range scalars {
+		scalarsUint8[i] = uint8(scalars[i][0])
+		scalarsBits[i] = uint8(scalars[i][0] & 1)
+		smallScalars[i].SetUint64(uint64(scalarsUint8[i]))
+	}
+
+	var testPoint G2Affine
+
+	for i := 16; i <= 20; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], scalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+		b.Run(fmt.Sprintf("%d points-uint8", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpUint8(samplePoints[:using], scalarsUint8[:using], ecc.MultiExpConfig{})
+			}
+		})
+		b.Run(fmt.Sprintf("%d points-uint8-detected", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], smallScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+		b.Run(fmt.Sprintf("%d points-bits", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpUint8(samplePoints[:using], scalarsBits[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
+
+func BenchmarkMultiExpBatchG2(b *testing.B) {
+	const (
+		pow       = 16
+		nbSamples = 1 << pow
+		nbBatch   = 16
+	)
+
+	samplePoints := make([]G2Affine, nbSamples)
+	fillBenchBasesG2(samplePoints)
+	sampleScalars := make([][]fr.Element, nbBatch)
+	for k := range sampleScalars {
+		sampleScalars[k] = make([]fr.Element, nbSamples)
+		fillBenchScalars(sampleScalars[k])
+	}
+
+	var testPoint G2Affine
+
+	for i := 10; i <= pow; i += 3 {
+		using := 1 << i
+		scalars := make([][]fr.Element, nbBatch)
+		for k := range scalars {
+			scalars[k] = sampleScalars[k][:using]
+		}
+
+		// K sequential multiExps, against a batch of K
+		b.Run(fmt.Sprintf("%d x %d points", nbBatch, using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				for k := range scalars {
+					testPoint.MultiExp(samplePoints[:using], scalars[k], ecc.MultiExpConfig{})
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d x %d points-batch", nbBatch, using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExpBatch(samplePoints[:using], scalars, ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
 
 func BenchmarkMultiExpG2Reference(b *testing.B) {
 	const nbSamples = 1 << 20
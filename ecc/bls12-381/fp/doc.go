@@ -47,7 +47,16 @@
 //	q[base10] = 4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787
 //	q[base16] = 0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab
 //
+// # Constant time
+//
+// Inverse, Exp, Sqrt and Legendre branch on their inputs and are meant for public values.
+// InverseCT, ExpCT, SqrtCT and LegendreCT are their constant-time counterparts, for secret values:
+// the sequence of operations and memory accesses doesn't depend on the input (nor on the bits of the exponent in ExpCT),
+// at the cost of a slower running time. The rule in this library is that a code path handling secrets (the signature
+// schemes) uses the CT variants for these operations; the other methods (Add, Mul, ...) make no such guarantee.
+// The harness in internal/dudect measures the CT variants; it is meant to be run locally.
+//
 // # Warning
 //
-// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance, beyond the best effort of the CT variants above.
 package fp
@@ -1319,6 +1319,17 @@ func (littleEndian) PutElement(b *[Bytes]byte, e Element) {
 
 func (littleEndian) String() string { return "LittleEndian" }
 
+var (
+	_bLegendreExponentElement *big.Int
+	_bSqrtExponentElement     *big.Int
+)
+
+func init() {
+	_bLegendreExponentElement, _ = new(big.Int).SetString("d0088f51cbff34d258dd3db21a5d66bb23ba5c279c2895fb39869507b587b120f55ffff58a9ffffdcff7fffffffd555", 16)
+	const sqrtExponentElement = "680447a8e5ff9a692c6e9ed90d2eb35d91dd2e13ce144afd9cc34a83dac3d8907aaffffac54ffffee7fbfffffffeaab"
+	_bSqrtExponentElement, _ = new(big.Int).SetString(sqrtExponentElement, 16)
+}
+
 // Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
 func (z *Element) Legendre() int {
 	var l Element
@@ -1352,6 +1363,294 @@ func (z *Element) Sqrt(x *Element) *Element {
 	return nil
 }
 
+var _bInverseExponentElement *big.Int
+
+func init() {
+	// q - 2
+	_bInverseExponentElement = Modulus()
+	_bInverseExponentElement.Sub(_bInverseExponentElement, big.NewInt(2))
+}
+
+// ctNonZero returns 1 if v != 0, 0 otherwise, without branching on v
+func ctNonZero(v uint64) int {
+	return int((v | -v) >> 63)
+}
+
+// InverseCT z = x⁻¹ (mod q) in constant time, computed as x^(q-2)
+//
+// if x == 0, sets and returns z = x
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.ExpCT(*x, _bInverseExponentElement)
+}
+
+// ExpCT z = xᵏ (mod q) in constant time
+//
+// The sequence of operations only depends on the number of words of k, such that
+// neither x nor the bits of k leak through timing or memory accesses. The sign of k is
+// not hidden: if k < 0, x is inverted with InverseCT.
+func (z *Element) ExpCT(x Element, k *big.Int) *Element {
+	if k.Sign() == -1 {
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.InverseCT(&x)
+	}
+
+	// fixed window of 4 bits; table[i] = xⁱ
+	var table [16]Element
+	table[0].SetOne()
+	table[1] = x
+	for i := 2; i < len(table); i++ {
+		table[i].mulCT(&table[i-1], &x)
+	}
+
+	// k.Bits() is the absolute value of k
+	words := k.Bits()
+	var res, t Element
+	res.SetOne()
+	for i := len(words) - 1; i >= 0; i-- {
+		w := uint(words[i])
+		for j := bits.UintSize - 4; j >= 0; j -= 4 {
+			res.mulCT(&res, &res).
+				mulCT(&res, &res).
+				mulCT(&res, &res).
+				mulCT(&res, &res)
+
+			// t = table[(w >> j) & 0xf], reading all the entries
+			idx := uint64((w >> uint(j)) & 0xf)
+			t = table[0]
+			for l := 1; l < len(table); l++ {
+				t.Select(ctNonZero(uint64(l)^idx), &table[l], &t)
+			}
+			res.mulCT(&res, &t)
+		}
+	}
+
+	return z.Set(&res)
+}
+
+// LegendreCT returns the Legendre symbol of z (either +1, -1, or 0.) in constant time
+func (z *Element) LegendreCT() int {
+	var l, one Element
+	one.SetOne()
+	// z^((q-1)/2)
+	l.ExpCT(*z, _bLegendreExponentElement)
+
+	// 0 if l == 0, 1 if l == 1, -1 otherwise
+	notZero := ctNonZero(l.NotEqual(&Element{}))
+	notOne := ctNonZero(l.NotEqual(&one))
+	return notZero - 2*(notZero&notOne)
+}
+
+// SqrtCT z = √x (mod q) in constant time
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+//
+// The running time doesn't depend on x, except for the final branch on
+// the existence of the square root. The root returned may differ from Sqrt's (z or -z).
+func (z *Element) SqrtCT(x *Element) *Element {
+	// q ≡ 3 (mod 4)
+	// using  z ≡ ± x^((p+1)/4) (mod q)
+	var y, square Element
+	y.ExpCT(*x, _bSqrtExponentElement)
+
+	// ensure we found y such that y * y = x
+	square.mulCT(&y, &y)
+	if square.Equal(x) {
+		return z.Set(&y)
+	}
+	return nil
+}
+
+// mulCT z = x * y (mod q)
+//
+// Unlike Mul, whose final reduction may branch on the result in its Go implementation,
+// mulCT never branches on the values of x and y.
+func (z *Element) mulCT(x, y *Element) *Element {
+
+	var t [7]uint64
+	var D uint64
+	var m, C uint64
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	C, t[3] = madd1(y[0], x[3], C)
+	C, t[4] = madd1(y[0], x[4], C)
+	C, t[5] = madd1(y[0], x[5], C)
+
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	C, t[3] = madd2(y[1], x[3], t[3], C)
+	C, t[4] = madd2(y[1], x[4], t[4], C)
+	C, t[5] = madd2(y[1], x[5], t[5], C)
+
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	C, t[3] = madd2(y[2], x[3], t[3], C)
+	C, t[4] = madd2(y[2], x[4], t[4], C)
+	C, t[5] = madd2(y[2], x[5], t[5], C)
+
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[3], x[0], t[0])
+	C, t[1] = madd2(y[3], x[1], t[1], C)
+	C, t[2] = madd2(y[3], x[2], t[2], C)
+	C, t[3] = madd2(y[3], x[3], t[3], C)
+	C, t[4] = madd2(y[3], x[4], t[4], C)
+	C, t[5] = madd2(y[3], x[5], t[5], C)
+
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[4], x[0], t[0])
+	C, t[1] = madd2(y[4], x[1], t[1], C)
+	C, t[2] = madd2(y[4], x[2], t[2], C)
+	C, t[3] = madd2(y[4], x[3], t[3], C)
+	C, t[4] = madd2(y[4], x[4], t[4], C)
+	C, t[5] = madd2(y[4], x[5], t[5], C)
+
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[5], x[0], t[0])
+	C, t[1] = madd2(y[5], x[1], t[1], C)
+	C, t[2] = madd2(y[5], x[2], t[2], C)
+	C, t[3] = madd2(y[5], x[3], t[3], C)
+	C, t[4] = madd2(y[5], x[4], t[4], C)
+	C, t[5] = madd2(y[5], x[5], t[5], C)
+
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// t < 2q on 7 words
+	// if t ⩾ q → z = t - q, else z = t; without branching on the value of t
+	{
+		var s [6]uint64
+		var b uint64
+		s[0], b = bits.Sub64(t[0], q0, 0)
+		s[1], b = bits.Sub64(t[1], q1, b)
+		s[2], b = bits.Sub64(t[2], q2, b)
+		s[3], b = bits.Sub64(t[3], q3, b)
+		s[4], b = bits.Sub64(t[4], q4, b)
+		s[5], b = bits.Sub64(t[5], q5, b)
+		_, b = bits.Sub64(t[6], 0, b)
+		// mask = 2⁶⁴-1 if there is no borrow, 0 otherwise
+		mask := b - 1
+		z[0] = t[0] ^ ((t[0] ^ s[0]) & mask)
+		z[1] = t[1] ^ ((t[1] ^ s[1]) & mask)
+		z[2] = t[2] ^ ((t[2] ^ s[2]) & mask)
+		z[3] = t[3] ^ ((t[3] ^ s[3]) & mask)
+		z[4] = t[4] ^ ((t[4] ^ s[4]) & mask)
+		z[5] = t[5] ^ ((t[5] ^ s[5]) & mask)
+	}
+
+	return z
+}
+
 const (
 	k               = 32 // word size / 2
 	signBitSelector = uint64(1) << 63
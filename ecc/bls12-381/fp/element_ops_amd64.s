@@ -304,3 +304,1645 @@ TEXT ·Butterfly(SB), $48-16
 	MOVQ R8, 32(AX)
 	MOVQ R9, 40(AX)
 	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	MOVQ    0(DX), SI
+	MOVQ    8(DX), DI
+	MOVQ    16(DX), R8
+	MOVQ    24(DX), R9
+	MOVQ    32(DX), R10
+	MOVQ    40(DX), R11
+	ADDQ    0(CX), SI
+	ADCQ    8(CX), DI
+	ADCQ    16(CX), R8
+	ADCQ    24(CX), R9
+	ADCQ    32(CX), R10
+	ADCQ    40(CX), R11
+	MOVQ    SI, 0(AX)
+	MOVQ    DI, 8(AX)
+	MOVQ    R8, 16(AX)
+	MOVQ    R9, 24(AX)
+	MOVQ    R10, 32(AX)
+	MOVQ    R11, 40(AX)
+	SUBQ    q<>+0(SB), SI
+	SBBQ    q<>+8(SB), DI
+	SBBQ    q<>+16(SB), R8
+	SBBQ    q<>+24(SB), R9
+	SBBQ    q<>+32(SB), R10
+	SBBQ    q<>+40(SB), R11
+	CMOVQCS 0(AX), SI
+	CMOVQCS 8(AX), DI
+	CMOVQCS 16(AX), R8
+	CMOVQCS 24(AX), R9
+	CMOVQCS 32(AX), R10
+	CMOVQCS 40(AX), R11
+	MOVQ    SI, 0(AX)
+	MOVQ    DI, 8(AX)
+	MOVQ    R8, 16(AX)
+	MOVQ    R9, 24(AX)
+	MOVQ    R10, 32(AX)
+	MOVQ    R11, 40(AX)
+	DECQ    BX
+	JEQ     l2
+	ADDQ    $0x0000000000000030, DX
+	ADDQ    $0x0000000000000030, CX
+	ADDQ    $0x0000000000000030, AX
+	JMP     l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l3:
+	MOVQ    0(DX), DI
+	MOVQ    8(DX), R8
+	MOVQ    16(DX), R9
+	MOVQ    24(DX), R10
+	MOVQ    32(DX), R11
+	MOVQ    40(DX), R12
+	SUBQ    0(CX), DI
+	SBBQ    8(CX), R8
+	SBBQ    16(CX), R9
+	SBBQ    24(CX), R10
+	SBBQ    32(CX), R11
+	SBBQ    40(CX), R12
+	SBBQ    SI, SI
+	MOVQ    DI, 0(AX)
+	MOVQ    R8, 8(AX)
+	MOVQ    R9, 16(AX)
+	MOVQ    R10, 24(AX)
+	MOVQ    R11, 32(AX)
+	MOVQ    R12, 40(AX)
+	ADDQ    q<>+0(SB), DI
+	ADCQ    q<>+8(SB), R8
+	ADCQ    q<>+16(SB), R9
+	ADCQ    q<>+24(SB), R10
+	ADCQ    q<>+32(SB), R11
+	ADCQ    q<>+40(SB), R12
+	TESTQ   SI, SI
+	CMOVQEQ 0(AX), DI
+	CMOVQEQ 8(AX), R8
+	CMOVQEQ 16(AX), R9
+	CMOVQEQ 24(AX), R10
+	CMOVQEQ 32(AX), R11
+	CMOVQEQ 40(AX), R12
+	MOVQ    DI, 0(AX)
+	MOVQ    R8, 8(AX)
+	MOVQ    R9, 16(AX)
+	MOVQ    R10, 24(AX)
+	MOVQ    R11, 32(AX)
+	MOVQ    R12, 40(AX)
+	DECQ    BX
+	JEQ     l4
+	ADDQ    $0x0000000000000030, DX
+	ADDQ    $0x0000000000000030, CX
+	ADDQ    $0x0000000000000030, AX
+	JMP     l3
+
+l4:
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+// requires the ADX and BMI2 instructions
+TEXT ·mulVec(SB), $16-32
+	NO_LOCAL_POINTERS
+	MOVQ a+8(FP), R14
+	MOVQ b+16(FP), R15
+
+l5:
+	// A -> BP
+	// t[0] -> CX
+	// t[1] -> BX
+	// t[2] -> SI
+	// t[3] -> DI
+	// t[4] -> R8
+	// t[5] -> R9
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R15), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R14), CX, BX
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R14), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R14), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R14), AX, R8
+	ADOXQ AX, DI
+
+	// (A,t[4])  := x[4]*y[0] + A
+	MULXQ 32(R14), AX, R9
+	ADOXQ AX, R8
+
+	// (A,t[5])  := x[5]*y[0] + A
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[1] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[1] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[2] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[2] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[3] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[3] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 32(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[4] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[4] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[4] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[4] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[4] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[4] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 40(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[5] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[5] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[5] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[5] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[5] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[5] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// reduce element(CX,BX,SI,DI,R8,R9) using temp registers (R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+	REDUCE(CX,BX,SI,DI,R8,R9,R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+
+	MOVQ res+0(FP), AX
+	MOVQ CX, 0(AX)
+	MOVQ BX, 8(AX)
+	MOVQ SI, 16(AX)
+	MOVQ DI, 24(AX)
+	MOVQ R8, 32(AX)
+	MOVQ R9, 40(AX)
+	DECQ n+24(FP)
+	JEQ  l6
+	ADDQ $0x0000000000000030, R14
+	ADDQ $0x0000000000000030, R15
+	ADDQ $0x0000000000000030, res+0(FP)
+	JMP  l5
+
+l6:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+// requires the ADX and BMI2 instructions
+TEXT ·scalarMulVec(SB), $16-32
+	NO_LOCAL_POINTERS
+	MOVQ a+8(FP), R14
+	MOVQ b+16(FP), R15
+
+l7:
+	// A -> BP
+	// t[0] -> CX
+	// t[1] -> BX
+	// t[2] -> SI
+	// t[3] -> DI
+	// t[4] -> R8
+	// t[5] -> R9
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R15), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R14), CX, BX
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R14), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R14), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R14), AX, R8
+	ADOXQ AX, DI
+
+	// (A,t[4])  := x[4]*y[0] + A
+	MULXQ 32(R14), AX, R9
+	ADOXQ AX, R8
+
+	// (A,t[5])  := x[5]*y[0] + A
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[1] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[1] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[2] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[2] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[3] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[3] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 32(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[4] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[4] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[4] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[4] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[4] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[4] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 40(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[5] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[5] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[5] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[5] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[5] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[5] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// reduce element(CX,BX,SI,DI,R8,R9) using temp registers (R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+	REDUCE(CX,BX,SI,DI,R8,R9,R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+
+	MOVQ res+0(FP), AX
+	MOVQ CX, 0(AX)
+	MOVQ BX, 8(AX)
+	MOVQ SI, 16(AX)
+	MOVQ DI, 24(AX)
+	MOVQ R8, 32(AX)
+	MOVQ R9, 40(AX)
+	DECQ n+24(FP)
+	JEQ  l8
+	ADDQ $0x0000000000000030, R14
+	ADDQ $0x0000000000000030, res+0(FP)
+	JMP  l7
+
+l8:
+	RET
+
+// innerProdVec(res, a, b *Element, n uint64) res = res + a[0]*b[0] + ... + a[n-1]*b[n-1]
+// requires the ADX and BMI2 instructions
+TEXT ·innerProdVec(SB), $16-32
+	NO_LOCAL_POINTERS
+	MOVQ a+8(FP), R14
+	MOVQ b+16(FP), R15
+
+l9:
+	// A -> BP
+	// t[0] -> CX
+	// t[1] -> BX
+	// t[2] -> SI
+	// t[3] -> DI
+	// t[4] -> R8
+	// t[5] -> R9
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R15), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R14), CX, BX
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R14), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R14), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R14), AX, R8
+	ADOXQ AX, DI
+
+	// (A,t[4])  := x[4]*y[0] + A
+	MULXQ 32(R14), AX, R9
+	ADOXQ AX, R8
+
+	// (A,t[5])  := x[5]*y[0] + A
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[1] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[1] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[2] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[2] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[3] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[3] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 32(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[4] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[4] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[4] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[4] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[4] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[4] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 40(R15), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[5] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[5] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[5] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[5] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// (A,t[4])  := t[4] + x[4]*y[5] + A
+	ADCXQ BP, R8
+	MULXQ 32(R14), AX, BP
+	ADOXQ AX, R8
+
+	// (A,t[5])  := t[5] + x[5]*y[5] + A
+	ADCXQ BP, R9
+	MULXQ 40(R14), AX, BP
+	ADOXQ AX, R9
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R10
+	ADCXQ CX, AX
+	MOVQ  R10, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// (C,t[3]) := t[4] + m*q[4] + C
+	ADCXQ R8, DI
+	MULXQ q<>+32(SB), AX, R8
+	ADOXQ AX, DI
+
+	// (C,t[4]) := t[5] + m*q[5] + C
+	ADCXQ R9, R8
+	MULXQ q<>+40(SB), AX, R9
+	ADOXQ AX, R8
+
+	// t[5] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, R9
+	ADOXQ BP, R9
+
+	// reduce element(CX,BX,SI,DI,R8,R9) using temp registers (R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+	REDUCE(CX,BX,SI,DI,R8,R9,R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+
+	MOVQ res+0(FP), AX
+	ADDQ 0(AX), CX
+	ADCQ 8(AX), BX
+	ADCQ 16(AX), SI
+	ADCQ 24(AX), DI
+	ADCQ 32(AX), R8
+	ADCQ 40(AX), R9
+
+	// reduce element(CX,BX,SI,DI,R8,R9) using temp registers (R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+	REDUCE(CX,BX,SI,DI,R8,R9,R11,R12,R13,R10,s0-8(SP),s1-16(SP))
+
+	MOVQ CX, 0(AX)
+	MOVQ BX, 8(AX)
+	MOVQ SI, 16(AX)
+	MOVQ DI, 24(AX)
+	MOVQ R8, 32(AX)
+	MOVQ R9, 40(AX)
+	DECQ n+24(FP)
+	JEQ  l10
+	ADDQ $0x0000000000000030, R14
+	ADDQ $0x0000000000000030, R15
+	JMP  l9
+
+l10:
+	RET
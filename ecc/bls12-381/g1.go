@@ -149,6 +149,13 @@ func (p *G1Affine) FromJacobian(p1 *G1Jac) *G1Affine {
 	return p
 }
 
+// BatchFromJacobian returns BatchJacobianToAffineG1(points); p is not used.
+//
+// It exposes the batch conversion to generic code, see ecc.BatchNormalize.
+func (p *G1Affine) BatchFromJacobian(points []G1Jac) []G1Affine {
+	return BatchJacobianToAffineG1(points)
+}
+
 // String returns the string representation of the point or "O" if it is infinity
 func (p *G1Affine) String() string {
 	if p.IsInfinity() {
@@ -474,6 +481,13 @@ func (p *G1Jac) phi(a *G1Jac) *G1Jac {
 	return p
 }
 
+// phi sets p to ϕ(a) in affine coordinates, and returns p
+func (p *G1Affine) phi(a *G1Affine) *G1Affine {
+	p.X.Mul(&a.X, &thirdRootOneG1)
+	p.Y = a.Y
+	return p
+}
+
 // mulGLV computes the scalar multiplication using a windowed-GLV method
 // see https://www.iacr.org/archive/crypto2001/21390189.pdf
 func (p *G1Jac) mulGLV(a *G1Jac, s *big.Int) *G1Jac {
@@ -945,7 +959,8 @@ func (p *g1JacExtended) doubleMixed(q *G1Affine) *g1JacExtended {
 func BatchJacobianToAffineG1(points []G1Jac) []G1Affine {
 	result := make([]G1Affine, len(points))
 	zeroes := make([]bool, len(points))
-	accumulator := fp.One()
+	var accumulator fp.Element
+	accumulator.SetOne()
 
 	// batch invert all points[].Z coordinates with Montgomery batch inversion trick
 	// (stores points[].Z^-1 in result[i].X to avoid allocating a slice of fr.Elements)
@@ -1032,7 +1047,7 @@ func BatchScalarMultiplicationG1(base *G1Affine, scalars []fr.Element) []G1Affin
 	toReturn := make([]G1Jac, len(scalars))
 
 	// partition the scalars into digits
-	digits, _ := partitionScalars(scalars, c, runtime.NumCPU())
+	digits, _ := partitionScalars(scalars, nil, c, fr.Bits, runtime.NumCPU())
 
 	// for each digit, take value in the base table, double it c time, voilà.
 	parallel.Execute(len(scalars), func(start, end int) {
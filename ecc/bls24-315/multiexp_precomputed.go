@@ -0,0 +1,309 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// G1MultiExpTable holds multiples of a set of bases, precomputed once to speed up
+// the multi-exponentiations with these bases, see G1Affine.MultiExpPrecomputed.
+//
+// The scalars are split in c-bit windows. For each base Pᵢ, the table stores the points [2^{k⋅stride⋅c}]Pᵢ,
+// so that the windows k⋅stride + o, for all k, share their buckets: the multi-exponentiation processes
+// stride rounds of len(bases)⋅nbTables points, with (stride-1)⋅c doublings, instead of ⌈fr.Bits/c⌉
+// rounds of len(bases) points.
+//
+// implements io.ReaderFrom and io.WriterTo
+type G1MultiExpTable struct {
+	c        uint64     // window size
+	stride   uint64     // number of windows between two consecutive multiples of a base
+	nbTables uint64     // number of multiples stored per base, ⌈nbChunks/stride⌉
+	points   []G1Affine // points[i⋅nbTables + k] = [2^{k⋅stride⋅c}]Pᵢ
+}
+
+// NewG1MultiExpTable precomputes the multiples of the bases used by G1Affine.MultiExpPrecomputed.
+//
+// The table holds at most max(maxPoints, len(bases)) points; if maxPoints <= 0 it is not bounded,
+// and the multi-exponentiations need no doubling at all.
+func NewG1MultiExpTable(bases []G1Affine, maxPoints int) *G1MultiExpTable {
+	n := uint64(len(bases))
+
+	// pick the window size minimizing the number of additions in the buckets and of the bucket reductions
+	implementedCs := []uint64{2, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	var t G1MultiExpTable
+	minCost := uint64(0)
+	for _, c := range implementedCs {
+		if lastC(c) > c {
+			// the digits of the last window must fit in the c-bit buckets
+			continue
+		}
+		nbChunks := computeNbChunks(c)
+		nbTables := nbChunks
+		if maxPoints > 0 && uint64(maxPoints) < nbChunks*n {
+			nbTables = uint64(maxPoints) / n
+			if nbTables == 0 {
+				nbTables = 1
+			}
+		}
+		stride := (nbChunks + nbTables - 1) / nbTables
+		nbTables = (nbChunks + stride - 1) / stride
+
+		cost := stride * (nbTables*n + (1 << c))
+		if minCost == 0 || cost < minCost {
+			minCost = cost
+			t.c, t.stride, t.nbTables = c, stride, nbTables
+		}
+	}
+
+	t.points = make([]G1Affine, n*t.nbTables)
+	parallel.Execute(len(bases), func(start, end int) {
+		// process the bases by blocks, to bound the memory used by the Jacobian points
+		const blockSize = 1 << 10
+		nbTables := int(t.nbTables)
+		nbDoublings := int(t.stride * t.c)
+		jac := make([]G1Jac, blockSize*nbTables)
+		for s := start; s < end; s += blockSize {
+			e := s + blockSize
+			if e > end {
+				e = end
+			}
+			block := jac[:(e-s)*nbTables]
+			for i := s; i < e; i++ {
+				multiples := block[(i-s)*nbTables : (i-s+1)*nbTables]
+				multiples[0].FromAffine(&bases[i])
+				for k := 1; k < nbTables; k++ {
+					multiples[k].Set(&multiples[k-1])
+					for j := 0; j < nbDoublings; j++ {
+						multiples[k].DoubleAssign()
+					}
+				}
+			}
+			copy(t.points[s*nbTables:e*nbTables], BatchJacobianToAffineG1(block))
+		}
+	})
+
+	return &t
+}
+
+// Len returns the number of bases of the table, or 0 if the table isn't initialized.
+func (t *G1MultiExpTable) Len() int {
+	if t.nbTables == 0 {
+		return 0
+	}
+	return len(t.points) / int(t.nbTables)
+}
+
+// MultiExpPrecomputed computes ∑ᵢ [scalars[i]]Pᵢ where Pᵢ are the first len(scalars) bases of the table.
+//
+// This call return an error if the table isn't initialized, if len(scalars) > table.Len() or if provided config is invalid.
+func (p *G1Affine) MultiExpPrecomputed(table *G1MultiExpTable, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Affine, error) {
+	var _p G1Jac
+	if _, err := _p.MultiExpPrecomputed(table, scalars, config); err != nil {
+		return nil, err
+	}
+	p.FromJacobian(&_p)
+	return p, nil
+}
+
+// MultiExpPrecomputed computes ∑ᵢ [scalars[i]]Pᵢ where Pᵢ are the first len(scalars) bases of the table.
+//
+// This call return an error if the table isn't initialized, if len(scalars) > table.Len() or if provided config is invalid.
+func (p *G1Jac) MultiExpPrecomputed(table *G1MultiExpTable, scalars []fr.Element, config ecc.MultiExpConfig) (*G1Jac, error) {
+	if table.nbTables == 0 {
+		return nil, errors.New("table isn't initialized")
+	}
+	nbScalars := len(scalars)
+	if nbScalars > table.Len() {
+		return nil, errors.New("len(scalars) > table.Len()")
+	}
+
+	// if nbTasks is not set, use all available CPUs
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU() * 2
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	c, stride, nbTables := table.c, table.stride, int(table.nbTables)
+	nbChunks := computeNbChunks(c)
+	digits, _ := partitionScalars(scalars, nil, c, fr.Bits, config.NbTasks)
+
+	points := table.points[:nbScalars*nbTables]
+	nbBuckets := 1 << (c - 1)
+	stat := chunkStat{nbBucketFilled: nbBuckets}
+	if len(points) < nbBuckets {
+		stat.nbBucketFilled = len(points)
+	}
+	processChunk := getChunkProcessorG1(c, stat)
+
+	// each round is split in several tasks, each with its own buckets; there is no benefit
+	// in having more tasks than CPUs here
+	nbSplits := config.NbTasks
+	if nbSplits > runtime.NumCPU() {
+		nbSplits = runtime.NumCPU()
+	}
+	nbSplits /= int(stride)
+	if nbSplits < 1 || nbSplits > len(points) {
+		nbSplits = 1
+	}
+
+	chRounds := make([]chan g1JacExtended, stride)
+	for o := range chRounds {
+		chRounds[o] = make(chan g1JacExtended, 1)
+		go func(o int) {
+			// the digits of the windows k⋅stride + o, in the layout of the table
+			roundDigits := make([]uint16, len(points))
+			for k := 0; k < nbTables; k++ {
+				chunk := k*int(stride) + o
+				if chunk >= int(nbChunks) {
+					break
+				}
+				chunkDigits := digits[chunk*nbScalars : (chunk+1)*nbScalars]
+				for i := range chunkDigits {
+					roundDigits[i*nbTables+k] = chunkDigits[i]
+				}
+			}
+
+			chSplit := make(chan g1JacExtended, nbSplits)
+			for i := 0; i < nbSplits; i++ {
+				start, end := i*len(points)/nbSplits, (i+1)*len(points)/nbSplits
+				go processChunk(uint64(o), chSplit, c, points[start:end], roundDigits[start:end], nil)
+			}
+			total := <-chSplit
+			for i := 1; i < nbSplits; i++ {
+				s := <-chSplit
+				total.add(&s)
+			}
+			chRounds[o] <- total
+		}(o)
+	}
+
+	return msmReduceChunkG1Affine(p, int(c), chRounds), nil
+}
+
+// WriteTo writes the binary encoding of the table to w, with the points uncompressed.
+func (t *G1MultiExpTable) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	var n int64
+	for _, v := range []uint64{t.c, t.stride, uint64(t.Len())} {
+		binary.BigEndian.PutUint64(buf[:], v)
+		written, err := w.Write(buf[:])
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	for i := range t.points {
+		buf := t.points[i].RawBytes()
+		written, err := w.Write(buf[:])
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom decodes a table from r, as written by WriteTo.
+func (t *G1MultiExpTable) ReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, true)
+}
+
+// UnsafeReadFrom decodes a table from r, as written by WriteTo, without checking
+// that the points are in the correct subgroup.
+func (t *G1MultiExpTable) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return t.readFrom(r, false)
+}
+
+func (t *G1MultiExpTable) readFrom(r io.Reader, subGroupCheck bool) (int64, error) {
+	var header [24]byte
+	read, err := io.ReadFull(r, header[:])
+	n := int64(read)
+	if err != nil {
+		return n, err
+	}
+	c := binary.BigEndian.Uint64(header[0:8])
+	stride := binary.BigEndian.Uint64(header[8:16])
+	nbBases := binary.BigEndian.Uint64(header[16:24])
+
+	valid := false
+	for _, _c := range []uint64{2, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16} {
+		valid = valid || (c == _c && lastC(c) <= c)
+	}
+	if !valid || stride == 0 || stride > computeNbChunks(c) {
+		return n, errors.New("invalid table parameters")
+	}
+	nbTables := (computeNbChunks(c) + stride - 1) / stride
+
+	// the header isn't trusted: the size of the table is bounded, and the points are read in batches,
+	// so that a truncated input fails before allocating the whole table
+	if nbBases > math.MaxUint32 || nbBases*nbTables > math.MaxInt/SizeOfG1AffineUncompressed {
+		return n, errors.New("invalid table size")
+	}
+	const batchSize = 1 << 16
+	nbPoints := int(nbBases * nbTables)
+	batchLen := nbPoints
+	if batchLen > batchSize {
+		batchLen = batchSize
+	}
+	points := make([]G1Affine, 0, batchLen)
+	buf := make([]byte, batchLen*SizeOfG1AffineUncompressed)
+	for len(points) < nbPoints {
+		m := nbPoints - len(points)
+		if m > batchLen {
+			m = batchLen
+		}
+		read, err = io.ReadFull(r, buf[:m*SizeOfG1AffineUncompressed])
+		n += int64(read)
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return n, err
+		}
+
+		points = append(points, make([]G1Affine, m)...)
+		batch := points[len(points)-m:]
+		var lock sync.Mutex
+		parallel.Execute(m, func(start, end int) {
+			for i := start; i < end; i++ {
+				if _, _err := batch[i].setBytes(buf[i*SizeOfG1AffineUncompressed:], subGroupCheck); _err != nil {
+					lock.Lock()
+					err = _err
+					lock.Unlock()
+					return
+				}
+			}
+		})
+		if err != nil {
+			return n, err
+		}
+	}
+
+	t.c, t.stride, t.nbTables, t.points = c, stride, nbTables, points
+	return n, nil
+}
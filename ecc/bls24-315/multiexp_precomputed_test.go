@@ -0,0 +1,182 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls24315
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMultiExpPrecomputedG1(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 2
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	const nbSamples = 73
+
+	var samplePoints [nbSamples]G1Affine
+	var g G1Jac
+	g.Set(&g1Gen)
+	for i := 0; i < nbSamples; i++ {
+		samplePoints[i].FromJacobian(&g)
+		g.AddAssign(&g1Gen)
+	}
+	samplePoints[nbSamples/2].setInfinity()
+
+	// unbounded, bounded, and with a single multiple per base
+	tables := []*G1MultiExpTable{
+		NewG1MultiExpTable(samplePoints[:], 0),
+		NewG1MultiExpTable(samplePoints[:], 5*nbSamples),
+		NewG1MultiExpTable(samplePoints[:], 1),
+	}
+
+	for i, table := range tables {
+		table := table
+		properties.Property(fmt.Sprintf("[G1] Multi exponentiation with precomputed table %d should be consistent with MultiExp", i), prop.ForAll(
+			func(mixer fr.Element) bool {
+				var sampleScalars [nbSamples]fr.Element
+				for i := 0; i < nbSamples; i++ {
+					sampleScalars[i].SetUint64(uint64(i)).
+						Mul(&sampleScalars[i], &mixer)
+				}
+				sampleScalars[1].SetOne().Neg(&sampleScalars[1])
+
+				var expected, precomputed, prefix, expectedPrefix G1Affine
+				expected.MultiExp(samplePoints[:], sampleScalars[:], ecc.MultiExpConfig{})
+				precomputed.MultiExpPrecomputed(table, sampleScalars[:], ecc.MultiExpConfig{})
+
+				// the table can be used with fewer scalars
+				expectedPrefix.MultiExp(samplePoints[:nbSamples/3], sampleScalars[:nbSamples/3], ecc.MultiExpConfig{})
+				prefix.MultiExpPrecomputed(table, sampleScalars[:nbSamples/3], ecc.MultiExpConfig{NbTasks: 3})
+
+				return expected.Equal(&precomputed) && expectedPrefix.Equal(&prefix)
+			},
+			genScalar,
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	t.Run("too many scalars", func(t *testing.T) {
+		var p G1Affine
+		if _, err := p.MultiExpPrecomputed(tables[0], make([]fr.Element, nbSamples+1), ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var table G1MultiExpTable
+		if table.Len() != 0 {
+			t.Fatal("expected an empty table")
+		}
+		var p G1Affine
+		if _, err := p.MultiExpPrecomputed(&table, nil, ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("serialization round-trip", func(t *testing.T) {
+		for _, table := range tables {
+			var buf bytes.Buffer
+			written, err := table.WriteTo(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded G1MultiExpTable
+			read, err := decoded.ReadFrom(bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if read != written || decoded.Len() != table.Len() || decoded.c != table.c || decoded.stride != table.stride {
+				t.Fatal("decoded table doesn't match")
+			}
+			for i := range table.points {
+				if !decoded.points[i].Equal(&table.points[i]) {
+					t.Fatal("decoded table doesn't match")
+				}
+			}
+			if _, err := decoded.ReadFrom(bytes.NewReader(buf.Bytes()[:buf.Len()-1])); err == nil {
+				t.Fatal("expected an error on truncated input")
+			}
+		}
+	})
+
+	t.Run("malformed header", func(t *testing.T) {
+		// valid window size and stride, but a number of bases which overflows, or doesn't match the input
+		for _, nbBases := range []uint64{math.MaxUint64, math.MaxUint64 / 2, math.MaxUint32 + 1, math.MaxUint32} {
+			var header [24]byte
+			binary.BigEndian.PutUint64(header[0:8], tables[0].c)
+			binary.BigEndian.PutUint64(header[8:16], tables[0].stride)
+			binary.BigEndian.PutUint64(header[16:24], nbBases)
+			var decoded G1MultiExpTable
+			if _, err := decoded.ReadFrom(bytes.NewReader(header[:])); err == nil {
+				t.Fatalf("expected an error with %d bases", nbBases)
+			}
+		}
+	})
+}
+
+func BenchmarkMultiExpPrecomputedG1(b *testing.B) {
+	const nbSamples = 1 << 20
+
+	var (
+		samplePoints  [nbSamples]G1Affine
+		sampleScalars [nbSamples]fr.Element
+	)
+
+	fillBenchScalars(sampleScalars[:])
+	fillBenchBasesG1(samplePoints[:])
+
+	var testPoint G1Affine
+
+	for i := 16; i <= 20; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+
+		for _, maxPoints := range []int{4 * using, 0} {
+			table := NewG1MultiExpTable(samplePoints[:using], maxPoints)
+			b.Run(fmt.Sprintf("%d points-precomputed(%d tables)", using, table.nbTables), func(b *testing.B) {
+				b.ResetTimer()
+				for j := 0; j < b.N; j++ {
+					testPoint.MultiExpPrecomputed(table, sampleScalars[:using], ecc.MultiExpConfig{})
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2020 Consensys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package twistededwards
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestMultiExp(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 2
+	} else {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenBigInt()
+	params := GetEdwardsCurve()
+
+	const nbSamples = 73
+
+	var samplePoints [nbSamples]PointAffine
+	samplePoints[0].Set(&params.Base)
+	for i := 1; i < nbSamples; i++ {
+		samplePoints[i].Add(&samplePoints[i-1], &params.Base)
+	}
+	// the same point twice, and its opposite, for the doublings (and P - P) in the buckets
+	samplePoints[nbSamples-2] = samplePoints[nbSamples-1]
+	samplePoints[nbSamples-3].Neg(&samplePoints[nbSamples-1])
+	samplePoints[nbSamples/2].setInfinity()
+
+	// sampleScalars returns the scalars and their sum on the sample points; some of them are
+	// negative or larger than the order of the subgroup
+	sampleScalars := func(mixer big.Int) ([nbSamples]big.Int, PointAffine) {
+		var scalars [nbSamples]big.Int
+		for i := 0; i < nbSamples; i++ {
+			scalars[i].SetUint64(uint64(i)).Mul(&scalars[i], &mixer)
+		}
+		scalars[1].SetInt64(-1)
+		scalars[2].Neg(&mixer)
+		scalars[3].Add(&mixer, &params.Order)
+		scalars[4].Sub(&params.Order, big.NewInt(1))
+		scalars[nbSamples-2].Set(&scalars[nbSamples-1])
+
+		var expected PointAffine
+		expected.setInfinity()
+		for i := 0; i < nbSamples; i++ {
+			if samplePoints[i].IsZero() {
+				// [s]O = O
+				continue
+			}
+			var s big.Int
+			var q PointAffine
+			s.Mod(&scalars[i], &params.Order)
+			q.ScalarMultiplication(&samplePoints[i], &s)
+			expected.Add(&expected, &q)
+		}
+		return scalars, expected
+	}
+
+	cRange := []uint64{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	if testing.Short() {
+		// test the buckets in extended coordinates, and the batch affine ones
+		cRange = []uint64{5, 12}
+	}
+
+	properties.Property(fmt.Sprintf("[TWISTED EDWARDS] Multi exponentiation (c in %v) should be consistent with sum of scalar multiplications", cRange), prop.ForAll(
+		func(mixer big.Int) bool {
+			scalars, expected := sampleScalars(mixer)
+			words, nbBits := scalarsToWords(scalars[:], 0)
+
+			for _, c := range cRange {
+				var res PointExtended
+				var resAffine PointAffine
+				res.multiExp(samplePoints[:], words, nbBits, c, ecc.MultiExpConfig{NbTasks: 3})
+				if !resAffine.FromExtended(&res).Equal(&expected) {
+					t.Logf("c = %d", c)
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.Property("[TWISTED EDWARDS] Multi exponentiation should be consistent with sum of scalar multiplications", prop.ForAll(
+		func(mixer big.Int) bool {
+			scalars, expected := sampleScalars(mixer)
+
+			var res PointAffine
+			res.MultiExp(samplePoints[:], scalars[:], ecc.MultiExpConfig{})
+			return res.Equal(&expected)
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	t.Run("points of order 2", func(t *testing.T) {
+		// (0, -1) has no image on the Montgomery curve
+		var p, expected PointAffine
+		p.Y.SetOne().Neg(&p.Y)
+		points := []PointAffine{p, params.Base, p}
+		scalars := []big.Int{*big.NewInt(1), *big.NewInt(2), *big.NewInt(4)}
+		expected.Double(&params.Base).Add(&expected, &p)
+
+		words, nbBits := scalarsToWords(scalars, 0)
+		for _, c := range []uint64{3, 12} {
+			var res PointExtended
+			var resAffine PointAffine
+			res.multiExp(points, words, nbBits, c, ecc.MultiExpConfig{NbTasks: 3})
+			if !resAffine.FromExtended(&res).Equal(&expected) {
+				t.Fatalf("c = %d: wrong result", c)
+			}
+		}
+	})
+
+	t.Run("points outside the subgroup", func(t *testing.T) {
+		// Q = Base + T, with T = (0, -1) of order 2: [s]Q = [s mod r]Base + [s mod 2]T,
+		// so the scalars can't be reduced modulo r
+		var T, Q PointAffine
+		T.Y.SetOne().Neg(&T.Y)
+		Q.Add(&params.Base, &T)
+
+		var order big.Int
+		params.Cofactor.BigInt(&order)
+		order.Mul(&order, &params.Order)
+
+		scalars := make([]big.Int, 8)
+		scalars[0].Add(&params.Order, big.NewInt(5))
+		scalars[1].SetInt64(-3)
+		scalars[2].Set(&params.Order)
+		scalars[3].Neg(&params.Order)
+		scalars[4].Add(&order, big.NewInt(7))
+		scalars[5].Sub(&order, big.NewInt(1))
+		scalars[6].Neg(&order).Sub(&scalars[6], big.NewInt(2))
+		scalars[7].Lsh(&order, 3).Add(&scalars[7], &params.Order)
+		points := make([]PointAffine, len(scalars))
+
+		var expected, sum PointAffine
+		sum.setInfinity()
+		for i := range scalars {
+			points[i] = Q
+			var s, parity big.Int
+			s.Mod(&scalars[i], &params.Order)
+			expected.ScalarMultiplication(&params.Base, &s)
+			if parity.Mod(&scalars[i], big.NewInt(2)).Sign() != 0 {
+				expected.Add(&expected, &T)
+			}
+			sum.Add(&sum, &expected)
+
+			var res PointAffine
+			if _, err := res.MultiExp(points[i:i+1], scalars[i:i+1], ecc.MultiExpConfig{}); err != nil {
+				t.Fatal(err)
+			}
+			if !res.Equal(&expected) {
+				t.Fatalf("wrong result with the scalar %s", scalars[i].String())
+			}
+		}
+
+		words, nbBits := scalarsToWords(scalars, 0)
+		for _, c := range []uint64{3, 12} {
+			var res PointExtended
+			var resAffine PointAffine
+			res.multiExp(points, words, nbBits, c, ecc.MultiExpConfig{NbTasks: 3})
+			if !resAffine.FromExtended(&res).Equal(&sum) {
+				t.Fatalf("c = %d: wrong result", c)
+			}
+		}
+	})
+
+	t.Run("len(points) != len(scalars)", func(t *testing.T) {
+		var p PointAffine
+		if _, err := p.MultiExp(samplePoints[:], make([]big.Int, nbSamples-1), ecc.MultiExpConfig{}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestBatchExtendedToAffine(t *testing.T) {
+	params := GetEdwardsCurve()
+
+	const nbSamples = 10
+	var points [nbSamples]PointExtended
+	points[0].FromAffine(&params.Base)
+	for i := 1; i < nbSamples; i++ {
+		points[i].Double(&points[i-1])
+	}
+	points[nbSamples/2].setInfinity()
+
+	var p PointAffine
+	affine := p.BatchFromExtended(points[:])
+	for i := range points {
+		var expected PointAffine
+		expected.FromExtended(&points[i])
+		if !affine[i].Equal(&expected) {
+			t.Fatal("BatchExtendedToAffine doesn't match FromExtended")
+		}
+	}
+	if len(BatchExtendedToAffine(nil)) != 0 {
+		t.Fatal("expected an empty slice")
+	}
+}
+
+func BenchmarkMultiExp(b *testing.B) {
+	params := GetEdwardsCurve()
+
+	const pow = 16
+	const nbSamples = 1 << pow
+
+	var (
+		samplePoints  [nbSamples]PointAffine
+		sampleScalars [nbSamples]big.Int
+	)
+
+	// random multiples of the base point
+	r := rand.New(rand.NewSource(0)) //#nosec G404 weak rng is fine here
+	extended := make([]PointExtended, nbSamples)
+	var base PointExtended
+	base.FromAffine(&params.Base)
+	extended[0].ScalarMultiplication(&base, big.NewInt(r.Int63()))
+	for i := 1; i < nbSamples; i++ {
+		extended[i].Add(&extended[i-1], &base)
+	}
+	copy(samplePoints[:], BatchExtendedToAffine(extended))
+	for i := range sampleScalars {
+		sampleScalars[i].Rand(r, &params.Order)
+	}
+
+	var testPoint PointExtended
+
+	for i := 8; i <= pow; i += 2 {
+		using := 1 << i
+
+		b.Run(fmt.Sprintf("%d points", using), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				testPoint.MultiExp(samplePoints[:using], sampleScalars[:using], ecc.MultiExpConfig{})
+			}
+		})
+	}
+}
+
+func BenchmarkBatchExtendedToAffine(b *testing.B) {
+	params := GetEdwardsCurve()
+
+	const nbSamples = 1 << 10
+	var points [nbSamples]PointExtended
+	points[0].FromAffine(&params.Base)
+	for i := 1; i < nbSamples; i++ {
+		points[i].Double(&points[i-1])
+	}
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		BatchExtendedToAffine(points[:])
+	}
+}
@@ -77,6 +77,15 @@ func randFieldElement(rand io.Reader) (k *big.Int, err error) {
 	return
 }
 
+// nonceInverse sets kInv = k⁻¹ (mod order); the nonce k is secret, so the inverse is
+// computed in constant time (see fr.Element.InverseCT)
+func nonceInverse(kInv, k *big.Int) {
+	var e fr.Element
+	e.SetBigInt(k)
+	e.InverseCT(&e)
+	e.BigInt(kInv)
+}
+
 // GenerateKey generates a public and private key pair.
 func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 
@@ -208,7 +217,7 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 
 			var P bls24317.G1Affine
 			P.ScalarMultiplicationBase(k)
-			kInv.ModInverse(k, order)
+			nonceInverse(kInv, k)
 
 			P.X.BigInt(r)
 
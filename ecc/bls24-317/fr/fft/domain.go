@@ -74,7 +74,6 @@ func NewDomain(m uint64, opts ...DomainOption) *Domain {
 	domain.Cardinality = uint64(x)
 
 	// generator of the largest 2-adic subgroup
-
 	domain.FrMultiplicativeGen.SetUint64(7)
 
 	if opt.shift != nil {
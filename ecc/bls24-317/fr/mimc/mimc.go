@@ -65,7 +65,7 @@ func NewMiMC() hash.Hash {
 // Reset resets the Hash to its initial state.
 func (d *digest) Reset() {
 	d.data = d.data[:0]
-	d.h = fr.Element{0, 0, 0, 0}
+	d.h.SetZero()
 }
 
 // Sum appends the current hash to b and returns the resulting slice.
@@ -150,16 +150,15 @@ func (d *digest) checksum() fr.Element {
 func (d *digest) encrypt(m fr.Element) fr.Element {
 	once.Do(initConstants) // init constants
 
-	var tmp1, tmp2 fr.Element
+	var tmp fr.Element
 	for i := 0; i < mimcNbRounds; i++ {
 		// m = (m+k+c)^7
-		tmp1.Add(&m, &d.h).Add(&tmp1, &mimcConstants[i])
-		tmp2.Square(&tmp1)
-		m.Square(&tmp2).
-			Mul(&m, &tmp2).
-			Mul(&m, &tmp1)
+		tmp.Add(&m, &d.h).Add(&tmp, &mimcConstants[i])
+		m.Square(&tmp).
+			Mul(&m, &tmp).
+			Square(&m).
+			Mul(&m, &tmp)
 	}
-
 	m.Add(&m, &d.h)
 	return m
 }
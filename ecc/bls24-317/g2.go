@@ -141,6 +141,13 @@ func (p *G2Affine) FromJacobian(p1 *G2Jac) *G2Affine {
 	return p
 }
 
+// BatchFromJacobian returns BatchJacobianToAffineG2(points); p is not used.
+//
+// It exposes the batch conversion to generic code, see ecc.BatchNormalize.
+func (p *G2Affine) BatchFromJacobian(points []G2Jac) []G2Affine {
+	return BatchJacobianToAffineG2(points)
+}
+
 // String returns the string representation of the point or "O" if it is infinity
 func (p *G2Affine) String() string {
 	if p.IsInfinity() {
@@ -462,6 +469,13 @@ func (p *G2Jac) psi(a *G2Jac) *G2Jac {
 	return p
 }
 
+// psi sets p to ψ(a) in affine coordinates, and returns p
+func (p *G2Affine) psi(a *G2Affine) *G2Affine {
+	p.X.Frobenius(&a.X).Mul(&p.X, &endo.u)
+	p.Y.Frobenius(&a.Y).Mul(&p.Y, &endo.v)
+	return p
+}
+
 // ϕ assigns p to ϕ(a) where ϕ: (x,y) → (w x,y), and returns p
 // where w is a third root of unity in 𝔽p
 func (p *G2Jac) phi(a *G2Jac) *G2Jac {
@@ -470,6 +484,13 @@ func (p *G2Jac) phi(a *G2Jac) *G2Jac {
 	return p
 }
 
+// phi sets p to ϕ(a) in affine coordinates, and returns p
+func (p *G2Affine) phi(a *G2Affine) *G2Affine {
+	p.X.MulByElement(&a.X, &thirdRootOneG2)
+	p.Y = a.Y
+	return p
+}
+
 // mulGLV computes the scalar multiplication using a windowed-GLV method
 // see https://www.iacr.org/archive/crypto2001/21390189.pdf
 func (p *G2Jac) mulGLV(a *G2Jac, s *big.Int) *G2Jac {
@@ -927,6 +948,56 @@ func (p *g2Proj) FromAffine(Q *G2Affine) *g2Proj {
 	return p
 }
 
+// BatchJacobianToAffineG2 converts points in Jacobian coordinates to Affine coordinates
+// performing a single field inversion (Montgomery batch inversion trick).
+func BatchJacobianToAffineG2(points []G2Jac) []G2Affine {
+	result := make([]G2Affine, len(points))
+	zeroes := make([]bool, len(points))
+	var accumulator fptower.E4
+	accumulator.SetOne()
+
+	// batch invert all points[].Z coordinates with Montgomery batch inversion trick
+	// (stores points[].Z^-1 in result[i].X to avoid allocating a slice of fr.Elements)
+	for i := 0; i < len(points); i++ {
+		if points[i].Z.IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		result[i].X = accumulator
+		accumulator.Mul(&accumulator, &points[i].Z)
+	}
+
+	var accInverse fptower.E4
+	accInverse.Inverse(&accumulator)
+
+	for i := len(points) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			// do nothing, (X=0, Y=0) is infinity point in affine
+			continue
+		}
+		result[i].X.Mul(&result[i].X, &accInverse)
+		accInverse.Mul(&accInverse, &points[i].Z)
+	}
+
+	// batch convert to affine.
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if zeroes[i] {
+				// do nothing, (X=0, Y=0) is infinity point in affine
+				continue
+			}
+			var a, b fptower.E4
+			a = result[i].X
+			b.Square(&a)
+			result[i].X.Mul(&points[i].X, &b)
+			result[i].Y.Mul(&points[i].Y, &b).
+				Mul(&result[i].Y, &a)
+		}
+	})
+
+	return result
+}
+
 // BatchScalarMultiplicationG2 multiplies the same base by all scalars
 // and return resulting points in affine coordinates
 // uses a simple windowed-NAF like exponentiation algorithm
@@ -968,7 +1039,7 @@ func BatchScalarMultiplicationG2(base *G2Affine, scalars []fr.Element) []G2Affin
 	toReturn := make([]G2Affine, len(scalars))
 
 	// partition the scalars into digits
-	digits, _ := partitionScalars(scalars, c, runtime.NumCPU())
+	digits, _ := partitionScalars(scalars, nil, c, fr.Bits, runtime.NumCPU())
 
 	// for each digit, take value in the base table, double it c time, voilà.
 	parallel.Execute(len(scalars), func(start, end int) {
@@ -25,4 +25,10 @@
 // - Wikipedia: https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
 // - FIPS 186-4: https://nvlpubs.nist.gov/nistpubs/FIPS/NIST.FIPS.186-4.pdf
 // - SEC 1, v-2: https://www.secg.org/sec1-v2.pdf
+//
+// # Constant time
+//
+// Field inversions, exponentiations and square roots on secret values (the inverse of the
+// nonce when signing) use the constant-time variants of fr.Element (InverseCT, ExpCT, ...).
+// The scalar multiplications and the math/big arithmetic are not constant time.
 package ecdsa
@@ -0,0 +1,464 @@
+//go:build 386 || arm || mips || mipsle
+// +build 386 arm mips mipsle
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import "math/bits"
+
+// qInvNeg32 = -q⁻¹ mod 2³²
+const qInvNeg32 = uint32(qInvNeg & 0xFFFFFFFF)
+
+// Mul z = x * y (mod q)
+//
+// x and y must be less than q
+func (z *Element) Mul(x, y *Element) *Element {
+	mul32(z, x, y)
+	return z
+}
+
+// Square z = x * x (mod q)
+//
+// x must be less than q
+func (z *Element) Square(x *Element) *Element {
+	mul32(z, x, x)
+	return z
+}
+
+// mul32 sets z = x * y (mod q) using the CIOS Montgomery multiplication on 32-bit words.
+func mul32(z, x, y *Element) {
+	var a, b [8]uint32
+	a[0], a[1] = uint32(x[0]), uint32(x[0]>>32)
+	a[2], a[3] = uint32(x[1]), uint32(x[1]>>32)
+	a[4], a[5] = uint32(x[2]), uint32(x[2]>>32)
+	a[6], a[7] = uint32(x[3]), uint32(x[3]>>32)
+	b[0], b[1] = uint32(y[0]), uint32(y[0]>>32)
+	b[2], b[3] = uint32(y[1]), uint32(y[1]>>32)
+	b[4], b[5] = uint32(y[2]), uint32(y[2]>>32)
+	b[6], b[7] = uint32(y[3]), uint32(y[3]>>32)
+
+	// t holds the running result on 10 words
+	var t [10]uint32
+	var C uint64
+	var m uint32
+
+	// t = t + a * b[0]
+	C = uint64(a[0]) * uint64(b[0])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(a[1])*uint64(b[0])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(a[2])*uint64(b[0])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(a[3])*uint64(b[0])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(a[4])*uint64(b[0])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(a[5])*uint64(b[0])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(a[6])*uint64(b[0])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(a[7])*uint64(b[0])
+	t[7] = uint32(C)
+	C = C >> 32
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t = t + a * b[1]
+	C = uint64(t[0]) + uint64(a[0])*uint64(b[1])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(t[1]) + uint64(a[1])*uint64(b[1])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(t[2]) + uint64(a[2])*uint64(b[1])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(t[3]) + uint64(a[3])*uint64(b[1])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(t[4]) + uint64(a[4])*uint64(b[1])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(t[5]) + uint64(a[5])*uint64(b[1])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(t[6]) + uint64(a[6])*uint64(b[1])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(t[7]) + uint64(a[7])*uint64(b[1])
+	t[7] = uint32(C)
+	C = C>>32 + uint64(t[8])
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t = t + a * b[2]
+	C = uint64(t[0]) + uint64(a[0])*uint64(b[2])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(t[1]) + uint64(a[1])*uint64(b[2])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(t[2]) + uint64(a[2])*uint64(b[2])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(t[3]) + uint64(a[3])*uint64(b[2])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(t[4]) + uint64(a[4])*uint64(b[2])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(t[5]) + uint64(a[5])*uint64(b[2])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(t[6]) + uint64(a[6])*uint64(b[2])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(t[7]) + uint64(a[7])*uint64(b[2])
+	t[7] = uint32(C)
+	C = C>>32 + uint64(t[8])
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t = t + a * b[3]
+	C = uint64(t[0]) + uint64(a[0])*uint64(b[3])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(t[1]) + uint64(a[1])*uint64(b[3])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(t[2]) + uint64(a[2])*uint64(b[3])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(t[3]) + uint64(a[3])*uint64(b[3])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(t[4]) + uint64(a[4])*uint64(b[3])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(t[5]) + uint64(a[5])*uint64(b[3])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(t[6]) + uint64(a[6])*uint64(b[3])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(t[7]) + uint64(a[7])*uint64(b[3])
+	t[7] = uint32(C)
+	C = C>>32 + uint64(t[8])
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t = t + a * b[4]
+	C = uint64(t[0]) + uint64(a[0])*uint64(b[4])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(t[1]) + uint64(a[1])*uint64(b[4])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(t[2]) + uint64(a[2])*uint64(b[4])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(t[3]) + uint64(a[3])*uint64(b[4])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(t[4]) + uint64(a[4])*uint64(b[4])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(t[5]) + uint64(a[5])*uint64(b[4])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(t[6]) + uint64(a[6])*uint64(b[4])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(t[7]) + uint64(a[7])*uint64(b[4])
+	t[7] = uint32(C)
+	C = C>>32 + uint64(t[8])
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t = t + a * b[5]
+	C = uint64(t[0]) + uint64(a[0])*uint64(b[5])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(t[1]) + uint64(a[1])*uint64(b[5])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(t[2]) + uint64(a[2])*uint64(b[5])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(t[3]) + uint64(a[3])*uint64(b[5])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(t[4]) + uint64(a[4])*uint64(b[5])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(t[5]) + uint64(a[5])*uint64(b[5])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(t[6]) + uint64(a[6])*uint64(b[5])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(t[7]) + uint64(a[7])*uint64(b[5])
+	t[7] = uint32(C)
+	C = C>>32 + uint64(t[8])
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t = t + a * b[6]
+	C = uint64(t[0]) + uint64(a[0])*uint64(b[6])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(t[1]) + uint64(a[1])*uint64(b[6])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(t[2]) + uint64(a[2])*uint64(b[6])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(t[3]) + uint64(a[3])*uint64(b[6])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(t[4]) + uint64(a[4])*uint64(b[6])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(t[5]) + uint64(a[5])*uint64(b[6])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(t[6]) + uint64(a[6])*uint64(b[6])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(t[7]) + uint64(a[7])*uint64(b[6])
+	t[7] = uint32(C)
+	C = C>>32 + uint64(t[8])
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t = t + a * b[7]
+	C = uint64(t[0]) + uint64(a[0])*uint64(b[7])
+	t[0] = uint32(C)
+	C = C>>32 + uint64(t[1]) + uint64(a[1])*uint64(b[7])
+	t[1] = uint32(C)
+	C = C>>32 + uint64(t[2]) + uint64(a[2])*uint64(b[7])
+	t[2] = uint32(C)
+	C = C>>32 + uint64(t[3]) + uint64(a[3])*uint64(b[7])
+	t[3] = uint32(C)
+	C = C>>32 + uint64(t[4]) + uint64(a[4])*uint64(b[7])
+	t[4] = uint32(C)
+	C = C>>32 + uint64(t[5]) + uint64(a[5])*uint64(b[7])
+	t[5] = uint32(C)
+	C = C>>32 + uint64(t[6]) + uint64(a[6])*uint64(b[7])
+	t[6] = uint32(C)
+	C = C>>32 + uint64(t[7]) + uint64(a[7])*uint64(b[7])
+	t[7] = uint32(C)
+	C = C>>32 + uint64(t[8])
+	t[8] = uint32(C)
+	t[9] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*(q0&0xFFFFFFFF)) >> 32
+	C += uint64(t[1]) + uint64(m)*(q0>>32)
+	t[0] = uint32(C)
+	C >>= 32
+	C += uint64(t[2]) + uint64(m)*(q1&0xFFFFFFFF)
+	t[1] = uint32(C)
+	C >>= 32
+	C += uint64(t[3]) + uint64(m)*(q1>>32)
+	t[2] = uint32(C)
+	C >>= 32
+	C += uint64(t[4]) + uint64(m)*(q2&0xFFFFFFFF)
+	t[3] = uint32(C)
+	C >>= 32
+	C += uint64(t[5]) + uint64(m)*(q2>>32)
+	t[4] = uint32(C)
+	C >>= 32
+	C += uint64(t[6]) + uint64(m)*(q3&0xFFFFFFFF)
+	t[5] = uint32(C)
+	C >>= 32
+	C += uint64(t[7]) + uint64(m)*(q3>>32)
+	t[6] = uint32(C)
+	C >>= 32
+	C += uint64(t[8])
+	t[7] = uint32(C)
+	t[8] = t[9] + uint32(C>>32)
+
+	// t < 2q, recombine the limbs and reduce
+	z[0] = uint64(t[0]) | uint64(t[1])<<32
+	z[1] = uint64(t[2]) | uint64(t[3])<<32
+	z[2] = uint64(t[4]) | uint64(t[5])<<32
+	z[3] = uint64(t[6]) | uint64(t[7])<<32
+	if t[8] != 0 || !z.smallerThanModulus() {
+		var b uint64
+		z[0], b = bits.Sub64(z[0], q0, 0)
+		z[1], b = bits.Sub64(z[1], q1, b)
+		z[2], b = bits.Sub64(z[2], q2, b)
+		z[3], _ = bits.Sub64(z[3], q3, b)
+	}
+}
@@ -254,6 +254,47 @@ func (p *PointAffine) FromExtended(p1 *PointExtended) *PointAffine {
 	return p
 }
 
+// BatchFromExtended returns BatchExtendedToAffine(points); p is not used.
+func (p *PointAffine) BatchFromExtended(points []PointExtended) []PointAffine {
+	return BatchExtendedToAffine(points)
+}
+
+// BatchExtendedToAffine converts points in extended coordinates to affine coordinates
+// performing a single field inversion (Montgomery batch inversion trick).
+func BatchExtendedToAffine(points []PointExtended) []PointAffine {
+	result := make([]PointAffine, len(points))
+	if len(points) == 0 {
+		return result
+	}
+
+	// batch invert all points[].Z coordinates with Montgomery batch inversion trick
+	// (stores points[].Z^-1 in result[i].X to avoid allocating a slice of fr.Elements)
+	var accumulator fr.Element
+	accumulator.SetOne()
+	for i := 0; i < len(points); i++ {
+		result[i].X = accumulator
+		accumulator.Mul(&accumulator, &points[i].Z)
+	}
+
+	var accInverse fr.Element
+	accInverse.Inverse(&accumulator)
+
+	for i := len(points) - 1; i >= 0; i-- {
+		result[i].X.Mul(&result[i].X, &accInverse)
+		accInverse.Mul(&accInverse, &points[i].Z)
+	}
+
+	// batch convert to affine.
+	for i := 0; i < len(points); i++ {
+		var I fr.Element
+		I.Set(&result[i].X)
+		result[i].X.Mul(&points[i].X, &I)
+		result[i].Y.Mul(&points[i].Y, &I)
+	}
+
+	return result
+}
+
 // ScalarMultiplication scalar multiplication of a point
 // p1 in affine coordinates with a scalar in big.Int
 func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *PointAffine {
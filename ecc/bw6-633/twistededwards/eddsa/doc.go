@@ -19,4 +19,10 @@
 // # See also
 //
 // https://en.wikipedia.org/wiki/EdDSA
+//
+// # Constant time
+//
+// Signing doesn't invert, exponentiate or take square roots of secret field elements; when a
+// secret value requires one, the constant-time variants of the field elements (InverseCT,
+// ExpCT, ...) are to be used. The scalar multiplications are not constant time.
 package eddsa
@@ -2967,3 +2967,127 @@ func approximateRef(x *Element) uint64 {
 	hi.Add(&hi, &lo)
 	return hi.Uint64()
 }
+
+func TestElementConstantTime(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("InverseCT == Inverse", prop.ForAll(
+		func(a testPairElement) bool {
+			var b, c Element
+			b.InverseCT(&a.element)
+			c.Inverse(&a.element)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("ExpCT == big.Int.Exp", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c Element
+			var d, e big.Int
+			c.ExpCT(a.element, &b.bigint)
+			d.Exp(&a.bigint, &b.bigint, Modulus())
+			return c.BigInt(&e).Cmp(&d) == 0
+		},
+		genA, genA,
+	))
+
+	properties.Property("ExpCT(x, -k) == Exp(x, -k)", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			var nb big.Int
+			nb.Neg(&b.bigint)
+			c.ExpCT(a.element, &nb)
+			d.Exp(a.element, &nb)
+			return c.Equal(&d)
+		},
+		genA, genA,
+	))
+
+	properties.Property("LegendreCT == Legendre", prop.ForAll(
+		func(a testPairElement) bool {
+			return a.element.LegendreCT() == a.element.Legendre()
+		},
+		genA,
+	))
+
+	properties.Property("SqrtCT(x) is a square root of x, and nil iff Sqrt(x) is nil", prop.ForAll(
+		func(a testPairElement) bool {
+			var b, c, s Element
+			var square Element
+			square.Square(&a.element)
+			for _, x := range []*Element{&a.element, &square} {
+				rCT := b.SqrtCT(x)
+				r := c.Sqrt(x)
+				if (rCT == nil) != (r == nil) {
+					return false
+				}
+				if rCT != nil && !s.Square(&b).Equal(x) {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// edge cases
+	var zero, one, r Element
+	one.SetOne()
+	if !r.InverseCT(&zero).IsZero() {
+		t.Fatal("InverseCT(0) != 0")
+	}
+	if !r.ExpCT(one, big.NewInt(0)).IsOne() || !r.ExpCT(zero, big.NewInt(0)).IsOne() {
+		t.Fatal("ExpCT(x, 0) != 1")
+	}
+	if zero.LegendreCT() != 0 || one.LegendreCT() != 1 {
+		t.Fatal("wrong LegendreCT of 0 or 1")
+	}
+	if r.SqrtCT(&zero) == nil || !r.IsZero() {
+		t.Fatal("SqrtCT(0) != 0")
+	}
+}
+
+func BenchmarkElementInverseCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResElement.InverseCT(&x)
+	}
+}
+
+func BenchmarkElementExpCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b1, _ := rand.Int(rand.Reader, Modulus())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.ExpCT(x, b1)
+	}
+}
+
+func BenchmarkElementSqrtCT(b *testing.B) {
+	var a Element
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.SqrtCT(&a)
+	}
+}
@@ -0,0 +1,58 @@
+//go:build !amd64 || purego
+// +build !amd64 purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// add sets vector[i] = a[i] + b[i]; the vectors must have the same length.
+func (vector Vector) add(a, b Vector) {
+	for i := range vector {
+		vector[i].Add(&a[i], &b[i])
+	}
+}
+
+// sub sets vector[i] = a[i] - b[i]; the vectors must have the same length.
+func (vector Vector) sub(a, b Vector) {
+	for i := range vector {
+		vector[i].Sub(&a[i], &b[i])
+	}
+}
+
+// mul sets vector[i] = a[i] * b[i]; the vectors must have the same length.
+func (vector Vector) mul(a, b Vector) {
+	for i := range vector {
+		vector[i].Mul(&a[i], &b[i])
+	}
+}
+
+// scalarMul sets vector[i] = a[i] * b; the vectors must have the same length.
+func (vector Vector) scalarMul(a Vector, b *Element) {
+	for i := range vector {
+		vector[i].Mul(&a[i], b)
+	}
+}
+
+// innerProduct returns the inner product of vector and other; the vectors must have the same length.
+func (vector Vector) innerProduct(other Vector) (res Element) {
+	var tmp Element
+	for i := range vector {
+		tmp.Mul(&vector[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
@@ -241,6 +241,178 @@ func processChunkG1BatchAffine[BJE ibg1JacExtended, B ibG1Affine, BS bitSet, TP
 
 }
 
+type batchOpMultiG1Affine struct {
+	bucketID int
+	point    G1Affine
+}
+
+// processChunkG1BatchAffineMulti processes the same chunk of several multiExps, see MultiExpBatch,
+// with affine buckets as processChunkG1BatchAffine: digits[k] are the digits of the k-th multiExp,
+// which has its own set of 2^{c-1} buckets, and each point is loaded once for all of them.
+// The batches of affine additions, and thus the inversions, are shared by the multiExps.
+// The total of the k-th multiExp is sent to chRes[k].
+func processChunkG1BatchAffineMulti[TP pG1Affine, TPP ppG1Affine, TC cG1Affine](
+	chunk uint64,
+	chRes []chan g1JacExtended,
+	c uint64,
+	points []G1Affine,
+	digits [][]uint16) {
+
+	// the buckets of the k-th multiExp are buckets[k*nbBuckets:(k+1)*nbBuckets]; as in
+	// processChunkG1BatchAffine, the conflicting points are queued, and the doublings
+	// go to the g1JacExtended buckets.
+	nbBuckets := 1 << (c - 1)
+	buckets := make([]G1Affine, len(digits)*nbBuckets)
+	bucketsJE := make([]g1JacExtended, len(digits)*nbBuckets)
+	for i := 0; i < len(buckets); i++ {
+		buckets[i].setInfinity()
+		bucketsJE[i].setInfinity()
+	}
+
+	// setup for the batch affine;
+	var (
+		cptAdd int // count the number of bucket + point added to current batch
+		R      TPP // bucket references
+		P      TP  // points to be added to R (buckets)
+		qID    int // current position in queue
+	)
+	batchSize := len(P)
+	inBatch := make([]bool, len(buckets))            // signify presence of a bucket in current batch
+	batchIDs := make([]int, batchSize)               // buckets of the current batch
+	queue := make([]batchOpMultiG1Affine, batchSize) // queue of points that conflict the current batch
+
+	executeAndReset := func() {
+		batchAddG1Affine[TP, TPP, TC](&R, &P, cptAdd)
+		for _, bucketID := range batchIDs[:cptAdd] {
+			inBatch[bucketID] = false
+		}
+		cptAdd = 0
+	}
+
+	add := func(bucketID int, PP *G1Affine, isAdd bool) {
+		// @precondition: ensures bucket is not "used" in current batch
+		BK := &buckets[bucketID]
+		// handle special cases with inf or -P / P
+		if BK.IsInfinity() {
+			if isAdd {
+				BK.Set(PP)
+			} else {
+				BK.Neg(PP)
+			}
+			return
+		}
+		if BK.X.Equal(&PP.X) {
+			if BK.Y.Equal(&PP.Y) {
+				// P + P: doubling, which should be quite rare --
+				if isAdd {
+					bucketsJE[bucketID].addMixed(PP)
+				} else {
+					BK.setInfinity()
+				}
+				return
+			}
+			if isAdd {
+				BK.setInfinity()
+			} else {
+				bucketsJE[bucketID].subMixed(PP)
+			}
+			return
+		}
+
+		inBatch[bucketID] = true
+		batchIDs[cptAdd] = bucketID
+		R[cptAdd] = BK
+		if isAdd {
+			P[cptAdd].Set(PP)
+		} else {
+			P[cptAdd].Neg(PP)
+		}
+		cptAdd++
+	}
+
+	flushQueue := func() {
+		for i := 0; i < qID; i++ {
+			bucketsJE[queue[i].bucketID].addMixed(&queue[i].point)
+		}
+		qID = 0
+	}
+
+	processTopQueue := func() {
+		for i := qID - 1; i >= 0; i-- {
+			if inBatch[queue[i].bucketID] {
+				return
+			}
+			add(queue[i].bucketID, &queue[i].point, true)
+			// len(queue) < batchSize so no need to check for full batch.
+			qID--
+		}
+	}
+
+	for i := range points {
+		if points[i].IsInfinity() {
+			continue
+		}
+		for k := range digits {
+			digit := digits[k][i]
+			if digit == 0 {
+				continue
+			}
+
+			bucketID := k*nbBuckets + int(digit>>1)
+			isAdd := digit&1 == 0
+			if isAdd {
+				// add
+				bucketID -= 1
+			}
+
+			if inBatch[bucketID] {
+				// put it in queue
+				queue[qID].bucketID = bucketID
+				if isAdd {
+					queue[qID].point.Set(&points[i])
+				} else {
+					queue[qID].point.Neg(&points[i])
+				}
+				qID++
+
+				// queue is full, flush it.
+				if qID == len(queue)-1 {
+					flushQueue()
+				}
+				continue
+			}
+
+			// we add the point to the batch.
+			add(bucketID, &points[i], isAdd)
+			if cptAdd == batchSize {
+				executeAndReset()
+				processTopQueue()
+			}
+		}
+	}
+
+	// flush items in batch.
+	executeAndReset()
+
+	// empty the queue
+	flushQueue()
+
+	// reduce the buckets of each multiExp into its total
+	for k := range digits {
+		var runningSum, total g1JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for b := (k+1)*nbBuckets - 1; b >= k*nbBuckets; b-- {
+			runningSum.addMixed(&buckets[b])
+			if !bucketsJE[b].IsZero() {
+				runningSum.add(&bucketsJE[b])
+			}
+			total.add(&runningSum)
+		}
+		chRes[k] <- total
+	}
+}
+
 // we declare the buckets as fixed-size array types
 // this allow us to allocate the buckets on the stack
 type bucketG1AffineC11 [1024]G1Affine
@@ -509,6 +681,178 @@ func processChunkG2BatchAffine[BJE ibg2JacExtended, B ibG2Affine, BS bitSet, TP
 
 }
 
+type batchOpMultiG2Affine struct {
+	bucketID int
+	point    G2Affine
+}
+
+// processChunkG2BatchAffineMulti processes the same chunk of several multiExps, see MultiExpBatch,
+// with affine buckets as processChunkG2BatchAffine: digits[k] are the digits of the k-th multiExp,
+// which has its own set of 2^{c-1} buckets, and each point is loaded once for all of them.
+// The batches of affine additions, and thus the inversions, are shared by the multiExps.
+// The total of the k-th multiExp is sent to chRes[k].
+func processChunkG2BatchAffineMulti[TP pG2Affine, TPP ppG2Affine, TC cG2Affine](
+	chunk uint64,
+	chRes []chan g2JacExtended,
+	c uint64,
+	points []G2Affine,
+	digits [][]uint16) {
+
+	// the buckets of the k-th multiExp are buckets[k*nbBuckets:(k+1)*nbBuckets]; as in
+	// processChunkG2BatchAffine, the conflicting points are queued, and the doublings
+	// go to the g2JacExtended buckets.
+	nbBuckets := 1 << (c - 1)
+	buckets := make([]G2Affine, len(digits)*nbBuckets)
+	bucketsJE := make([]g2JacExtended, len(digits)*nbBuckets)
+	for i := 0; i < len(buckets); i++ {
+		buckets[i].setInfinity()
+		bucketsJE[i].setInfinity()
+	}
+
+	// setup for the batch affine;
+	var (
+		cptAdd int // count the number of bucket + point added to current batch
+		R      TPP // bucket references
+		P      TP  // points to be added to R (buckets)
+		qID    int // current position in queue
+	)
+	batchSize := len(P)
+	inBatch := make([]bool, len(buckets))            // signify presence of a bucket in current batch
+	batchIDs := make([]int, batchSize)               // buckets of the current batch
+	queue := make([]batchOpMultiG2Affine, batchSize) // queue of points that conflict the current batch
+
+	executeAndReset := func() {
+		batchAddG2Affine[TP, TPP, TC](&R, &P, cptAdd)
+		for _, bucketID := range batchIDs[:cptAdd] {
+			inBatch[bucketID] = false
+		}
+		cptAdd = 0
+	}
+
+	add := func(bucketID int, PP *G2Affine, isAdd bool) {
+		// @precondition: ensures bucket is not "used" in current batch
+		BK := &buckets[bucketID]
+		// handle special cases with inf or -P / P
+		if BK.IsInfinity() {
+			if isAdd {
+				BK.Set(PP)
+			} else {
+				BK.Neg(PP)
+			}
+			return
+		}
+		if BK.X.Equal(&PP.X) {
+			if BK.Y.Equal(&PP.Y) {
+				// P + P: doubling, which should be quite rare --
+				if isAdd {
+					bucketsJE[bucketID].addMixed(PP)
+				} else {
+					BK.setInfinity()
+				}
+				return
+			}
+			if isAdd {
+				BK.setInfinity()
+			} else {
+				bucketsJE[bucketID].subMixed(PP)
+			}
+			return
+		}
+
+		inBatch[bucketID] = true
+		batchIDs[cptAdd] = bucketID
+		R[cptAdd] = BK
+		if isAdd {
+			P[cptAdd].Set(PP)
+		} else {
+			P[cptAdd].Neg(PP)
+		}
+		cptAdd++
+	}
+
+	flushQueue := func() {
+		for i := 0; i < qID; i++ {
+			bucketsJE[queue[i].bucketID].addMixed(&queue[i].point)
+		}
+		qID = 0
+	}
+
+	processTopQueue := func() {
+		for i := qID - 1; i >= 0; i-- {
+			if inBatch[queue[i].bucketID] {
+				return
+			}
+			add(queue[i].bucketID, &queue[i].point, true)
+			// len(queue) < batchSize so no need to check for full batch.
+			qID--
+		}
+	}
+
+	for i := range points {
+		if points[i].IsInfinity() {
+			continue
+		}
+		for k := range digits {
+			digit := digits[k][i]
+			if digit == 0 {
+				continue
+			}
+
+			bucketID := k*nbBuckets + int(digit>>1)
+			isAdd := digit&1 == 0
+			if isAdd {
+				// add
+				bucketID -= 1
+			}
+
+			if inBatch[bucketID] {
+				// put it in queue
+				queue[qID].bucketID = bucketID
+				if isAdd {
+					queue[qID].point.Set(&points[i])
+				} else {
+					queue[qID].point.Neg(&points[i])
+				}
+				qID++
+
+				// queue is full, flush it.
+				if qID == len(queue)-1 {
+					flushQueue()
+				}
+				continue
+			}
+
+			// we add the point to the batch.
+			add(bucketID, &points[i], isAdd)
+			if cptAdd == batchSize {
+				executeAndReset()
+				processTopQueue()
+			}
+		}
+	}
+
+	// flush items in batch.
+	executeAndReset()
+
+	// empty the queue
+	flushQueue()
+
+	// reduce the buckets of each multiExp into its total
+	for k := range digits {
+		var runningSum, total g2JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for b := (k+1)*nbBuckets - 1; b >= k*nbBuckets; b-- {
+			runningSum.addMixed(&buckets[b])
+			if !bucketsJE[b].IsZero() {
+				runningSum.add(&bucketsJE[b])
+			}
+			total.add(&runningSum)
+		}
+		chRes[k] <- total
+	}
+}
+
 // we declare the buckets as fixed-size array types
 // this allow us to allocate the buckets on the stack
 type bucketG2AffineC11 [1024]G2Affine
@@ -71,6 +71,54 @@ func processChunkG1Jacobian[B ibg1JacExtended](chunk uint64,
 	chRes <- total
 }
 
+// processChunkG1JacobianMulti processes the same chunk of several multiExps, see MultiExpBatch:
+// digits[k] are the digits of the k-th multiExp, which has its own set of 2^{c-1} buckets, and each point
+// is loaded once for all of them. The total of the k-th multiExp is sent to chRes[k].
+func processChunkG1JacobianMulti(chunk uint64,
+	chRes []chan g1JacExtended,
+	c uint64,
+	points []G1Affine,
+	digits [][]uint16) {
+
+	nbBuckets := 1 << (c - 1)
+	buckets := make([]g1JacExtended, len(digits)*nbBuckets)
+	for i := 0; i < len(buckets); i++ {
+		buckets[i].setInfinity()
+	}
+
+	for i := range points {
+		for k := range digits {
+			digit := digits[k][i]
+			if digit == 0 {
+				continue
+			}
+
+			// if msbWindow bit is set, we need to subtract
+			if digit&1 == 0 {
+				// add
+				buckets[k*nbBuckets+int(digit>>1)-1].addMixed(&points[i])
+			} else {
+				// sub
+				buckets[k*nbBuckets+int(digit>>1)].subMixed(&points[i])
+			}
+		}
+	}
+
+	// reduce the buckets of each multiExp into its total
+	for k := range digits {
+		var runningSum, total g1JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for b := (k+1)*nbBuckets - 1; b >= k*nbBuckets; b-- {
+			if !buckets[b].IsZero() {
+				runningSum.add(&buckets[b])
+			}
+			total.add(&runningSum)
+		}
+		chRes[k] <- total
+	}
+}
+
 // we declare the buckets as fixed-size array types
 // this allow us to allocate the buckets on the stack
 type bucketg1JacExtendedC3 [4]g1JacExtended
@@ -144,6 +192,54 @@ func processChunkG2Jacobian[B ibg2JacExtended](chunk uint64,
 	chRes <- total
 }
 
+// processChunkG2JacobianMulti processes the same chunk of several multiExps, see MultiExpBatch:
+// digits[k] are the digits of the k-th multiExp, which has its own set of 2^{c-1} buckets, and each point
+// is loaded once for all of them. The total of the k-th multiExp is sent to chRes[k].
+func processChunkG2JacobianMulti(chunk uint64,
+	chRes []chan g2JacExtended,
+	c uint64,
+	points []G2Affine,
+	digits [][]uint16) {
+
+	nbBuckets := 1 << (c - 1)
+	buckets := make([]g2JacExtended, len(digits)*nbBuckets)
+	for i := 0; i < len(buckets); i++ {
+		buckets[i].setInfinity()
+	}
+
+	for i := range points {
+		for k := range digits {
+			digit := digits[k][i]
+			if digit == 0 {
+				continue
+			}
+
+			// if msbWindow bit is set, we need to subtract
+			if digit&1 == 0 {
+				// add
+				buckets[k*nbBuckets+int(digit>>1)-1].addMixed(&points[i])
+			} else {
+				// sub
+				buckets[k*nbBuckets+int(digit>>1)].subMixed(&points[i])
+			}
+		}
+	}
+
+	// reduce the buckets of each multiExp into its total
+	for k := range digits {
+		var runningSum, total g2JacExtended
+		runningSum.setInfinity()
+		total.setInfinity()
+		for b := (k+1)*nbBuckets - 1; b >= k*nbBuckets; b-- {
+			if !buckets[b].IsZero() {
+				runningSum.add(&buckets[b])
+			}
+			total.add(&runningSum)
+		}
+		chRes[k] <- total
+	}
+}
+
 // we declare the buckets as fixed-size array types
 // this allow us to allocate the buckets on the stack
 type bucketg2JacExtendedC3 [4]g2JacExtended
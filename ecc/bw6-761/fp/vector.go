@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math/big"
 	"runtime"
 	"strings"
 	"sync"
@@ -207,6 +208,105 @@ func (vector Vector) Swap(i, j int) {
 	vector[i], vector[j] = vector[j], vector[i]
 }
 
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	execute(len(a), func(start, end int) {
+		(*vector)[start:end].add(a[start:end], b[start:end])
+	}, vectorNbTasks(len(a)))
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	execute(len(a), func(start, end int) {
+		(*vector)[start:end].sub(a[start:end], b[start:end])
+	}, vectorNbTasks(len(a)))
+}
+
+// Mul multiplies two vectors element-wise (Hadamard product) and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	execute(len(a), func(start, end int) {
+		(*vector)[start:end].mul(a[start:end], b[start:end])
+	}, vectorNbTasks(len(a)))
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	execute(len(a), func(start, end int) {
+		(*vector)[start:end].scalarMul(a[start:end], b)
+	}, vectorNbTasks(len(a)))
+}
+
+// Exp raises each element of a to the power k and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Exp(a Vector, k *big.Int) {
+	if len(a) != len(*vector) {
+		panic("vector.Exp: vectors don't have the same length")
+	}
+	execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			(*vector)[i].Exp(a[i], k)
+		}
+	}, vectorNbTasks(len(a)))
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector Vector) Sum() (res Element) {
+	var lock sync.Mutex
+	execute(len(vector), func(start, end int) {
+		var partial Element
+		for i := start; i < end; i++ {
+			partial.Add(&partial, &vector[i])
+		}
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	}, vectorNbTasks(len(vector)))
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector Vector) InnerProduct(other Vector) (res Element) {
+	if len(vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var lock sync.Mutex
+	execute(len(vector), func(start, end int) {
+		partial := vector[start:end].innerProduct(other[start:end])
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	}, vectorNbTasks(len(vector)))
+	return
+}
+
+// vectorNbTasks returns the number of go routines used by the vector
+// arithmetic operations on n elements; small vectors are processed serially.
+func vectorNbTasks(n int) int {
+	const minElementsPerTask = 1 << 12
+	nbTasks := n / minElementsPerTask
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+	return nbTasks
+}
+
 // TODO @gbotrel make a public package out of that.
 // execute executes the work function in parallel.
 // this is copy paste from internal/parallel/parallel.go
@@ -0,0 +1,485 @@
+package ecc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+)
+
+// Curve gives access to the points and scalars of a curve whose ID is only known at runtime,
+// see ID.Curve. The handles it returns can only be combined with handles of the same curve
+// (and of the same group for points); mixing them panics, or returns an error when the method
+// returns one.
+type Curve interface {
+	// ID returns the ID of the curve.
+	ID() ID
+
+	// NewScalar returns a new scalar (element of the scalar field) set to 0.
+	NewScalar() Scalar
+
+	// NewG1 returns a new point of G1 set to the point at infinity.
+	NewG1() Point
+	// G1Generator returns a new point of G1 set to the generator of the group.
+	G1Generator() Point
+
+	// NewG2 returns a new point of G2 set to the point at infinity, or nil if the curve
+	// isn't pairing-friendly.
+	NewG2() Point
+	// G2Generator returns a new point of G2 set to the generator of the group, or nil if the
+	// curve isn't pairing-friendly.
+	G2Generator() Point
+
+	// HashToG1 hashes a message to a point of G1, see the HashToG1 function of the curve package.
+	HashToG1(msg, dst []byte) (Point, error)
+
+	// PairingCheck returns true if ∏ᵢ e(P[i], Q[i]) == 1, with P in G1 and Q in G2.
+	PairingCheck(P, Q []Point) (bool, error)
+
+	// NewEncoder returns an encoder of the points and scalars of the curve.
+	NewEncoder(w io.Writer) *Encoder
+	// NewDecoder returns a decoder of the points and scalars of the curve.
+	NewDecoder(r io.Reader) *Decoder
+}
+
+// Point is an opaque handle on a point in affine coordinates, returned by a Curve.
+//
+// As in the curve packages, the result of an operation is stored in the receiver, which is
+// also returned.
+type Point interface {
+	// Curve returns the ID of the curve of the point.
+	Curve() ID
+
+	Set(a Point) Point
+	Add(a, b Point) Point
+	ScalarMul(a Point, s Scalar) Point
+	// MultiExp sets the point to ∑ᵢ [scalars[i]]points[i], see the MultiExp method of the
+	// point types of the curve packages.
+	MultiExp(points []Point, scalars []Scalar, config MultiExpConfig) (Point, error)
+
+	Equal(a Point) bool
+	IsInfinity() bool
+
+	// Marshal returns the uncompressed encoding of the point.
+	Marshal() []byte
+	// Unmarshal sets the point from its encoding, checking it is in the correct subgroup.
+	Unmarshal(buf []byte) error
+	String() string
+
+	// encodedSize returns the size of the uncompressed encoding of the point
+	encodedSize() int
+}
+
+// Scalar is an opaque handle on an element of the scalar field, returned by a Curve.
+type Scalar interface {
+	// Curve returns the ID of the curve of the scalar.
+	Curve() ID
+
+	Set(a Scalar) Scalar
+	SetUint64(v uint64) Scalar
+	SetBigInt(v *big.Int) Scalar
+	SetRandom() (Scalar, error)
+	BigInt(res *big.Int) *big.Int
+
+	Equal(a Scalar) bool
+
+	// Marshal returns the big-endian encoding of the scalar.
+	Marshal() []byte
+	// Unmarshal sets the scalar from its big-endian encoding, which must be canonical.
+	Unmarshal(buf []byte) error
+	String() string
+
+	// encodedSize returns the size of the encoding of the scalar
+	encodedSize() int
+}
+
+// registeredCurve builds the facade of a curve once, on first use: the curve packages register it
+// from an init function, which may run before the generators of the package are set
+type registeredCurve struct {
+	once  sync.Once
+	new   func() Curve
+	curve Curve
+}
+
+var curves = make(map[ID]*registeredCurve)
+
+// RegisterCurve makes a curve available through ID.Curve; f is called once, on first use.
+// It is called from the init function of the curve packages.
+func RegisterCurve(id ID, f func() Curve) {
+	curves[id] = &registeredCurve{new: f}
+}
+
+// Curve returns the runtime facade of the curve. Successive calls return the same facade, so
+// that the handles it returns can be combined.
+//
+// The curve package must be linked into the binary, for example with
+//
+//	import _ "github.com/consensys/gnark-crypto/ecc/bn254"
+//
+// otherwise Curve returns an error. The stark-curve has no MultiExp and no facade.
+func (id ID) Curve() (Curve, error) {
+	r, ok := curves[id]
+	if !ok {
+		return nil, fmt.Errorf("ecc: curve %s is unavailable, its package is not imported", id)
+	}
+	r.once.Do(func() { r.curve = r.new() })
+	return r.curve, nil
+}
+
+var (
+	errMixedCurves = errors.New("ecc: points or scalars of a different curve or group")
+	errNoPairing   = errors.New("ecc: the curve is not pairing-friendly")
+)
+
+// NewCurve returns the facade of a curve without pairing.
+// It is used by the curve packages to call RegisterCurve.
+func NewCurve[G1, Fr any, PG1 Group[G1, Fr], PFr Field[Fr]](id ID, g1 G1, hashToG1 func(msg, dst []byte) (G1, error)) Curve {
+	return &curve[G1, G1, Fr, PG1, PG1, PFr]{
+		id:       id,
+		g1:       newGroup[G1, Fr, PG1, PFr](id, 1, g1),
+		hashToG1: hashToG1,
+	}
+}
+
+// NewPairingCurve returns the facade of a pairing-friendly curve.
+// It is used by the curve packages to call RegisterCurve.
+func NewPairingCurve[G1, G2, Fr any, PG1 Group[G1, Fr], PG2 Group[G2, Fr], PFr Field[Fr]](id ID, g1 G1, g2 G2, hashToG1 func(msg, dst []byte) (G1, error), pairingCheck func(P []G1, Q []G2) (bool, error)) Curve {
+	return &curve[G1, G2, Fr, PG1, PG2, PFr]{
+		id:           id,
+		g1:           newGroup[G1, Fr, PG1, PFr](id, 1, g1),
+		g2:           newGroup[G2, Fr, PG2, PFr](id, 2, g2),
+		hashToG1:     hashToG1,
+		pairingCheck: pairingCheck,
+	}
+}
+
+type curve[G1, G2, Fr any, PG1 Group[G1, Fr], PG2 Group[G2, Fr], PFr Field[Fr]] struct {
+	id           ID
+	g1           *group[G1, Fr, PG1, PFr]
+	g2           *group[G2, Fr, PG2, PFr] // nil if the curve has no pairing
+	hashToG1     func(msg, dst []byte) (G1, error)
+	pairingCheck func(P []G1, Q []G2) (bool, error)
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) ID() ID {
+	return c.id
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) NewScalar() Scalar {
+	return &scalar[Fr, PFr]{id: c.id}
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) NewG1() Point {
+	return &point[G1, Fr, PG1, PFr]{g: c.g1}
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) G1Generator() Point {
+	return &point[G1, Fr, PG1, PFr]{g: c.g1, v: c.g1.generator}
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) NewG2() Point {
+	if c.g2 == nil {
+		return nil
+	}
+	return &point[G2, Fr, PG2, PFr]{g: c.g2}
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) G2Generator() Point {
+	if c.g2 == nil {
+		return nil
+	}
+	return &point[G2, Fr, PG2, PFr]{g: c.g2, v: c.g2.generator}
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) HashToG1(msg, dst []byte) (Point, error) {
+	p, err := c.hashToG1(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	return &point[G1, Fr, PG1, PFr]{g: c.g1, v: p}, nil
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) PairingCheck(P, Q []Point) (bool, error) {
+	if c.g2 == nil {
+		return false, errNoPairing
+	}
+	p, err := c.g1.values(P)
+	if err != nil {
+		return false, err
+	}
+	q, err := c.g2.values(Q)
+	if err != nil {
+		return false, err
+	}
+	return c.pairingCheck(p, q)
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w, id: c.id}
+}
+
+func (c *curve[G1, G2, Fr, PG1, PG2, PFr]) NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r, id: c.id}
+}
+
+// group holds what is common to the points of a group
+type group[P, S any, PP Group[P, S], PS Field[S]] struct {
+	id        ID
+	index     int // 1 for G1, 2 for G2
+	generator P
+	size      int
+}
+
+func newGroup[P, S any, PP Group[P, S], PS Field[S]](id ID, index int, generator P) *group[P, S, PP, PS] {
+	return &group[P, S, PP, PS]{
+		id:        id,
+		index:     index,
+		generator: generator,
+		size:      len(PP(&generator).Marshal()),
+	}
+}
+
+// same returns true if g and h are the same group, even if they come from different facades
+func (g *group[P, S, PP, PS]) same(h *group[P, S, PP, PS]) bool {
+	return g.id == h.id && g.index == h.index
+}
+
+// values returns the points behind the handles, or an error if one of them is not in g
+func (g *group[P, S, PP, PS]) values(points []Point) ([]P, error) {
+	res := make([]P, len(points))
+	for i := range points {
+		p, ok := points[i].(*point[P, S, PP, PS])
+		if !ok || !p.g.same(g) {
+			return nil, errMixedCurves
+		}
+		res[i] = p.v
+	}
+	return res, nil
+}
+
+type point[P, S any, PP Group[P, S], PS Field[S]] struct {
+	g *group[P, S, PP, PS]
+	v P
+}
+
+// cast returns the point behind a, and panics if it is not in the group of p
+func (p *point[P, S, PP, PS]) cast(a Point) *P {
+	q, ok := a.(*point[P, S, PP, PS])
+	if !ok || !q.g.same(p.g) {
+		panic(errMixedCurves)
+	}
+	return &q.v
+}
+
+func (p *point[P, S, PP, PS]) Curve() ID {
+	return p.g.id
+}
+
+func (p *point[P, S, PP, PS]) Set(a Point) Point {
+	p.v = *p.cast(a)
+	return p
+}
+
+func (p *point[P, S, PP, PS]) Add(a, b Point) Point {
+	PP(&p.v).Add(p.cast(a), p.cast(b))
+	return p
+}
+
+func (p *point[P, S, PP, PS]) ScalarMul(a Point, s Scalar) Point {
+	sc, ok := s.(*scalar[S, PS])
+	if !ok || sc.id != p.g.id {
+		panic(errMixedCurves)
+	}
+	var e big.Int
+	PP(&p.v).ScalarMultiplication(p.cast(a), PS(&sc.v).BigInt(&e))
+	return p
+}
+
+func (p *point[P, S, PP, PS]) MultiExp(points []Point, scalars []Scalar, config MultiExpConfig) (Point, error) {
+	values, err := p.g.values(points)
+	if err != nil {
+		return nil, err
+	}
+	s := make([]S, len(scalars))
+	for i := range scalars {
+		sc, ok := scalars[i].(*scalar[S, PS])
+		if !ok || sc.id != p.g.id {
+			return nil, errMixedCurves
+		}
+		s[i] = sc.v
+	}
+	if _, err := PP(&p.v).MultiExp(values, s, config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *point[P, S, PP, PS]) Equal(a Point) bool {
+	q, ok := a.(*point[P, S, PP, PS])
+	return ok && q.g.same(p.g) && PP(&p.v).Equal(&q.v)
+}
+
+func (p *point[P, S, PP, PS]) IsInfinity() bool {
+	return PP(&p.v).IsInfinity()
+}
+
+func (p *point[P, S, PP, PS]) Marshal() []byte {
+	return PP(&p.v).Marshal()
+}
+
+func (p *point[P, S, PP, PS]) Unmarshal(buf []byte) error {
+	return PP(&p.v).Unmarshal(buf)
+}
+
+func (p *point[P, S, PP, PS]) String() string {
+	return PP(&p.v).String()
+}
+
+func (p *point[P, S, PP, PS]) encodedSize() int {
+	return p.g.size
+}
+
+type scalar[S any, PS Field[S]] struct {
+	id ID
+	v  S
+}
+
+// cast returns the element behind a, and panics if it is not of the curve of s
+func (s *scalar[S, PS]) cast(a Scalar) *S {
+	b, ok := a.(*scalar[S, PS])
+	if !ok || b.id != s.id {
+		panic(errMixedCurves)
+	}
+	return &b.v
+}
+
+func (s *scalar[S, PS]) Curve() ID {
+	return s.id
+}
+
+func (s *scalar[S, PS]) Set(a Scalar) Scalar {
+	s.v = *s.cast(a)
+	return s
+}
+
+func (s *scalar[S, PS]) SetUint64(v uint64) Scalar {
+	PS(&s.v).SetUint64(v)
+	return s
+}
+
+func (s *scalar[S, PS]) SetBigInt(v *big.Int) Scalar {
+	PS(&s.v).SetBigInt(v)
+	return s
+}
+
+func (s *scalar[S, PS]) SetRandom() (Scalar, error) {
+	if _, err := PS(&s.v).SetRandom(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *scalar[S, PS]) BigInt(res *big.Int) *big.Int {
+	return PS(&s.v).BigInt(res)
+}
+
+func (s *scalar[S, PS]) Equal(a Scalar) bool {
+	b, ok := a.(*scalar[S, PS])
+	return ok && b.id == s.id && PS(&s.v).Equal(&b.v)
+}
+
+func (s *scalar[S, PS]) Marshal() []byte {
+	return PS(&s.v).Marshal()
+}
+
+func (s *scalar[S, PS]) Unmarshal(buf []byte) error {
+	if len(buf) != s.encodedSize() {
+		return errors.New("ecc: invalid scalar encoding size")
+	}
+	return PS(&s.v).SetBytesCanonical(buf)
+}
+
+func (s *scalar[S, PS]) String() string {
+	return PS(&s.v).String()
+}
+
+func (s *scalar[S, PS]) encodedSize() int {
+	var zero S
+	return len(PS(&zero).Marshal())
+}
+
+// Encoder writes the encoding of points and scalars of a curve to an io.Writer.
+// Points are not compressed.
+type Encoder struct {
+	w  io.Writer
+	id ID
+	n  int64 // written bytes
+}
+
+// Encode writes the encoding of v, which must be a Point or a Scalar of the curve of the encoder.
+func (enc *Encoder) Encode(v interface{}) error {
+	var buf []byte
+	switch t := v.(type) {
+	case Point:
+		if t.Curve() != enc.id {
+			return errMixedCurves
+		}
+		buf = t.Marshal()
+	case Scalar:
+		if t.Curve() != enc.id {
+			return errMixedCurves
+		}
+		buf = t.Marshal()
+	default:
+		return fmt.Errorf("ecc: can't encode %T", v)
+	}
+	n, err := enc.w.Write(buf)
+	enc.n += int64(n)
+	return err
+}
+
+// BytesWritten returns the total number of bytes written
+func (enc *Encoder) BytesWritten() int64 {
+	return enc.n
+}
+
+// Decoder reads the encoding of points and scalars of a curve from an io.Reader.
+type Decoder struct {
+	r  io.Reader
+	id ID
+	n  int64 // read bytes
+}
+
+// Decode reads an encoding written by Encoder.Encode into v, which must be a Point or a Scalar
+// of the curve of the decoder. Points are checked to be in the correct subgroup.
+func (dec *Decoder) Decode(v interface{}) error {
+	var size int
+	var unmarshal func([]byte) error
+	switch t := v.(type) {
+	case Point:
+		if t.Curve() != dec.id {
+			return errMixedCurves
+		}
+		size, unmarshal = t.encodedSize(), t.Unmarshal
+	case Scalar:
+		if t.Curve() != dec.id {
+			return errMixedCurves
+		}
+		size, unmarshal = t.encodedSize(), t.Unmarshal
+	default:
+		return fmt.Errorf("ecc: can't decode %T", v)
+	}
+	buf := make([]byte, size)
+	n, err := io.ReadFull(dec.r, buf)
+	dec.n += int64(n)
+	if err != nil {
+		return err
+	}
+	return unmarshal(buf)
+}
+
+// BytesRead returns the total number of bytes read
+func (dec *Decoder) BytesRead() int64 {
+	return dec.n
+}
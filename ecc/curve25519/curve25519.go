@@ -0,0 +1,91 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package curve25519 efficient elliptic curve implementation for Curve25519 (https://cr.yp.to/ecdh/curve25519-20060209.pdf).
+// The curve is used in its twisted Edwards form (edwards25519) by the Ed25519 signature scheme of RFC 8032
+// (see the eddsa sub-package), and in its Montgomery form by the X25519 key exchange of RFC 7748.
+//
+// edwards25519: a twisted Edwards curve birationally equivalent to curve25519, with
+//
+//	𝔽r: r=7237005577332262213973186563042994240857116359379907606001950938285454250989 (2^252 + 27742317777372353535851937790883648493)
+//	𝔽p: p=57896044618658097711785492504343953926634992332820282019728792003956564819949 (2^255 - 19)
+//	(E/𝔽p): -X²+Y²=1+d⋅X²Y² with d=-121665/121666
+//	cofactor: 8
+//
+// curve25519: the Montgomery form, with
+//
+//	(M/𝔽p): V²=U³+486662U²+U
+//	birational map: (U, V) = ((1+Y)/(1-Y), √-486664⋅U/X)
+//
+// Security: estimated 126-bit level using Pollard's \rho attack
+// (r is 253 bits)
+//
+// # Warning
+//
+// This code has been partially audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// Only the scalar multiplications used with secret scalars (ScalarMultiplicationCT, X25519) are constant time.
+package curve25519
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+)
+
+// CurveParams curve parameters: ax^2 + y^2 = 1 + d*x^2*y^2
+type CurveParams struct {
+	A, D     fp.Element
+	Cofactor fp.Element
+	Order    big.Int
+	Base     PointAffine
+}
+
+// GetEdwardsCurve returns the twisted Edwards curve edwards25519
+func GetEdwardsCurve() CurveParams {
+	initOnce.Do(initCurveParams)
+	// copy to keep Order private
+	var res CurveParams
+
+	res.A.Set(&curveParams.A)
+	res.D.Set(&curveParams.D)
+	res.Cofactor.Set(&curveParams.Cofactor)
+	res.Order.Set(&curveParams.Order)
+	res.Base.Set(&curveParams.Base)
+
+	return res
+}
+
+// MontgomeryA is the coefficient A of the Montgomery form V²=U³+A⋅U²+U
+const MontgomeryA = 486662
+
+var (
+	initOnce    sync.Once
+	curveParams CurveParams
+)
+
+func initCurveParams() {
+	curveParams.A.SetString("-1")
+	curveParams.D.SetString("37095705934669439343138083508754565189542113879843219016388785533085940283555")
+	curveParams.Cofactor.SetString("8")
+	curveParams.Order.SetString("7237005577332262213973186563042994240857116359379907606001950938285454250989", 10)
+
+	curveParams.Base.X.SetString("15112221349535400772501151409588531511454012693041857206046113283949847762202")
+	curveParams.Base.Y.SetString("46316835694926478169428394003475163141307993866256225615783033603165251855960")
+}
+
+// mulByA multiplies fp.Element by curveParams.A
+func mulByA(x *fp.Element) {
+	x.Neg(x)
+}
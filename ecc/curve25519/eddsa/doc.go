@@ -0,0 +1,33 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eddsa provides the Ed25519 and Ed25519ph signature schemes of RFC 8032 on edwards25519.
+//
+// Unlike the eddsa packages of the SNARK-embedded twisted Edwards curves, the keys, the
+// signatures and the hash function (SHA-512) follow https://tools.ietf.org/html/rfc8032#section-5.1,
+// so that the signatures interoperate with other Ed25519 implementations. The hFunc argument of
+// Sign and Verify selects the variant: nil for Ed25519, the pre-hash function PH for Ed25519ph
+// (SHA-512 in RFC 8032).
+//
+// Verification is cofactored and rejects non-canonical encodings of R, A and S.
+//
+// # See also
+//
+// https://tools.ietf.org/html/rfc8032
+//
+// # Constant time
+//
+// The scalar multiplications by the secret scalar and by the nonce are constant time. The
+// reductions of the secret values modulo the group order are not.
+package eddsa
@@ -0,0 +1,247 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto/sha512"
+	"crypto/subtle"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+const (
+	sizeFr = fr.Bytes
+
+	// SeedSize is the size of the private key seed of RFC 8032
+	SeedSize = 32
+	// PublicKeySize is the size of an encoded public key
+	PublicKeySize = curve25519.SizePointCompressed
+	// PrivateKeySize is the size of an encoded private key, seed||publicKey
+	PrivateKeySize = SeedSize + PublicKeySize
+	// SignatureSize is the size of an encoded signature, R||S
+	SignatureSize = curve25519.SizePointCompressed + sizeFr
+)
+
+// dom2Ph is dom2(1, "") of https://tools.ietf.org/html/rfc8032#section-5.1, prepended to the
+// hashed data by Ed25519ph
+var dom2Ph = []byte("SigEd25519 no Ed25519 collisions\x01\x00")
+
+// PublicKey eddsa signature object
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type PublicKey struct {
+	A curve25519.PointAffine
+}
+
+// PrivateKey private key of an eddsa instance
+type PrivateKey struct {
+	PublicKey PublicKey      // copy of the associated public key
+	seed      [SeedSize]byte // private key of RFC 8032
+	scalar    [sizeFr]byte   // secret scalar, pruned, in little endian
+	prefix    [32]byte       // second half of SHA-512(seed), source of the nonces
+}
+
+// Signature represents an eddsa signature
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type Signature struct {
+	R curve25519.PointAffine
+	S [sizeFr]byte // S, in little endian
+}
+
+// GenerateKey generates a public and private key pair, the seed being read from r.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	var seed [SeedSize]byte
+	if _, err := io.ReadFull(r, seed[:]); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(seed[:])
+}
+
+// NewKeyFromSeed derives the key pair from the 32-byte private key of RFC 8032,
+// see https://tools.ietf.org/html/rfc8032#section-5.1.5.
+func NewKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return nil, errWrongSize
+	}
+	var priv PrivateKey
+	copy(priv.seed[:], seed)
+
+	// hash(seed) = scalar || prefix, on 32 bytes each
+	h := sha512.Sum512(seed)
+	copy(priv.prefix[:], h[32:])
+
+	// prune the key
+	h[0] &= 0xF8
+	h[31] &= 0x7F
+	h[31] |= 0x40
+	copy(priv.scalar[:], h[:sizeFr])
+
+	c := curve25519.GetEdwardsCurve()
+	priv.PublicKey.A.ScalarMultiplicationCT(&c.Base, &priv.scalar)
+
+	return &priv, nil
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign signs message, following https://tools.ietf.org/html/rfc8032#section-5.1.6.
+//
+// If hFunc is nil, it is an Ed25519 signature of message. Otherwise it is an Ed25519ph
+// signature, message being pre-hashed with hFunc (RFC 8032 specifies SHA-512).
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+
+	curveParams := curve25519.GetEdwardsCurve()
+
+	dom, msg, err := prehash(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	var res Signature
+
+	// r = SHA-512(dom2(F, C) || prefix || PH(M)) mod l
+	h := sha512.New()
+	h.Write(dom)
+	h.Write(privKey.prefix[:])
+	h.Write(msg)
+	r := reduce(h.Sum(nil))
+
+	// R = [r]Base
+	var rBin [sizeFr]byte
+	fr.LittleEndian.PutElement(&rBin, r)
+	res.R.ScalarMultiplicationCT(&curveParams.Base, &rBin)
+
+	// k = SHA-512(dom2(F, C) || R || A || PH(M)) mod l
+	k := challenge(dom, &res.R, &privKey.PublicKey.A, msg)
+
+	// S = r + k*s mod l
+	var s, S fr.Element
+	s.SetBytes(reverse(privKey.scalar[:]))
+	S.Mul(&k, &s).Add(&S, &r)
+	fr.LittleEndian.PutElement(&res.S, S)
+
+	return res.Bytes(), nil
+}
+
+// Verify verifies an Ed25519 (hFunc nil) or Ed25519ph (hFunc the pre-hash function)
+// signature, following https://tools.ietf.org/html/rfc8032#section-5.1.7.
+//
+// It checks the cofactored equation [8][S]B = [8]R + [8][k]A.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	curveParams := curve25519.GetEdwardsCurve()
+
+	// verify that pubKey is on the curve
+	if !pub.A.IsOnCurve() {
+		return false, errNotOnCurve
+	}
+
+	// Deserialize the signature; this rejects non-canonical R and S >= l
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+
+	dom, msg, err := prehash(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	k := challenge(dom, &sig.R, &pub.A, msg)
+
+	// [S]B - [k]A - R
+	var S fr.Element
+	S, err = fr.LittleEndian.Element(&sig.S)
+	if err != nil {
+		return false, errSBiggerThanRMod
+	}
+	var bS, bk big.Int
+	S.BigInt(&bS)
+	k.BigInt(&bk)
+
+	var lhs, rhs, R, A curve25519.PointExtended
+	lhs.FromAffine(&curveParams.Base)
+	lhs.ScalarMultiplication(&lhs, &bS)
+	rhs.FromAffine(&pub.A)
+	rhs.ScalarMultiplication(&rhs, &bk)
+	R.FromAffine(&sig.R)
+	rhs.Add(&rhs, &R)
+	A.Neg(&rhs)
+	lhs.Add(&lhs, &A)
+
+	// multiply by the cofactor 8
+	lhs.Double(&lhs).Double(&lhs).Double(&lhs)
+
+	return lhs.IsZero(), nil
+}
+
+// prehash returns the domain separator and the message to sign, PH(M) for Ed25519ph
+func prehash(message []byte, hFunc hash.Hash) (dom, msg []byte, err error) {
+	if hFunc == nil {
+		return nil, message, nil
+	}
+	hFunc.Reset()
+	if _, err = hFunc.Write(message); err != nil {
+		return nil, nil, err
+	}
+	return dom2Ph, hFunc.Sum(nil), nil
+}
+
+// challenge returns k = SHA-512(dom || R || A || msg) mod l
+func challenge(dom []byte, R, A *curve25519.PointAffine, msg []byte) fr.Element {
+	RBin := R.Bytes()
+	ABin := A.Bytes()
+	h := sha512.New()
+	h.Write(dom)
+	h.Write(RBin[:])
+	h.Write(ABin[:])
+	h.Write(msg)
+	return reduce(h.Sum(nil))
+}
+
+// reduce interprets b as a little endian integer and reduces it modulo l
+func reduce(b []byte) fr.Element {
+	var res fr.Element
+	res.SetBytes(reverse(b))
+	return res
+}
+
+// reverse returns a reversed copy of b
+func reverse(b []byte) []byte {
+	res := make([]byte, len(b))
+	for i := range b {
+		res[len(b)-1-i] = b[i]
+	}
+	return res
+}
@@ -0,0 +1,272 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	crand "crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+)
+
+func Example() {
+	// create an Ed25519 key pair
+	privateKey, _ := GenerateKey(crand.Reader)
+	publicKey := privateKey.PublicKey
+
+	msg := []byte("message")
+
+	// sign the message (nil hash: pure Ed25519)
+	signature, _ := privateKey.Sign(msg, nil)
+
+	// verifies signature
+	isValid, _ := publicKey.Verify(signature, msg, nil)
+	if !isValid {
+		fmt.Println("1. invalid signature")
+	} else {
+		fmt.Println("1. valid signature")
+	}
+
+	// Output: 1. valid signature
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// https://tools.ietf.org/html/rfc8032#section-7.1 and #section-7.3
+func TestVectors(t *testing.T) {
+	t.Parallel()
+	vectors := []struct {
+		name                string
+		seed, pub, msg, sig string
+		ph                  bool
+	}{
+		{
+			name: "Ed25519 TEST 1",
+			seed: "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+			pub:  "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			msg:  "",
+			sig:  "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+		},
+		{
+			name: "Ed25519 TEST 2",
+			seed: "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+			pub:  "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+			msg:  "72",
+			sig:  "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+		},
+		{
+			name: "Ed25519 TEST 3",
+			seed: "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+			pub:  "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+			msg:  "af82",
+			sig:  "6291d657deec24024827e69c3abe01a30ce548a284743a445e3680d7db5ac3ac18ff9b538d16f290ae67f760984dc6594a7c15e9716ed28dc027beceea1ec40a",
+		},
+		{
+			name: "Ed25519ph TEST abc",
+			seed: "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42",
+			pub:  "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf",
+			msg:  "616263",
+			sig:  "98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406",
+			ph:   true,
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.name, func(t *testing.T) {
+			privKey, err := NewKeyFromSeed(mustDecodeHex(t, v.seed))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(privKey.PublicKey.Bytes()) != v.pub {
+				t.Fatal("wrong public key")
+			}
+
+			msg := mustDecodeHex(t, v.msg)
+			hFunc := sha512.New()
+			if !v.ph {
+				hFunc = nil
+			}
+			sig, err := privKey.Sign(msg, hFunc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(sig) != v.sig {
+				t.Fatalf("wrong signature %x", sig)
+			}
+
+			var pub PublicKey
+			if _, err := pub.SetBytes(mustDecodeHex(t, v.pub)); err != nil {
+				t.Fatal(err)
+			}
+			ok, err := pub.Verify(sig, msg, hFunc)
+			if err != nil || !ok {
+				t.Fatal("signature should verify")
+			}
+
+			// wrong variant
+			other := sha512.New()
+			if v.ph {
+				other = nil
+			}
+			if ok, _ := pub.Verify(sig, msg, other); ok {
+				t.Fatal("signature should not verify with the other variant")
+			}
+		})
+	}
+}
+
+func TestStdlibInterop(t *testing.T) {
+	t.Parallel()
+	for i := 0; i < 10; i++ {
+		privKey, err := GenerateKey(crand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stdKey := ed25519.PrivateKey(privKey.Bytes())
+
+		msg := make([]byte, 10*i)
+		if _, err := crand.Read(msg); err != nil {
+			t.Fatal(err)
+		}
+
+		// our signature verified by crypto/ed25519
+		sig, err := privKey.Sign(msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ed25519.Verify(stdKey.Public().(ed25519.PublicKey), msg, sig) {
+			t.Fatal("signature should be accepted by crypto/ed25519")
+		}
+
+		// Ed25519 is deterministic
+		if !bytes.Equal(sig, ed25519.Sign(stdKey, msg)) {
+			t.Fatal("signature should match crypto/ed25519")
+		}
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	t.Parallel()
+	privKey, err := GenerateKey(crand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var privKey2 PrivateKey
+	if _, err := privKey2.SetBytes(privKey.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(privKey.Bytes(), privKey2.Bytes()) {
+		t.Fatal("private key round trip failed")
+	}
+	if !privKey.Public().Equal(privKey2.Public()) {
+		t.Fatal("public key mismatch")
+	}
+
+	// inconsistent public key
+	b := privKey.Bytes()
+	other, err := GenerateKey(crand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(b[SeedSize:], other.PublicKey.Bytes())
+	if _, err := privKey2.SetBytes(b); err != errInconsistentKey {
+		t.Fatal("inconsistent private key should be rejected")
+	}
+}
+
+func TestNonMalleability(t *testing.T) {
+	t.Parallel()
+	privKey, err := GenerateKey(crand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("message")
+	sig, err := privKey.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("wrong size", func(t *testing.T) {
+		var s Signature
+		if _, err := s.SetBytes(append(sig, 0)); err != errWrongSize {
+			t.Fatal("should raise wrong size error")
+		}
+	})
+
+	t.Run("S+l", func(t *testing.T) {
+		// S+l is congruent to S and would verify if not rejected
+		var S big.Int
+		var sBE [32]byte
+		for i := 0; i < sizeFr; i++ {
+			sBE[sizeFr-1-i] = sig[curve25519.SizePointCompressed+i]
+		}
+		S.SetBytes(sBE[:])
+		cp := curve25519.GetEdwardsCurve()
+		S.Add(&S, &cp.Order)
+		S.FillBytes(sBE[:])
+
+		forged := append([]byte(nil), sig...)
+		for i := 0; i < sizeFr; i++ {
+			forged[curve25519.SizePointCompressed+i] = sBE[sizeFr-1-i]
+		}
+		if ok, err := privKey.PublicKey.Verify(forged, msg, nil); ok || err != errSBiggerThanRMod {
+			t.Fatal("should raise error s >= r_mod")
+		}
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		forged := append([]byte(nil), sig...)
+		forged[SignatureSize-1] ^= 0x01
+		if ok, _ := privKey.PublicKey.Verify(forged, msg, nil); ok {
+			t.Fatal("tampered signature should not verify")
+		}
+		if ok, _ := privKey.PublicKey.Verify(sig, []byte("massage"), nil); ok {
+			t.Fatal("signature should not verify for another message")
+		}
+	})
+}
+
+func BenchmarkSign(b *testing.B) {
+	privKey, _ := GenerateKey(crand.Reader)
+	msg := []byte("message")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.Sign(msg, nil)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, _ := GenerateKey(crand.Reader)
+	msg := []byte("message")
+	sig, _ := privKey.Sign(msg, nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = privKey.PublicKey.Verify(sig, msg, nil)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+)
+
+var (
+	errNotOnCurve      = errors.New("point not on curve")
+	errWrongSize       = errors.New("wrong size buffer")
+	errSBiggerThanRMod = errors.New("s >= r_mod")
+	errInconsistentKey = errors.New("public key doesn't match the seed")
+)
+
+// Bytes returns the binary representation of the public key,
+// the compressed point of https://tools.ietf.org/html/rfc8032#section-5.1.2
+func (pk *PublicKey) Bytes() []byte {
+	var res [PublicKeySize]byte
+	pkBin := pk.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:], pkBin[:])
+	return res[:]
+}
+
+// SetBytes sets pk from its binary representation in buf, decoded as in
+// https://tools.ietf.org/html/rfc8032#section-5.1.3.
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < PublicKeySize {
+		return 0, io.ErrShortBuffer
+	}
+	return pk.A.SetBytes(buf[:PublicKeySize])
+}
+
+// Bytes returns the binary representation of privKey,
+// as byte array seed||publicKey, as in crypto/ed25519
+// where seed is the private key of RFC 8032 and publicKey is as publicKey.Bytes().
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [PrivateKeySize]byte
+	pubkBin := privKey.PublicKey.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:SeedSize], privKey.seed[:])
+	subtle.ConstantTimeCopy(1, res[SeedSize:], pubkBin[:])
+	return res[:]
+}
+
+// SetBytes sets privKey from buf, where buf is interpreted
+// as seed||publicKey (see Bytes).
+// The key pair is derived from the seed, and an error is returned if it doesn't
+// match the public key.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < PrivateKeySize {
+		return 0, io.ErrShortBuffer
+	}
+	k, err := NewKeyFromSeed(buf[:SeedSize])
+	if err != nil {
+		return 0, err
+	}
+	pubkBin := k.PublicKey.A.Bytes()
+	if subtle.ConstantTimeCompare(pubkBin[:], buf[SeedSize:PrivateKeySize]) != 1 {
+		return 0, errInconsistentKey
+	}
+	*privKey = *k
+	return PrivateKeySize, nil
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array of size SignatureSize R||S where
+//   - R is the compressed point of https://tools.ietf.org/html/rfc8032#section-5.1.2
+//   - S=r+k⋅s mod l in little endian
+func (sig *Signature) Bytes() []byte {
+	var res [SignatureSize]byte
+	sigRBin := sig.R.Bytes()
+	subtle.ConstantTimeCopy(1, res[:curve25519.SizePointCompressed], sigRBin[:])
+	subtle.ConstantTimeCopy(1, res[curve25519.SizePointCompressed:], sig.S[:])
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary, R||S (see Bytes).
+// To avoid malleability, it rejects non-canonical encodings of R and S >= l,
+// see https://tools.ietf.org/html/rfc8032#section-5.1.7.
+//
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != SignatureSize {
+		return 0, errWrongSize
+	}
+
+	// S < l
+	var S [sizeFr]byte
+	copy(S[:], buf[curve25519.SizePointCompressed:])
+	if _, err := fr.LittleEndian.Element(&S); err != nil {
+		return 0, errSBiggerThanRMod
+	}
+
+	if _, err := sig.R.SetBytes(buf[:curve25519.SizePointCompressed]); err != nil {
+		return 0, err
+	}
+	sig.S = S
+	return SignatureSize, nil
+}
@@ -0,0 +1,261 @@
+//go:build (!amd64 && !386 && !arm && !mips && !mipsle) || (purego && !386 && !arm && !mips && !mipsle)
+// +build !amd64,!386,!arm,!mips,!mipsle purego,!386,!arm,!mips,!mipsle
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import "math/bits"
+
+// Mul z = x * y (mod q)
+func (z *Element) Mul(x, y *Element) *Element {
+
+	// q = 2^255 - c, with c = 0x13 (pseudo-Mersenne).
+	// We use the CIOS algorithm, where in each step the reduction
+	// t = (t + m·q) / 2⁶⁴
+	// is computed as
+	// t = (t - m·c) / 2⁶⁴ + m·2^191
+	// since q = -c mod 2⁶⁴, the low word of m·c is t[0] and only its high word is needed.
+	var t [6]uint64
+	var m, hi, b, C uint64
+
+	// t = t + x * y[0]
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	C, t[3] = madd1(y[0], x[3], C)
+	t[4] = C
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t = t + x * y[1]
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	C, t[3] = madd2(y[1], x[3], t[3], C)
+	t[4], t[5] = bits.Add64(t[4], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t = t + x * y[2]
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	C, t[3] = madd2(y[2], x[3], t[3], C)
+	t[4], t[5] = bits.Add64(t[4], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t = t + x * y[3]
+	C, t[0] = madd1(y[3], x[0], t[0])
+	C, t[1] = madd2(y[3], x[1], t[1], C)
+	C, t[2] = madd2(y[3], x[2], t[2], C)
+	C, t[3] = madd2(y[3], x[3], t[3], C)
+	t[4], t[5] = bits.Add64(t[4], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t < 2q, on 5 words
+	z[0] = t[0]
+	z[1] = t[1]
+	z[2] = t[2]
+	z[3] = t[3]
+	if t[4] != 0 || !z.smallerThanModulus() {
+		z[0], b = bits.Sub64(z[0], q0, 0)
+		z[1], b = bits.Sub64(z[1], q1, b)
+		z[2], b = bits.Sub64(z[2], q2, b)
+		z[3], b = bits.Sub64(z[3], q3, b)
+	}
+
+	return z
+}
+
+// Square z = x * x (mod q)
+func (z *Element) Square(x *Element) *Element {
+	// see Mul for algorithm documentation
+
+	// q = 2^255 - c, with c = 0x13 (pseudo-Mersenne).
+	// We use the CIOS algorithm, where in each step the reduction
+	// t = (t + m·q) / 2⁶⁴
+	// is computed as
+	// t = (t - m·c) / 2⁶⁴ + m·2^191
+	// since q = -c mod 2⁶⁴, the low word of m·c is t[0] and only its high word is needed.
+	var t [6]uint64
+	var m, hi, b, C uint64
+
+	// t = t + x * x[0]
+	C, t[0] = bits.Mul64(x[0], x[0])
+	C, t[1] = madd1(x[0], x[1], C)
+	C, t[2] = madd1(x[0], x[2], C)
+	C, t[3] = madd1(x[0], x[3], C)
+	t[4] = C
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t = t + x * x[1]
+	C, t[0] = madd1(x[1], x[0], t[0])
+	C, t[1] = madd2(x[1], x[1], t[1], C)
+	C, t[2] = madd2(x[1], x[2], t[2], C)
+	C, t[3] = madd2(x[1], x[3], t[3], C)
+	t[4], t[5] = bits.Add64(t[4], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t = t + x * x[2]
+	C, t[0] = madd1(x[2], x[0], t[0])
+	C, t[1] = madd2(x[2], x[1], t[1], C)
+	C, t[2] = madd2(x[2], x[2], t[2], C)
+	C, t[3] = madd2(x[2], x[3], t[3], C)
+	t[4], t[5] = bits.Add64(t[4], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t = t + x * x[3]
+	C, t[0] = madd1(x[3], x[0], t[0])
+	C, t[1] = madd2(x[3], x[1], t[1], C)
+	C, t[2] = madd2(x[3], x[2], t[2], C)
+	C, t[3] = madd2(x[3], x[3], t[3], C)
+	t[4], t[5] = bits.Add64(t[4], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, 0x13)
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	t[1], b = bits.Sub64(t[2], 0, b)
+	t[2], b = bits.Sub64(t[3], 0, b)
+	t[3], b = bits.Sub64(t[4], 0, b)
+	t[4], b = bits.Sub64(t[5], 0, b)
+
+	// t = t + m·2^191
+	t[2], C = bits.Add64(t[2], m<<63, 0)
+	t[3], C = bits.Add64(t[3], m>>1, C)
+	t[4], C = bits.Add64(t[4], 0, C)
+
+	// t < 2q, on 5 words
+	z[0] = t[0]
+	z[1] = t[1]
+	z[2] = t[2]
+	z[3] = t[3]
+	if t[4] != 0 || !z.smallerThanModulus() {
+		z[0], b = bits.Sub64(z[0], q0, 0)
+		z[1], b = bits.Sub64(z[1], q1, b)
+		z[2], b = bits.Sub64(z[2], q2, b)
+		z[3], b = bits.Sub64(z[3], q3, b)
+	}
+
+	return z
+}
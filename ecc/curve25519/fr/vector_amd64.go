@@ -0,0 +1,101 @@
+//go:build !purego
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// add sets vector[i] = a[i] + b[i]; the vectors must have the same length.
+func (vector Vector) add(a, b Vector) {
+	if len(vector) == 0 {
+		return
+	}
+	addVec(&vector[0], &a[0], &b[0], uint64(len(vector)))
+}
+
+// sub sets vector[i] = a[i] - b[i]; the vectors must have the same length.
+func (vector Vector) sub(a, b Vector) {
+	if len(vector) == 0 {
+		return
+	}
+	subVec(&vector[0], &a[0], &b[0], uint64(len(vector)))
+}
+
+// mul sets vector[i] = a[i] * b[i]; the vectors must have the same length.
+func (vector Vector) mul(a, b Vector) {
+	if len(vector) == 0 {
+		return
+	}
+	if !supportAdx {
+		for i := range vector {
+			vector[i].Mul(&a[i], &b[i])
+		}
+		return
+	}
+	mulVec(&vector[0], &a[0], &b[0], uint64(len(vector)))
+}
+
+// scalarMul sets vector[i] = a[i] * b; the vectors must have the same length.
+func (vector Vector) scalarMul(a Vector, b *Element) {
+	if len(vector) == 0 {
+		return
+	}
+	if !supportAdx {
+		for i := range vector {
+			vector[i].Mul(&a[i], b)
+		}
+		return
+	}
+	scalarMulVec(&vector[0], &a[0], b, uint64(len(vector)))
+}
+
+// innerProduct returns the inner product of vector and other; the vectors must have the same length.
+func (vector Vector) innerProduct(other Vector) (res Element) {
+	if len(vector) == 0 {
+		return
+	}
+	if !supportAdx {
+		var tmp Element
+		for i := range vector {
+			tmp.Mul(&vector[i], &other[i])
+			res.Add(&res, &tmp)
+		}
+		return
+	}
+	innerProdVec(&res, &vector[0], &other[0], uint64(len(vector)))
+	return
+}
+
+// the kernels below process n > 0 elements; they are implemented in element_ops_amd64.s
+// and, except addVec and subVec, require the ADX and BMI2 instructions.
+
+//go:noescape
+func addVec(res, a, b *Element, n uint64)
+
+//go:noescape
+func subVec(res, a, b *Element, n uint64)
+
+//go:noescape
+func mulVec(res, a, b *Element, n uint64)
+
+//go:noescape
+func scalarMulVec(res, a, b *Element, n uint64)
+
+// innerProdVec sets res = res + a[0]*b[0] + ... + a[n-1]*b[n-1]
+//
+//go:noescape
+func innerProdVec(res, a, b *Element, n uint64)
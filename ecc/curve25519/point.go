@@ -0,0 +1,503 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package curve25519
+
+import (
+	"errors"
+	"io"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+)
+
+// PointAffine point on edwards25519
+type PointAffine struct {
+	X, Y fp.Element
+}
+
+// PointExtended point in extended coordinates (X:Y:T:Z), with x=X/Z, y=Y/Z and x⋅y=T/Z
+type PointExtended struct {
+	X, Y, T, Z fp.Element
+}
+
+const (
+	// SizePointCompressed is the size of the RFC 8032 encoding of a point
+	SizePointCompressed = fp.Bytes
+	mMask               = 0x80
+)
+
+var (
+	errNotOnCurve   = errors.New("invalid point: not on the curve")
+	errNonCanonical = errors.New("invalid point: non-canonical y-coordinate")
+	errNegativeZero = errors.New("invalid point: x=0 with the sign bit set")
+)
+
+// Bytes returns the compressed point as a byte array, as specified in
+// https://tools.ietf.org/html/rfc8032#section-5.1.2: the y-coordinate in little
+// endian, with the most significant bit set to the least significant bit of x.
+func (p *PointAffine) Bytes() [SizePointCompressed]byte {
+	var res [SizePointCompressed]byte
+	fp.LittleEndian.PutElement(&res, p.Y)
+	if p.X.Bits()[0]&1 == 1 {
+		res[SizePointCompressed-1] |= mMask
+	}
+	return res
+}
+
+// Marshal converts p to a byte slice
+func (p *PointAffine) Marshal() []byte {
+	b := p.Bytes()
+	return b[:]
+}
+
+// computeX returns x such that -x²+y²=1+d⋅x²y², or nil if y isn't the y-coordinate
+// of a point on the curve
+func computeX(y *fp.Element) *fp.Element {
+	initOnce.Do(initCurveParams)
+
+	// x² = (y²-1) / (d⋅y²+1); d⋅y²+1 never vanishes as -1/d is not a square
+	var x, num, den, one fp.Element
+	one.SetOne()
+	num.Square(y)
+	den.Mul(&num, &curveParams.D).Add(&den, &one)
+	num.Sub(&num, &one)
+	num.Div(&num, &den)
+
+	return x.Sqrt(&num)
+}
+
+// SetBytes sets p from buf, decoding it as specified in
+// https://tools.ietf.org/html/rfc8032#section-5.1.3.
+// len(buf) >= SizePointCompressed
+// Returns the number of read bytes and an error if the buffer is too short,
+// if the y-coordinate isn't canonical or if the point isn't on the curve.
+func (p *PointAffine) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizePointCompressed {
+		return 0, io.ErrShortBuffer
+	}
+	var bufCopy [SizePointCompressed]byte
+	copy(bufCopy[:], buf[:SizePointCompressed])
+
+	sign := uint64(bufCopy[SizePointCompressed-1] >> 7)
+	bufCopy[SizePointCompressed-1] &^= mMask
+
+	y, err := fp.LittleEndian.Element(&bufCopy)
+	if err != nil {
+		return 0, errNonCanonical
+	}
+	x := computeX(&y)
+	if x == nil {
+		return 0, errNotOnCurve
+	}
+	if x.IsZero() && sign == 1 {
+		return 0, errNegativeZero
+	}
+	if x.Bits()[0]&1 != sign {
+		x.Neg(x)
+	}
+
+	p.X.Set(x)
+	p.Y.Set(&y)
+
+	return SizePointCompressed, nil
+}
+
+// Unmarshal alias to SetBytes()
+func (p *PointAffine) Unmarshal(b []byte) error {
+	_, err := p.SetBytes(b)
+	return err
+}
+
+// Set sets p to p1 and return it
+func (p *PointAffine) Set(p1 *PointAffine) *PointAffine {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	return p
+}
+
+// Equal returns true if p=p1 false otherwise
+func (p *PointAffine) Equal(p1 *PointAffine) bool {
+	return p.X.Equal(&p1.X) && p.Y.Equal(&p1.Y)
+}
+
+// IsZero returns true if p=0 false otherwise
+func (p *PointAffine) IsZero() bool {
+	var one fp.Element
+	one.SetOne()
+	return p.X.IsZero() && p.Y.Equal(&one)
+}
+
+// NewPointAffine creates a new instance of PointAffine
+func NewPointAffine(x, y fp.Element) PointAffine {
+	return PointAffine{x, y}
+}
+
+// IsOnCurve checks if a point is on the twisted Edwards curve
+func (p *PointAffine) IsOnCurve() bool {
+	initOnce.Do(initCurveParams)
+
+	var lhs, rhs, tmp fp.Element
+
+	tmp.Mul(&p.Y, &p.Y)
+	lhs.Mul(&p.X, &p.X)
+	mulByA(&lhs)
+	lhs.Add(&lhs, &tmp)
+
+	tmp.Mul(&p.X, &p.X).
+		Mul(&tmp, &p.Y).
+		Mul(&tmp, &p.Y).
+		Mul(&tmp, &curveParams.D)
+	rhs.SetOne().Add(&rhs, &tmp)
+
+	return lhs.Equal(&rhs)
+}
+
+// IsInSubGroup checks if a point is in the prime order subgroup, i.e. if [r]p=0
+func (p *PointAffine) IsInSubGroup() bool {
+	initOnce.Do(initCurveParams)
+
+	var res PointAffine
+	res.ScalarMultiplication(p, &curveParams.Order)
+	return res.IsZero()
+}
+
+// Neg sets p to -p1 and returns it
+func (p *PointAffine) Neg(p1 *PointAffine) *PointAffine {
+	p.X.Neg(&p1.X)
+	p.Y = p1.Y
+	return p
+}
+
+// Add adds two points (x,y), (u,v) on a twisted Edwards curve with parameters a, d
+// modifies p
+func (p *PointAffine) Add(p1, p2 *PointAffine) *PointAffine {
+	initOnce.Do(initCurveParams)
+
+	var xu, yv, xv, yu, dxyuv, one, denx, deny fp.Element
+	pRes := new(PointAffine)
+	xv.Mul(&p1.X, &p2.Y)
+	yu.Mul(&p1.Y, &p2.X)
+	pRes.X.Add(&xv, &yu)
+
+	xu.Mul(&p1.X, &p2.X)
+	mulByA(&xu)
+	yv.Mul(&p1.Y, &p2.Y)
+	pRes.Y.Sub(&yv, &xu)
+
+	dxyuv.Mul(&xv, &yu).Mul(&dxyuv, &curveParams.D)
+	one.SetOne()
+	denx.Add(&one, &dxyuv)
+	deny.Sub(&one, &dxyuv)
+
+	p.X.Div(&pRes.X, &denx)
+	p.Y.Div(&pRes.Y, &deny)
+
+	return p
+}
+
+// Double doubles point (x,y) on a twisted Edwards curve with parameters a, d
+// modifies p
+func (p *PointAffine) Double(p1 *PointAffine) *PointAffine {
+
+	p.Set(p1)
+	var xx, yy, xy, denum, two fp.Element
+
+	xx.Square(&p.X)
+	yy.Square(&p.Y)
+	xy.Mul(&p.X, &p.Y)
+	mulByA(&xx)
+	denum.Add(&xx, &yy)
+
+	p.X.Double(&xy).Div(&p.X, &denum)
+
+	two.SetOne().Double(&two)
+	denum.Neg(&denum).Add(&denum, &two)
+
+	p.Y.Sub(&yy, &xx).Div(&p.Y, &denum)
+
+	return p
+}
+
+// FromExtended sets p in affine from p in extended coordinates
+func (p *PointAffine) FromExtended(p1 *PointExtended) *PointAffine {
+	var I fp.Element
+	I.Inverse(&p1.Z)
+	p.X.Mul(&p1.X, &I)
+	p.Y.Mul(&p1.Y, &I)
+	return p
+}
+
+// ScalarMultiplication scalar multiplication of a point
+// p1 in affine coordinates with a scalar in big.Int
+func (p *PointAffine) ScalarMultiplication(p1 *PointAffine, scalar *big.Int) *PointAffine {
+
+	var p1Extended, resExtended PointExtended
+	p1Extended.FromAffine(p1)
+	resExtended.ScalarMultiplication(&p1Extended, scalar)
+	p.FromExtended(&resExtended)
+
+	return p
+}
+
+// ScalarMultiplicationCT scalar multiplication of a point p1 in affine coordinates
+// with a 256-bit scalar given in little endian, in constant time.
+// See PointExtended.ScalarMultiplicationCT.
+func (p *PointAffine) ScalarMultiplicationCT(p1 *PointAffine, scalar *[32]byte) *PointAffine {
+
+	var p1Extended, resExtended PointExtended
+	p1Extended.FromAffine(p1)
+	resExtended.ScalarMultiplicationCT(&p1Extended, scalar)
+
+	var I fp.Element
+	I.InverseCT(&resExtended.Z)
+	p.X.Mul(&resExtended.X, &I)
+	p.Y.Mul(&resExtended.Y, &I)
+
+	return p
+}
+
+// MontgomeryU returns the u-coordinate of the image of p on the Montgomery curve
+// V²=U³+486662U²+U, u=(1+y)/(1-y). The neutral element is mapped to 0.
+func (p *PointAffine) MontgomeryU() fp.Element {
+	var u, num, den fp.Element
+	num.SetOne()
+	den.Sub(&num, &p.Y)
+	num.Add(&num, &p.Y)
+	u.Div(&num, &den)
+	return u
+}
+
+// setInfinity sets p to O (0:1)
+func (p *PointAffine) setInfinity() *PointAffine {
+	p.X.SetZero()
+	p.Y.SetOne()
+	return p
+}
+
+// ------- Extended coordinates
+
+// Set sets p to p1 and return it
+func (p *PointExtended) Set(p1 *PointExtended) *PointExtended {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.T.Set(&p1.T)
+	p.Z.Set(&p1.Z)
+	return p
+}
+
+// IsZero returns true if p=0 false otherwise
+func (p *PointExtended) IsZero() bool {
+	return p.X.IsZero() && p.Y.Equal(&p.Z) && p.T.IsZero()
+}
+
+// Equal returns true if p=p1 false otherwise
+// If one point is on the affine chart Z=0 it returns false
+func (p *PointExtended) Equal(p1 *PointExtended) bool {
+	if p.Z.IsZero() || p1.Z.IsZero() {
+		return false
+	}
+	var lhs, rhs fp.Element
+	lhs.Mul(&p.X, &p1.Z)
+	rhs.Mul(&p1.X, &p.Z)
+	if !lhs.Equal(&rhs) {
+		return false
+	}
+	lhs.Mul(&p.Y, &p1.Z)
+	rhs.Mul(&p1.Y, &p.Z)
+	return lhs.Equal(&rhs)
+}
+
+// Neg negates point (x,y) on a twisted Edwards curve with parameters a, d
+// modifies p
+func (p *PointExtended) Neg(p1 *PointExtended) *PointExtended {
+	p.X.Neg(&p1.X)
+	p.Y = p1.Y
+	p.Z = p1.Z
+	p.T.Neg(&p1.T)
+	return p
+}
+
+// FromAffine sets p in projective from p in affine
+func (p *PointExtended) FromAffine(p1 *PointAffine) *PointExtended {
+	p.X.Set(&p1.X)
+	p.Y.Set(&p1.Y)
+	p.Z.SetOne()
+	p.T.Mul(&p1.X, &p1.Y)
+	return p
+}
+
+// Add adds points in extended coordinates
+// See https://hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-add-2008-hwcd
+//
+// The formulas are complete on edwards25519 (a=-1 is a square and d is not), so
+// they also handle doublings and the neutral element.
+func (p *PointExtended) Add(p1, p2 *PointExtended) *PointExtended {
+	initOnce.Do(initCurveParams)
+
+	var A, B, C, D, E, F, G, H, tmp fp.Element
+	A.Mul(&p1.X, &p2.X)
+	B.Mul(&p1.Y, &p2.Y)
+	C.Mul(&p1.T, &p2.T).Mul(&C, &curveParams.D)
+	D.Mul(&p1.Z, &p2.Z)
+	tmp.Add(&p1.X, &p1.Y)
+	E.Add(&p2.X, &p2.Y).
+		Mul(&E, &tmp).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	F.Sub(&D, &C)
+	G.Add(&D, &C)
+	H.Set(&A)
+	mulByA(&H)
+	H.Sub(&B, &H)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
+
+// MixedAdd adds a point in extended coordinates to a point in affine coordinates
+// See https://hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-madd-2008-hwcd
+func (p *PointExtended) MixedAdd(p1 *PointExtended, p2 *PointAffine) *PointExtended {
+	initOnce.Do(initCurveParams)
+
+	var A, B, C, D, E, F, G, H, tmp fp.Element
+	A.Mul(&p1.X, &p2.X)
+	B.Mul(&p1.Y, &p2.Y)
+	C.Mul(&p2.X, &p2.Y).
+		Mul(&C, &p1.T).
+		Mul(&C, &curveParams.D)
+	D.Set(&p1.Z)
+	tmp.Add(&p1.X, &p1.Y)
+	E.Add(&p2.X, &p2.Y).
+		Mul(&E, &tmp).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	F.Sub(&D, &C)
+	G.Add(&D, &C)
+	H.Set(&A)
+	mulByA(&H)
+	H.Sub(&B, &H)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&E, &H)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
+
+// Double adds points in extended coordinates
+// Dedicated doubling
+// https://hyperelliptic.org/EFD/g1p/auto-twisted-extended-1.html#doubling-dbl-2008-hwcd
+func (p *PointExtended) Double(p1 *PointExtended) *PointExtended {
+
+	var A, B, C, D, E, F, G, H fp.Element
+
+	A.Square(&p1.X)
+	B.Square(&p1.Y)
+	C.Square(&p1.Z).
+		Double(&C)
+	D.Set(&A)
+	mulByA(&D)
+	E.Add(&p1.X, &p1.Y).
+		Square(&E).
+		Sub(&E, &A).
+		Sub(&E, &B)
+	G.Add(&D, &B)
+	F.Sub(&G, &C)
+	H.Sub(&D, &B)
+
+	p.X.Mul(&E, &F)
+	p.Y.Mul(&G, &H)
+	p.T.Mul(&H, &E)
+	p.Z.Mul(&F, &G)
+
+	return p
+}
+
+// Select is a constant-time conditional move.
+// If c=0, p = p0. Else p = p1
+func (p *PointExtended) Select(c int, p0, p1 *PointExtended) *PointExtended {
+	p.X.Select(c, &p0.X, &p1.X)
+	p.Y.Select(c, &p0.Y, &p1.Y)
+	p.T.Select(c, &p0.T, &p1.T)
+	p.Z.Select(c, &p0.Z, &p1.Z)
+	return p
+}
+
+// setInfinity sets p to O (0:1:1:0)
+func (p *PointExtended) setInfinity() *PointExtended {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.Z.SetOne()
+	p.T.SetZero()
+	return p
+}
+
+// ScalarMultiplication scalar multiplication of a point
+// p1 in extended coordinates with a scalar in big.Int
+func (p *PointExtended) ScalarMultiplication(p1 *PointExtended, scalar *big.Int) *PointExtended {
+	var _scalar big.Int
+	_scalar.Set(scalar)
+	p.Set(p1)
+	if _scalar.Sign() == -1 {
+		_scalar.Neg(&_scalar)
+		p.Neg(p)
+	}
+	var resExtended PointExtended
+	resExtended.setInfinity()
+	const wordSize = bits.UintSize
+	sWords := _scalar.Bits()
+
+	for i := len(sWords) - 1; i >= 0; i-- {
+		ithWord := sWords[i]
+		for k := 0; k < wordSize; k++ {
+			resExtended.Double(&resExtended)
+			kthBit := (ithWord >> (wordSize - 1 - k)) & 1
+			if kthBit == 1 {
+				resExtended.Add(&resExtended, p)
+			}
+		}
+	}
+
+	p.Set(&resExtended)
+	return p
+}
+
+// ScalarMultiplicationCT scalar multiplication of a point p1 in extended coordinates
+// with a 256-bit scalar given in little endian (as the scalars of RFC 8032).
+//
+// The double-and-add-always loop goes through the 256 bits of the scalar and selects
+// the result in constant time, relying on the completeness of the addition law, so
+// that it is suitable for secret scalars.
+func (p *PointExtended) ScalarMultiplicationCT(p1 *PointExtended, scalar *[32]byte) *PointExtended {
+	var base, res, tmp PointExtended
+	base.Set(p1)
+	res.setInfinity()
+
+	for i := 255; i >= 0; i-- {
+		res.Double(&res)
+		tmp.Add(&res, &base)
+		bit := int((scalar[i/8] >> (i % 8)) & 1)
+		res.Select(bit, &res, &tmp)
+	}
+
+	p.Set(&res)
+	return p
+}
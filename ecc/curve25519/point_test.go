@@ -0,0 +1,232 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package curve25519
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestCurveParams(t *testing.T) {
+	t.Parallel()
+	params := GetEdwardsCurve()
+
+	if !params.Base.IsOnCurve() {
+		t.Fatal("base point should be on the curve")
+	}
+	if !params.Base.IsInSubGroup() {
+		t.Fatal("base point should be of order r")
+	}
+
+	// the Montgomery image of the base point is u=9
+	var nine fp.Element
+	nine.SetUint64(9)
+	if u := params.Base.MontgomeryU(); !u.Equal(&nine) {
+		t.Fatal("base point should be mapped to u=9")
+	}
+
+	// https://tools.ietf.org/html/rfc8032#section-5.1: the encoding of the base point
+	b := params.Base.Bytes()
+	if hex.EncodeToString(b[:]) != "5866666666666666666666666666666666666666666666666666666666666666" {
+		t.Fatal("wrong encoding of the base point")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+	params := GetEdwardsCurve()
+
+	var point, decoded PointAffine
+	var s big.Int
+	for i := 0; i < 20; i++ {
+		s.SetInt64(int64(i))
+		point.ScalarMultiplication(&params.Base, &s)
+		if i%2 == 1 {
+			point.Neg(&point)
+		}
+		if err := decoded.Unmarshal(point.Marshal()); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equal(&point) {
+			t.Fatal("marshal/unmarshal round trip failed")
+		}
+	}
+
+	t.Run("non-canonical y", func(t *testing.T) {
+		// y = p, which is the canonical 0
+		buf := [SizePointCompressed]byte{0xed}
+		for i := 1; i < 31; i++ {
+			buf[i] = 0xff
+		}
+		buf[31] = 0x7f
+		if _, err := decoded.SetBytes(buf[:]); err != errNonCanonical {
+			t.Fatal("non-canonical y should be rejected")
+		}
+	})
+
+	t.Run("negative zero", func(t *testing.T) {
+		// y = 1, x = 0 with the sign bit set
+		buf := [SizePointCompressed]byte{1}
+		buf[31] = 0x80
+		if _, err := decoded.SetBytes(buf[:]); err != errNegativeZero {
+			t.Fatal("x=0 with the sign bit set should be rejected")
+		}
+	})
+
+	t.Run("not on curve", func(t *testing.T) {
+		// y = 2: (y²-1)/(d⋅y²+1) is not a square
+		buf := [SizePointCompressed]byte{2}
+		if _, err := decoded.SetBytes(buf[:]); err != errNotOnCurve {
+			t.Fatal("y=2 should not decode to a point")
+		}
+	})
+
+	t.Run("short buffer", func(t *testing.T) {
+		if _, err := decoded.SetBytes(make([]byte, SizePointCompressed-1)); err == nil {
+			t.Fatal("short buffer should be rejected")
+		}
+	})
+}
+
+func TestSmallOrder(t *testing.T) {
+	t.Parallel()
+
+	// (0, -1) is of order 2
+	var p PointAffine
+	p.Y.SetOne().Neg(&p.Y)
+	if !p.IsOnCurve() {
+		t.Fatal("(0,-1) should be on the curve")
+	}
+	if p.IsInSubGroup() {
+		t.Fatal("(0,-1) should not be in the prime order subgroup")
+	}
+	p.Double(&p)
+	if !p.IsZero() {
+		t.Fatal("(0,-1) should be of order 2")
+	}
+}
+
+func TestOps(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 5
+	} else {
+		parameters.MinSuccessfulTests = 50
+	}
+	properties := gopter.NewProperties(parameters)
+
+	genS := func(t *gopter.GenParameters) *gopter.GenResult {
+		var s [32]byte
+		if _, err := rand.Read(s[:]); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(s, gopter.NoShrinker)
+	}
+	params := GetEdwardsCurve()
+
+	properties.Property("(affine) P+(-P)=O", prop.ForAll(
+		func(s [32]byte) bool {
+			var p1, p2 PointAffine
+			p1.ScalarMultiplicationCT(&params.Base, &s)
+			p2.Neg(&p1)
+			p1.Add(&p1, &p2)
+			return p1.IsZero()
+		},
+		genS,
+	))
+
+	properties.Property("(affine) P+P=2*P", prop.ForAll(
+		func(s [32]byte) bool {
+			var p, inf PointAffine
+			inf.setInfinity()
+			p.ScalarMultiplicationCT(&params.Base, &s)
+
+			var p1, p2 PointAffine
+			p1.Add(&p, &p)
+			p2.Double(&p)
+			return p1.Equal(&p2) && p1.IsOnCurve() && p1.Add(&p1, &inf).Equal(&p2)
+		},
+		genS,
+	))
+
+	properties.Property("(extended) Add, MixedAdd and Double are consistent", prop.ForAll(
+		func(s [32]byte) bool {
+			var p PointAffine
+			p.ScalarMultiplicationCT(&params.Base, &s)
+
+			var pe, add, madd, dbl PointExtended
+			pe.FromAffine(&p)
+			add.Add(&pe, &pe)
+			madd.MixedAdd(&pe, &p)
+			dbl.Double(&pe)
+			var res PointAffine
+			res.Double(&p)
+			var resExt PointExtended
+			resExt.FromAffine(&res)
+			return add.Equal(&resExt) && madd.Equal(&resExt) && dbl.Equal(&resExt)
+		},
+		genS,
+	))
+
+	properties.Property("(extended) ScalarMultiplicationCT matches ScalarMultiplication", prop.ForAll(
+		func(s [32]byte) bool {
+			var sBig big.Int
+			var sBE [32]byte
+			for i := range s {
+				sBE[31-i] = s[i]
+			}
+			sBig.SetBytes(sBE[:])
+
+			var p1, p2 PointAffine
+			p1.ScalarMultiplication(&params.Base, &sBig)
+			p2.ScalarMultiplicationCT(&params.Base, &s)
+			return p1.Equal(&p2) && p1.IsInSubGroup()
+		},
+		genS,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func BenchmarkScalarMultiplication(b *testing.B) {
+	params := GetEdwardsCurve()
+	var s [32]byte
+	if _, err := rand.Read(s[:]); err != nil {
+		b.Fatal(err)
+	}
+	var sBig big.Int
+	sBig.SetBytes(s[:])
+
+	var p PointAffine
+	b.Run("double and add", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			p.ScalarMultiplication(&params.Base, &sBig)
+		}
+	})
+	b.Run("constant time", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			p.ScalarMultiplicationCT(&params.Base, &s)
+		}
+	})
+}
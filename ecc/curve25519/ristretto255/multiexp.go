@@ -0,0 +1,112 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ristretto255
+
+import (
+	"errors"
+	"math/bits"
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MultiExp computes the multi-exponentiation ∑ᵢ [scalars[i]]points[i]
+// and assigns it to e, using Pippenger's bucket method.
+//
+// Each window of the scalars is processed by a separate task, at most config.NbTasks
+// of them running concurrently. MultiExp is not constant time.
+func (e *Element) MultiExp(points []Element, scalars []fr.Element, config ecc.MultiExpConfig) (*Element, error) {
+	nbPoints := len(points)
+	if nbPoints != len(scalars) {
+		return nil, errors.New("len(points) != len(scalars)")
+	}
+	if config.NbTasks <= 0 {
+		config.NbTasks = runtime.NumCPU()
+	} else if config.NbTasks > 1024 {
+		return nil, errors.New("invalid config: config.NbTasks > 1024")
+	}
+
+	// window size: the bucket method costs about n⋅b/c + 2^c⋅b/c additions for b-bit scalars
+	c := bits.Len(uint(nbPoints)) - 2
+	if c < 2 {
+		c = 2
+	} else if c > 16 {
+		c = 16
+	}
+	nbChunks := (fr.Bits + c - 1) / c
+
+	// scalars in regular form
+	sBits := make([][fr.Limbs]uint64, nbPoints)
+	parallel.Execute(nbPoints, func(start, end int) {
+		for i := start; i < end; i++ {
+			sBits[i] = scalars[i].Bits()
+		}
+	}, config.NbTasks)
+
+	chunks := make([]curve25519.PointExtended, nbChunks)
+	parallel.Execute(nbChunks, func(start, end int) {
+		for j := start; j < end; j++ {
+			msmProcessChunk(&chunks[j], points, sBits, uint(j*c), uint(c))
+		}
+	}, config.NbTasks)
+
+	// ∑ⱼ 2^(j⋅c)⋅chunks[j]
+	var res curve25519.PointExtended
+	res.Set(&chunks[nbChunks-1])
+	for j := nbChunks - 2; j >= 0; j-- {
+		for k := 0; k < c; k++ {
+			res.Double(&res)
+		}
+		res.Add(&res, &chunks[j])
+	}
+
+	e.p.Set(&res)
+	return e, nil
+}
+
+// msmProcessChunk sets res to ∑ᵢ dᵢ⋅points[i], where dᵢ is the c-bit digit of scalars[i]
+// starting at bit offset
+func msmProcessChunk(res *curve25519.PointExtended, points []Element, sBits [][fr.Limbs]uint64, offset, c uint) {
+	buckets := make([]curve25519.PointExtended, (1<<c)-1)
+	for i := range buckets {
+		setIdentity(&buckets[i])
+	}
+
+	mask := uint64(1<<c) - 1
+	w, shift := offset/64, offset%64
+	for i := range points {
+		digit := sBits[i][w] >> shift
+		if shift+c > 64 && w+1 < fr.Limbs {
+			digit |= sBits[i][w+1] << (64 - shift)
+		}
+		digit &= mask
+		if digit != 0 {
+			buckets[digit-1].Add(&buckets[digit-1], &points[i].p)
+		}
+	}
+
+	// ∑ₖ k⋅buckets[k-1] with a running sum
+	var runningSum, total curve25519.PointExtended
+	setIdentity(&runningSum)
+	setIdentity(&total)
+	for k := len(buckets) - 1; k >= 0; k-- {
+		runningSum.Add(&runningSum, &buckets[k])
+		total.Add(&total, &runningSum)
+	}
+	res.Set(&total)
+}
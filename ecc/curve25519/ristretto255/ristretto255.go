@@ -0,0 +1,411 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ristretto255 implements the ristretto255 prime-order group of RFC 9496
+// (https://www.rfc-editor.org/rfc/rfc9496.html), built on top of edwards25519.
+//
+// A ristretto255 Element is an equivalence class of edwards25519 points modulo the 4-torsion:
+// the group has prime order r=2^252+27742317777372353535851937790883648493 (the scalar field
+// fr of curve25519), every element has a unique canonical 32-byte encoding and there is no
+// cofactor to clear. This makes it a drop-in group for protocols designed for prime-order
+// groups (sigma protocols, OPRFs, ...).
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. The encoding, decoding, the one-way map
+// and ScalarMultiplication are constant time; MultiExp is not.
+package ristretto255
+
+import (
+	"crypto/sha512"
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+	"github.com/consensys/gnark-crypto/field/hash"
+)
+
+const (
+	// SizeElement is the size of the canonical encoding of an Element
+	SizeElement = fp.Bytes
+	// SizeUniformBytes is the size of the input of the one-way map
+	SizeUniformBytes = 2 * fp.Bytes
+)
+
+// Element is an element of the ristretto255 group, represented by one of the
+// edwards25519 points of its equivalence class.
+//
+// The zero value is not valid; use SetZero.
+type Element struct {
+	p curve25519.PointExtended
+}
+
+var (
+	errInvalidEncoding = errors.New("ristretto255: invalid element encoding")
+	errWrongSize       = errors.New("ristretto255: wrong size buffer")
+)
+
+// constants of https://www.rfc-editor.org/rfc/rfc9496.html#section-4.1
+var (
+	d, sqrtM1, sqrtADMinusOne, invSqrtAMinusD, oneMinusDSq, dMinusOneSq fp.Element
+	generator                                                           Element
+
+	// pMinus5Div8 = (p-5)/8
+	pMinus5Div8 big.Int
+)
+
+func init() {
+	params := curve25519.GetEdwardsCurve()
+	d.Set(&params.D)
+	sqrtM1.SetString("19681161376707505956807079304988542015446066515923890162744021073123829784752")
+	sqrtADMinusOne.SetString("25063068953384623474111414158702152701244531502492656460079210482610430750235")
+	invSqrtAMinusD.SetString("54469307008909316920995813868745141605393597292927456921205312896311721017578")
+	oneMinusDSq.SetString("1159843021668779879193775521855586647937357759715417654439879720876111806838")
+	dMinusOneSq.SetString("40440834346308536858101042469323190826248399146238708352240133220865137265952")
+
+	generator.p.FromAffine(&params.Base)
+
+	pMinus5Div8.Sub(fp.Modulus(), big.NewInt(5)).Rsh(&pMinus5Div8, 3)
+}
+
+// Generator returns the canonical generator of ristretto255, the class of the
+// edwards25519 base point
+func Generator() Element {
+	return generator
+}
+
+// SetZero sets e to the identity element and returns it
+func (e *Element) SetZero() *Element {
+	setIdentity(&e.p)
+	return e
+}
+
+// setIdentity sets p to the neutral element (0:1:1:0) of edwards25519
+func setIdentity(p *curve25519.PointExtended) {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.Z.SetOne()
+	p.T.SetZero()
+}
+
+// Set sets e to e1 and returns it
+func (e *Element) Set(e1 *Element) *Element {
+	e.p.Set(&e1.p)
+	return e
+}
+
+// Equal returns true if e and e1 are the same element of the group, see
+// https://www.rfc-editor.org/rfc/rfc9496.html#section-4.3.3
+func (e *Element) Equal(e1 *Element) bool {
+	var a, b fp.Element
+	a.Mul(&e.p.X, &e1.p.Y)
+	b.Mul(&e.p.Y, &e1.p.X)
+	c1 := a.Equal(&b)
+	a.Mul(&e.p.Y, &e1.p.Y)
+	b.Mul(&e.p.X, &e1.p.X)
+	c2 := a.Equal(&b)
+	return c1 || c2
+}
+
+// IsZero returns true if e is the identity element
+func (e *Element) IsZero() bool {
+	var zero Element
+	zero.SetZero()
+	return e.Equal(&zero)
+}
+
+// Add sets e to e1+e2 and returns it
+func (e *Element) Add(e1, e2 *Element) *Element {
+	e.p.Add(&e1.p, &e2.p)
+	return e
+}
+
+// Sub sets e to e1-e2 and returns it
+func (e *Element) Sub(e1, e2 *Element) *Element {
+	var n curve25519.PointExtended
+	n.Neg(&e2.p)
+	e.p.Add(&e1.p, &n)
+	return e
+}
+
+// Neg sets e to -e1 and returns it
+func (e *Element) Neg(e1 *Element) *Element {
+	e.p.Neg(&e1.p)
+	return e
+}
+
+// Double sets e to 2⋅e1 and returns it
+func (e *Element) Double(e1 *Element) *Element {
+	e.p.Double(&e1.p)
+	return e
+}
+
+// ScalarMultiplication sets e to [s]e1 and returns it, in constant time
+func (e *Element) ScalarMultiplication(e1 *Element, s *fr.Element) *Element {
+	var sBytes [fr.Bytes]byte
+	fr.LittleEndian.PutElement(&sBytes, *s)
+	e.p.ScalarMultiplicationCT(&e1.p, &sBytes)
+	return e
+}
+
+// ScalarMultiplicationBase sets e to [s]G, where G is the generator, and returns it
+func (e *Element) ScalarMultiplicationBase(s *fr.Element) *Element {
+	return e.ScalarMultiplication(&generator, s)
+}
+
+// Bytes returns the canonical encoding of e, see
+// https://www.rfc-editor.org/rfc/rfc9496.html#section-4.3.2
+func (e *Element) Bytes() [SizeElement]byte {
+	var u1, u2, tmp, invSqrt, den1, den2, zInv, ix0, iy0, enchantedDenominator fp.Element
+	x0, y0, z0, t0 := &e.p.X, &e.p.Y, &e.p.Z, &e.p.T
+
+	// u1 = (z0 + y0) * (z0 - y0), u2 = x0 * y0
+	u1.Add(z0, y0)
+	tmp.Sub(z0, y0)
+	u1.Mul(&u1, &tmp)
+	u2.Mul(x0, y0)
+
+	// invsqrt = 1/sqrt(u1 * u2^2)
+	var one fp.Element
+	one.SetOne()
+	tmp.Square(&u2).Mul(&tmp, &u1)
+	sqrtRatioM1(&invSqrt, &one, &tmp)
+
+	den1.Mul(&invSqrt, &u1)
+	den2.Mul(&invSqrt, &u2)
+	zInv.Mul(&den1, &den2).Mul(&zInv, t0)
+
+	ix0.Mul(x0, &sqrtM1)
+	iy0.Mul(y0, &sqrtM1)
+	enchantedDenominator.Mul(&den1, &invSqrtAMinusD)
+
+	tmp.Mul(t0, &zInv)
+	rotate := isNegative(&tmp)
+
+	var x, y, denInv fp.Element
+	x.Select(rotate, x0, &iy0)
+	y.Select(rotate, y0, &ix0)
+	denInv.Select(rotate, &den2, &enchantedDenominator)
+
+	tmp.Mul(&x, &zInv)
+	condNeg(&y, isNegative(&tmp))
+
+	var s fp.Element
+	s.Sub(z0, &y).Mul(&s, &denInv)
+	condNeg(&s, isNegative(&s))
+
+	var res [SizeElement]byte
+	fp.LittleEndian.PutElement(&res, s)
+	return res
+}
+
+// Marshal converts e to a byte slice
+func (e *Element) Marshal() []byte {
+	b := e.Bytes()
+	return b[:]
+}
+
+// SetBytes sets e from its canonical encoding in buf, see
+// https://www.rfc-editor.org/rfc/rfc9496.html#section-4.3.1.
+// len(buf) >= SizeElement
+// It returns the number of bytes read, and an error if buf isn't a canonical encoding.
+func (e *Element) SetBytes(buf []byte) (int, error) {
+	if len(buf) < SizeElement {
+		return 0, errWrongSize
+	}
+	var bufCopy [SizeElement]byte
+	copy(bufCopy[:], buf[:SizeElement])
+
+	// s must be canonical and non-negative
+	s, err := fp.LittleEndian.Element(&bufCopy)
+	if err != nil || isNegative(&s) == 1 {
+		return 0, errInvalidEncoding
+	}
+
+	var ss, u1, u2, u2Sqr, v, tmp, one fp.Element
+	one.SetOne()
+	ss.Square(&s)
+	u1.Sub(&one, &ss)
+	u2.Add(&one, &ss)
+	u2Sqr.Square(&u2)
+
+	// v = -(D * u1^2) - u2_sqr
+	v.Square(&u1).Mul(&v, &d).Neg(&v).Sub(&v, &u2Sqr)
+
+	var invSqrt fp.Element
+	tmp.Mul(&v, &u2Sqr)
+	wasSquare := sqrtRatioM1(&invSqrt, &one, &tmp)
+
+	var denX, denY, x, y, t fp.Element
+	denX.Mul(&invSqrt, &u2)
+	denY.Mul(&invSqrt, &denX).Mul(&denY, &v)
+
+	x.Double(&s).Mul(&x, &denX)
+	condNeg(&x, isNegative(&x))
+	y.Mul(&u1, &denY)
+	t.Mul(&x, &y)
+
+	if wasSquare == 0 || isNegative(&t) == 1 || y.IsZero() {
+		return 0, errInvalidEncoding
+	}
+
+	e.p.X.Set(&x)
+	e.p.Y.Set(&y)
+	e.p.Z.SetOne()
+	e.p.T.Set(&t)
+
+	return SizeElement, nil
+}
+
+// Unmarshal alias to SetBytes()
+func (e *Element) Unmarshal(buf []byte) error {
+	_, err := e.SetBytes(buf)
+	return err
+}
+
+// SetUniformBytes sets e to the image of 64 uniformly random bytes by the one-way
+// map of https://www.rfc-editor.org/rfc/rfc9496.html#section-4.3.4.
+func (e *Element) SetUniformBytes(b []byte) (*Element, error) {
+	if len(b) != SizeUniformBytes {
+		return nil, errWrongSize
+	}
+	var p1, p2 curve25519.PointExtended
+	mapToPoint(&p1, b[:fp.Bytes])
+	mapToPoint(&p2, b[fp.Bytes:])
+	e.p.Add(&p1, &p2)
+	return e, nil
+}
+
+// HashToGroup hashes msg to an element of ristretto255, following the
+// ristretto255_XMD:SHA-512_R255MAP_RO_ suite of https://www.rfc-editor.org/rfc/rfc9380.html#appendix-B:
+// msg is expanded to 64 bytes with expand_message_xmd (SHA-512) and mapped with SetUniformBytes.
+//
+// dst stands for "domain separation tag", a string unique to the construction using the hash function
+func HashToGroup(msg, dst []byte) (Element, error) {
+	var res Element
+	b, err := hash.ExpandMsgXmdWithHash(sha512.New(), msg, dst, SizeUniformBytes)
+	if err != nil {
+		return res, err
+	}
+	_, err = res.SetUniformBytes(b)
+	return res, err
+}
+
+// mapToPoint implements MAP of https://www.rfc-editor.org/rfc/rfc9496.html#section-4.3.4
+// on the 32 bytes b, whose most significant bit is ignored.
+func mapToPoint(p *curve25519.PointExtended, b []byte) {
+	// t = LE(b) mod p, with the top bit masked
+	var tBytes [fp.Bytes]byte
+	for i := 0; i < fp.Bytes; i++ {
+		tBytes[i] = b[fp.Bytes-1-i]
+	}
+	tBytes[0] &= 0x7f
+	var t fp.Element
+	t.SetBytes(tBytes[:])
+
+	var one, r, u, v, tmp fp.Element
+	one.SetOne()
+
+	// r = SQRT_M1 * t^2
+	r.Square(&t).Mul(&r, &sqrtM1)
+	// u = (r + 1) * ONE_MINUS_D_SQ
+	u.Add(&r, &one).Mul(&u, &oneMinusDSq)
+	// v = (-1 - r*D) * (r + D)
+	v.Mul(&r, &d).Add(&v, &one).Neg(&v)
+	tmp.Add(&r, &d)
+	v.Mul(&v, &tmp)
+
+	var s, sPrime, c fp.Element
+	wasSquare := sqrtRatioM1(&s, &u, &v)
+	sPrime.Mul(&s, &t)
+	condNeg(&sPrime, isNegative(&sPrime))
+	sPrime.Neg(&sPrime)
+	s.Select(int(wasSquare), &sPrime, &s)
+
+	var minusOne fp.Element
+	minusOne.Neg(&one)
+	c.Select(int(wasSquare), &r, &minusOne)
+
+	// N = c * (r - 1) * D_MINUS_ONE_SQ - v
+	var n fp.Element
+	n.Sub(&r, &one).Mul(&n, &c).Mul(&n, &dMinusOneSq).Sub(&n, &v)
+
+	var w0, w1, w2, w3 fp.Element
+	w0.Double(&s).Mul(&w0, &v)
+	w1.Mul(&n, &sqrtADMinusOne)
+	tmp.Square(&s)
+	w2.Sub(&one, &tmp)
+	w3.Add(&one, &tmp)
+
+	p.X.Mul(&w0, &w3)
+	p.Y.Mul(&w2, &w1)
+	p.Z.Mul(&w1, &w3)
+	p.T.Mul(&w0, &w2)
+}
+
+// sqrtRatioM1 implements SQRT_RATIO_M1 of https://www.rfc-editor.org/rfc/rfc9496.html#section-4.2:
+// it sets r to the non-negative square root of u/v if it exists and of SQRT_M1⋅u/v otherwise,
+// and returns 1 if u/v was square, 0 otherwise.
+func sqrtRatioM1(r, u, v *fp.Element) uint64 {
+	var v3, v7, tmp, check fp.Element
+
+	// r = (u * v^3) * (u * v^7)^((p-5)/8)
+	v3.Square(v).Mul(&v3, v)
+	v7.Square(&v3).Mul(&v7, v)
+	tmp.Mul(u, &v7)
+	powPm5d8(&tmp, &tmp)
+	r.Mul(u, &v3).Mul(r, &tmp)
+
+	check.Square(r).Mul(&check, v)
+
+	var negU, negUSqrtM1 fp.Element
+	negU.Neg(u)
+	negUSqrtM1.Mul(&negU, &sqrtM1)
+
+	correctSignSqrt := ctEqual(&check, u)
+	flippedSignSqrt := ctEqual(&check, &negU)
+	flippedSignSqrtI := ctEqual(&check, &negUSqrtM1)
+
+	var rPrime fp.Element
+	rPrime.Mul(r, &sqrtM1)
+	r.Select(int(flippedSignSqrt|flippedSignSqrtI), r, &rPrime)
+	condNeg(r, isNegative(r))
+
+	return correctSignSqrt | flippedSignSqrt
+}
+
+// powPm5d8 sets z to x^((p-5)/8), in constant time
+func powPm5d8(z, x *fp.Element) {
+	z.ExpCT(*x, &pMinus5Div8)
+}
+
+// ctEqual returns 1 if x=y, 0 otherwise, in constant time
+func ctEqual(x, y *fp.Element) uint64 {
+	v := x.NotEqual(y)
+	return 1 ^ ((v | -v) >> 63)
+}
+
+// isNegative returns 1 if the canonical representative of x is odd, 0 otherwise
+func isNegative(x *fp.Element) int {
+	return int(x.Bits()[0] & 1)
+}
+
+// condNeg sets x to -x if c=1, in constant time
+func condNeg(x *fp.Element, c int) {
+	var n fp.Element
+	n.Neg(x)
+	x.Select(c, x, &n)
+}
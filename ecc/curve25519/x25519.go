@@ -0,0 +1,138 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package curve25519
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+)
+
+const (
+	// ScalarSize is the size of the scalars of X25519
+	ScalarSize = 32
+	// PointSize is the size of the u-coordinates of X25519
+	PointSize = fp.Bytes
+)
+
+// Basepoint is the u-coordinate of the generator of X25519, u=9
+var Basepoint = []byte{9, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+
+var (
+	errX25519Size     = errors.New("x25519: bad scalar or point length")
+	errX25519LowOrder = errors.New("x25519: low order point")
+)
+
+// X25519 returns the u-coordinate of [scalar]point on the Montgomery curve, as
+// specified in https://tools.ietf.org/html/rfc7748#section-5.
+//
+// scalar and point must be 32 bytes long. The scalar is clamped, the most significant
+// bit of point is masked and non-canonical u-coordinates are accepted. Following
+// https://tools.ietf.org/html/rfc7748#section-6.1, an error is returned if the result is
+// the all-zero value, i.e. if point is of small order.
+//
+// Use Basepoint as point to compute a public key.
+func X25519(scalar, point []byte) ([]byte, error) {
+	if len(scalar) != ScalarSize || len(point) != PointSize {
+		return nil, errX25519Size
+	}
+
+	var k [ScalarSize]byte
+	copy(k[:], scalar)
+	k[0] &= 248
+	k[31] &= 127
+	k[31] |= 64
+
+	// fp.Element.SetBytes reads big endian and reduces modulo p
+	var uBytes [PointSize]byte
+	for i := 0; i < PointSize; i++ {
+		uBytes[i] = point[PointSize-1-i]
+	}
+	uBytes[0] &= 0x7f
+	var u fp.Element
+	u.SetBytes(uBytes[:])
+
+	res := MontgomeryScalarMultiplication(&u, &k)
+
+	var out [PointSize]byte
+	fp.LittleEndian.PutElement(&out, res)
+	var acc byte
+	for i := range out {
+		acc |= out[i]
+	}
+	if acc == 0 {
+		return nil, errX25519LowOrder
+	}
+	return out[:], nil
+}
+
+// MontgomeryScalarMultiplication returns the u-coordinate of [scalar]P on the Montgomery
+// curve V²=U³+486662U²+U, where P is a point of u-coordinate u. The scalar is given in
+// little endian and is used as is (no clamping).
+//
+// It runs the x-only Montgomery ladder of https://tools.ietf.org/html/rfc7748#section-5
+// over the 256 bits of the scalar, in constant time. The point at infinity is mapped to 0.
+func MontgomeryScalarMultiplication(u *fp.Element, scalar *[32]byte) fp.Element {
+	var x1, x2, z2, x3, z3 fp.Element
+	x1.Set(u)
+	x2.SetOne()
+	x3.Set(u)
+	z3.SetOne()
+
+	// a24 = (A-2)/4
+	var a24 fp.Element
+	a24.SetUint64((MontgomeryA - 2) / 4)
+
+	var A, AA, B, BB, E, C, D, DA, CB, tmp fp.Element
+	swap := 0
+	for t := 255; t >= 0; t-- {
+		kt := int((scalar[t/8] >> (t % 8)) & 1)
+		swap ^= kt
+		cswap(swap, &x2, &x3)
+		cswap(swap, &z2, &z3)
+		swap = kt
+
+		A.Add(&x2, &z2)
+		AA.Square(&A)
+		B.Sub(&x2, &z2)
+		BB.Square(&B)
+		E.Sub(&AA, &BB)
+		C.Add(&x3, &z3)
+		D.Sub(&x3, &z3)
+		DA.Mul(&D, &A)
+		CB.Mul(&C, &B)
+
+		x3.Add(&DA, &CB).Square(&x3)
+		z3.Sub(&DA, &CB).Square(&z3).Mul(&z3, &x1)
+		x2.Mul(&AA, &BB)
+		tmp.Mul(&a24, &E).Add(&tmp, &AA)
+		z2.Mul(&E, &tmp)
+	}
+	cswap(swap, &x2, &x3)
+	cswap(swap, &z2, &z3)
+
+	var res fp.Element
+	z2.InverseCT(&z2)
+	res.Mul(&x2, &z2)
+	return res
+}
+
+// cswap swaps a and b in constant time if c=1
+func cswap(c int, a, b *fp.Element) {
+	var ta, tb fp.Element
+	ta.Select(c, a, b)
+	tb.Select(c, b, a)
+	*a, *b = ta, tb
+}
@@ -0,0 +1,180 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package curve25519
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// https://tools.ietf.org/html/rfc7748#section-5.2
+func TestX25519Vectors(t *testing.T) {
+	t.Parallel()
+	vectors := []struct {
+		scalar, point, expected string
+	}{
+		{
+			"a546e36bf0527c9d3b16154b82465edd62144c0ac1fc5a18506a2244ba449ac4",
+			"e6db6867583030db3594c1a424b15f7c726624ec26b3353b10a903a6d0ab1c4c",
+			"c3da55379de9c6908e94ea4df28d084f32eccf03491c71f754b4075577a28552",
+		},
+		{
+			"4b66e9d4d1b4673c5ad22691957d6af5c11b6421e0ea01d42ca4169e7918ba0d",
+			"e5210f12786811d3f4b7959d0538ae2c31dbe7106fc03c3efc4cd549c715a493",
+			"95cbde9476e8907d7aade45cb4b873f88b595a68799fa152e6f8f7647aac7957",
+		},
+	}
+	for _, v := range vectors {
+		res, err := X25519(mustDecodeHex(t, v.scalar), mustDecodeHex(t, v.point))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(res) != v.expected {
+			t.Fatalf("expected %s, got %x", v.expected, res)
+		}
+	}
+}
+
+// https://tools.ietf.org/html/rfc7748#section-5.2, iterated test
+func TestX25519Iterated(t *testing.T) {
+	t.Parallel()
+	k := append([]byte(nil), Basepoint...)
+	u := append([]byte(nil), Basepoint...)
+
+	iterate := func(n int) {
+		for i := 0; i < n; i++ {
+			res, err := X25519(k, u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			u, k = k, res
+		}
+	}
+
+	iterate(1)
+	if hex.EncodeToString(k) != "422c8e7a6227d7bca1350b3e2bb7279f7897b87bb6854b783c60e80311ae3079" {
+		t.Fatalf("wrong result after 1 iteration: %x", k)
+	}
+	if testing.Short() {
+		return
+	}
+	iterate(999)
+	if hex.EncodeToString(k) != "684cf59ba83309552800ef566f2f4d3c1c3887c49360e3875f2eb94d99532c51" {
+		t.Fatalf("wrong result after 1000 iterations: %x", k)
+	}
+}
+
+// https://tools.ietf.org/html/rfc7748#section-6.1
+func TestX25519DiffieHellman(t *testing.T) {
+	t.Parallel()
+	alicePriv := mustDecodeHex(t, "77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	bobPriv := mustDecodeHex(t, "5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb")
+
+	alicePub, err := X25519(alicePriv, Basepoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(alicePub) != "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a" {
+		t.Fatal("wrong public key for Alice")
+	}
+	bobPub, err := X25519(bobPriv, Basepoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(bobPub) != "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f" {
+		t.Fatal("wrong public key for Bob")
+	}
+
+	k1, err := X25519(alicePriv, bobPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k2, err := X25519(bobPriv, alicePub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(k1, k2) || hex.EncodeToString(k1) != "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742" {
+		t.Fatal("wrong shared secret")
+	}
+}
+
+func TestX25519Errors(t *testing.T) {
+	t.Parallel()
+	scalar := make([]byte, ScalarSize)
+	if _, err := rand.Read(scalar); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := X25519(scalar[:31], Basepoint); err != errX25519Size {
+		t.Fatal("short scalar should be rejected")
+	}
+	if _, err := X25519(scalar, Basepoint[:31]); err != errX25519Size {
+		t.Fatal("short point should be rejected")
+	}
+
+	// u=0 and u=1 are of small order
+	zero := make([]byte, PointSize)
+	if _, err := X25519(scalar, zero); err != errX25519LowOrder {
+		t.Fatal("u=0 should be rejected")
+	}
+	one := make([]byte, PointSize)
+	one[0] = 1
+	if _, err := X25519(scalar, one); err != errX25519LowOrder {
+		t.Fatal("u=1 should be rejected")
+	}
+}
+
+func TestX25519MatchesEdwards(t *testing.T) {
+	t.Parallel()
+	params := GetEdwardsCurve()
+
+	var s [32]byte
+	if _, err := rand.Read(s[:]); err != nil {
+		t.Fatal(err)
+	}
+	var p PointAffine
+	p.ScalarMultiplicationCT(&params.Base, &s)
+	expected := p.MontgomeryU()
+
+	var nine fp.Element
+	nine.SetUint64(9)
+	if u := MontgomeryScalarMultiplication(&nine, &s); !u.Equal(&expected) {
+		t.Fatal("Montgomery ladder and Edwards scalar multiplication mismatch")
+	}
+}
+
+func BenchmarkX25519(b *testing.B) {
+	scalar := make([]byte, ScalarSize)
+	if _, err := rand.Read(scalar); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = X25519(scalar, Basepoint)
+	}
+}
@@ -0,0 +1,207 @@
+package ecc_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+func TestCurve(t *testing.T) {
+	t.Parallel()
+	for _, id := range ecc.Implemented() {
+		if id == ecc.STARK_CURVE {
+			continue
+		}
+		id := id
+		t.Run(id.String(), func(t *testing.T) {
+			t.Parallel()
+			c, err := id.Curve()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.ID() != id {
+				t.Fatal("wrong curve ID")
+			}
+			testCurveGroup(t, c, c.NewG1, c.G1Generator())
+			if c.G2Generator() != nil {
+				testCurveGroup(t, c, c.NewG2, c.G2Generator())
+				testCurvePairing(t, c)
+			} else if _, err := c.PairingCheck(nil, nil); err == nil {
+				t.Fatal("PairingCheck should fail without pairing")
+			}
+
+			p, err := c.HashToG1([]byte("message"), []byte("dst"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Curve() != id || p.IsInfinity() {
+				t.Fatal("wrong HashToG1 output")
+			}
+		})
+	}
+}
+
+func TestCurveUnavailable(t *testing.T) {
+	t.Parallel()
+	if c, err := ecc.STARK_CURVE.Curve(); err == nil || c != nil {
+		t.Fatal("Curve should fail for the stark-curve")
+	}
+	if _, err := ecc.UNKNOWN.Curve(); err == nil {
+		t.Fatal("Curve should fail for an unknown curve")
+	}
+}
+
+// mustCurve returns the facade of a curve, or fails the test
+func mustCurve(t *testing.T, id ecc.ID) ecc.Curve {
+	t.Helper()
+	c, err := id.Curve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCurveCalledTwice(t *testing.T) {
+	t.Parallel()
+	c1, c2 := mustCurve(t, ecc.BN254), mustCurve(t, ecc.BN254)
+
+	// handles from two calls can be combined
+	if !c1.G1Generator().Equal(c2.G1Generator()) || !c1.G2Generator().Equal(c2.G2Generator()) {
+		t.Fatal("the generators of two calls should be equal")
+	}
+	var expected ecc.Point = c1.NewG1().ScalarMul(c1.G1Generator(), c1.NewScalar().SetUint64(2))
+	res := c1.NewG1().Add(c1.G1Generator(), c2.G1Generator())
+	if !res.Equal(expected) {
+		t.Fatal("Add of handles from two calls is wrong")
+	}
+	res = c2.NewG1().ScalarMul(c1.G1Generator(), c2.NewScalar().SetUint64(2))
+	if !res.Equal(expected) {
+		t.Fatal("ScalarMul of handles from two calls is wrong")
+	}
+	if _, err := c2.NewG1().MultiExp([]ecc.Point{c1.G1Generator()}, []ecc.Scalar{c1.NewScalar().SetUint64(2)}, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := c2.PairingCheck([]ecc.Point{c1.NewG1()}, []ecc.Point{c1.G2Generator()}); err != nil || !ok {
+		t.Fatal("PairingCheck of handles from two calls should succeed")
+	}
+}
+
+func TestCurveMixing(t *testing.T) {
+	t.Parallel()
+	bn254, bls12381 := mustCurve(t, ecc.BN254), mustCurve(t, ecc.BLS12_381)
+
+	mustPanic := func(name string, f func()) {
+		t.Helper()
+		defer func() {
+			if recover() == nil {
+				t.Fatal(name + " should panic")
+			}
+		}()
+		f()
+	}
+	mustPanic("Add of G1 and G2", func() { bn254.NewG1().Add(bn254.G1Generator(), bn254.G2Generator()) })
+	mustPanic("Add of two curves", func() { bn254.NewG1().Add(bn254.G1Generator(), bls12381.G1Generator()) })
+	mustPanic("ScalarMul with a scalar of another curve", func() { bn254.NewG1().ScalarMul(bn254.G1Generator(), bls12381.NewScalar()) })
+
+	if bn254.G1Generator().Equal(bls12381.G1Generator()) {
+		t.Fatal("points of different curves can't be equal")
+	}
+	if _, err := bn254.NewG1().MultiExp([]ecc.Point{bn254.G2Generator()}, []ecc.Scalar{bn254.NewScalar()}, ecc.MultiExpConfig{}); err == nil {
+		t.Fatal("MultiExp should reject points of another group")
+	}
+	if _, err := bn254.PairingCheck([]ecc.Point{bn254.G2Generator()}, []ecc.Point{bn254.G1Generator()}); err == nil {
+		t.Fatal("PairingCheck should reject swapped groups")
+	}
+	if err := bn254.NewEncoder(new(bytes.Buffer)).Encode(bls12381.NewScalar()); err == nil {
+		t.Fatal("Encode should reject scalars of another curve")
+	}
+	if err := bn254.NewEncoder(new(bytes.Buffer)).Encode(big.NewInt(1)); err == nil {
+		t.Fatal("Encode should reject other types")
+	}
+}
+
+func testCurveGroup(t *testing.T, c ecc.Curve, newPoint func() ecc.Point, g ecc.Point) {
+	infinity := newPoint()
+	if !infinity.IsInfinity() || g.IsInfinity() || infinity.Equal(g) {
+		t.Fatal("wrong infinity or generator")
+	}
+
+	// [3]g = g + g + g
+	var expected, res ecc.Point = newPoint(), newPoint()
+	expected.Add(g, g).Add(expected, g)
+	res.ScalarMul(g, c.NewScalar().SetUint64(3))
+	if !res.Equal(expected) {
+		t.Fatal("ScalarMul doesn't match Add")
+	}
+
+	// MSM
+	const n = 5
+	points := make([]ecc.Point, n)
+	scalars := make([]ecc.Scalar, n)
+	expected = newPoint()
+	for i := 0; i < n; i++ {
+		s, err := c.NewScalar().SetRandom()
+		if err != nil {
+			t.Fatal(err)
+		}
+		scalars[i] = s
+		points[i] = newPoint().ScalarMul(g, c.NewScalar().SetBigInt(big.NewInt(int64(i+2))))
+		expected.Add(expected, newPoint().ScalarMul(points[i], scalars[i]))
+	}
+	res, err := newPoint().MultiExp(points, scalars, ecc.MultiExpConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Equal(expected) {
+		t.Fatal("MultiExp doesn't match the sum of ScalarMul")
+	}
+
+	// serialization
+	var buf bytes.Buffer
+	enc := c.NewEncoder(&buf)
+	for _, v := range []interface{}{res, scalars[0], infinity} {
+		if err := enc.Encode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if enc.BytesWritten() != int64(buf.Len()) {
+		t.Fatal("wrong number of bytes written")
+	}
+	dec := c.NewDecoder(&buf)
+	p, s, inf := newPoint(), c.NewScalar(), newPoint().Set(g)
+	for _, v := range []interface{}{p, s, inf} {
+		if err := dec.Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !p.Equal(res) || !s.Equal(scalars[0]) || !inf.IsInfinity() {
+		t.Fatal("Decode(Encode(v)) != v")
+	}
+	if dec.BytesRead() != enc.BytesWritten() {
+		t.Fatal("wrong number of bytes read")
+	}
+}
+
+func testCurvePairing(t *testing.T, c ecc.Curve) {
+	// e([a]g1, g2)⋅e([-a]g1, g2) == 1
+	a, err := c.NewScalar().SetRandom()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var minusA big.Int
+	a.BigInt(&minusA)
+	minusA.Neg(&minusA)
+
+	g1, g2 := c.G1Generator(), c.G2Generator()
+	P := []ecc.Point{c.NewG1().ScalarMul(g1, a), c.NewG1().ScalarMul(g1, c.NewScalar().SetBigInt(&minusA))}
+	Q := []ecc.Point{g2, g2}
+	if ok, err := c.PairingCheck(P, Q); err != nil || !ok {
+		t.Fatal("PairingCheck should succeed")
+	}
+	Q[1] = c.NewG2().Add(g2, g2)
+	if ok, err := c.PairingCheck(P, Q); err != nil || ok {
+		t.Fatal("PairingCheck should fail")
+	}
+}
@@ -0,0 +1,176 @@
+package ecc
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Field is the constraint satisfied by the field elements of this module (fr.Element and
+// fp.Element of every curve). E is the element type, the methods are those of *E.
+//
+// It enables curve-generic code, for example:
+//
+//	func Sum[E any, PE ecc.Field[E]](a []E) E {
+//		var res E
+//		for i := range a {
+//			PE(&res).Add(&res, &a[i])
+//		}
+//		return res
+//	}
+type Field[E any] interface {
+	*E
+	Set(*E) *E
+	SetZero() *E
+	SetOne() *E
+	SetUint64(uint64) *E
+	SetBigInt(*big.Int) *E
+	SetRandom() (*E, error)
+	SetBytesCanonical([]byte) error
+	Add(a, b *E) *E
+	Sub(a, b *E) *E
+	Mul(a, b *E) *E
+	Double(*E) *E
+	Square(*E) *E
+	Neg(*E) *E
+	Inverse(*E) *E
+	Exp(E, *big.Int) *E
+	Equal(*E) bool
+	IsZero() bool
+	IsOne() bool
+	BigInt(*big.Int) *big.Int
+	Marshal() []byte
+	String() string
+}
+
+// Group is the constraint satisfied by the points in affine coordinates of this module
+// (G1Affine and G2Affine of every curve with a MultiExp). P is the point type and S the type
+// of the scalars (fr.Element of the curve), the methods are those of *P.
+//
+// The zero value of P is the point at infinity.
+type Group[P, S any] interface {
+	*P
+	Set(*P) *P
+	Add(a, b *P) *P
+	Sub(a, b *P) *P
+	Double(*P) *P
+	Neg(*P) *P
+	ScalarMultiplication(*P, *big.Int) *P
+	ScalarMultiplicationBase(*big.Int) *P
+	MultiExp(points []P, scalars []S, config MultiExpConfig) (*P, error)
+	Equal(*P) bool
+	IsInfinity() bool
+	IsOnCurve() bool
+	IsInSubGroup() bool
+	Marshal() []byte
+	Unmarshal([]byte) error
+	SetBytes([]byte) (int, error)
+	String() string
+}
+
+// Jacobian is the constraint satisfied by the points in Jacobian coordinates of this module
+// (G1Jac and G2Jac of every curve). J is the point type and P the matching affine type,
+// the methods are those of *J.
+type Jacobian[J, P any] interface {
+	*J
+	Set(*J) *J
+	FromAffine(*P) *J
+	AddAssign(*J) *J
+	AddMixed(*P) *J
+	SubAssign(*J) *J
+	Double(*J) *J
+	DoubleAssign() *J
+	Neg(*J) *J
+	ScalarMultiplication(*J, *big.Int) *J
+	Equal(*J) bool
+	IsOnCurve() bool
+	IsInSubGroup() bool
+}
+
+// MultiExp returns ∑ᵢ [scalars[i]]points[i], see the MultiExp method of the point type.
+func MultiExp[P, S any, PP Group[P, S]](points []P, scalars []S, config MultiExpConfig) (P, error) {
+	var res P
+	_, err := PP(&res).MultiExp(points, scalars, config)
+	return res, err
+}
+
+// BatchNormalize converts points in Jacobian coordinates to affine coordinates,
+// performing a single field inversion (Montgomery batch inversion trick).
+//
+// The affine type can't be inferred and must be given: BatchNormalize[bn254.G1Affine](points).
+func BatchNormalize[P, J any, PP interface {
+	*P
+	BatchFromJacobian([]J) []P
+}](points []J) []P {
+	var p P
+	return PP(&p).BatchFromJacobian(points)
+}
+
+// BatchInvert returns a new slice with every element of a inverted.
+// It uses Montgomery batch inversion trick; zero elements are left unchanged.
+func BatchInvert[E any, PE Field[E]](a []E) []E {
+	res := make([]E, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E
+	PE(&accumulator).SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if PE(&a[i]).IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i] = accumulator
+		PE(&accumulator).Mul(&accumulator, &a[i])
+	}
+
+	PE(&accumulator).Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		PE(&res[i]).Mul(&res[i], &accumulator)
+		PE(&accumulator).Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// MarshalPoints returns the concatenation of the uncompressed encodings of points.
+func MarshalPoints[P any, PP interface {
+	*P
+	Marshal() []byte
+}](points []P) []byte {
+	var buf []byte
+	for i := range points {
+		buf = append(buf, PP(&points[i]).Marshal()...)
+	}
+	return buf
+}
+
+// UnmarshalPoints decodes a concatenation of point encodings, as output by MarshalPoints.
+// Each point is checked to be on the curve and in the correct subgroup.
+//
+// The point type can't be inferred and must be given: UnmarshalPoints[bn254.G1Affine](buf).
+func UnmarshalPoints[P any, PP interface {
+	*P
+	SetBytes([]byte) (int, error)
+}](buf []byte) ([]P, error) {
+	var points []P
+	for len(buf) != 0 {
+		var p P
+		n, err := PP(&p).SetBytes(buf)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, errors.New("invalid point encoding")
+		}
+		points = append(points, p)
+		buf = buf[n:]
+	}
+	return points, nil
+}
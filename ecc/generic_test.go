@@ -0,0 +1,217 @@
+package ecc_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	bls12377fp "github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	bls12377fr "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378"
+	bls12378fp "github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
+	bls12378fr "github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls12381fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	bls12381fr "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	bls24315fp "github.com/consensys/gnark-crypto/ecc/bls24-315/fp"
+	bls24315fr "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	bls24317fp "github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
+	bls24317fr "github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	bn254fp "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-633"
+	bw6633fp "github.com/consensys/gnark-crypto/ecc/bw6-633/fp"
+	bw6633fr "github.com/consensys/gnark-crypto/ecc/bw6-633/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-756"
+	bw6756fp "github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+	bw6756fr "github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+	"github.com/consensys/gnark-crypto/ecc/bw6-761"
+	bw6761fp "github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	bw6761fr "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	curve25519fp "github.com/consensys/gnark-crypto/ecc/curve25519/fp"
+	curve25519fr "github.com/consensys/gnark-crypto/ecc/curve25519/fr"
+	"github.com/consensys/gnark-crypto/ecc/grumpkin"
+	"github.com/consensys/gnark-crypto/ecc/p256"
+	p256fp "github.com/consensys/gnark-crypto/ecc/p256/fp"
+	p256fr "github.com/consensys/gnark-crypto/ecc/p256/fr"
+	"github.com/consensys/gnark-crypto/ecc/pallas"
+	pallasfp "github.com/consensys/gnark-crypto/ecc/pallas/fp"
+	pallasfr "github.com/consensys/gnark-crypto/ecc/pallas/fr"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	secp256k1fp "github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	secp256k1fr "github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/ecc/secq256k1"
+	starkcurvefp "github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+	starkcurvefr "github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
+	"github.com/consensys/gnark-crypto/ecc/vesta"
+)
+
+// assertGroup fails to compile if the points don't satisfy the constraints of package ecc
+func assertGroup[P, J, S any, PP ecc.Group[P, S], PJ ecc.Jacobian[J, P], _ interface {
+	*P
+	BatchFromJacobian([]J) []P
+}]() {
+}
+
+func assertField[E any, PE ecc.Field[E]]() {}
+
+func TestConstraints(t *testing.T) {
+	t.Parallel()
+	assertGroup[bn254.G1Affine, bn254.G1Jac, bn254fr.Element]()
+	assertGroup[bn254.G2Affine, bn254.G2Jac, bn254fr.Element]()
+	assertGroup[bls12377.G1Affine, bls12377.G1Jac, bls12377fr.Element]()
+	assertGroup[bls12377.G2Affine, bls12377.G2Jac, bls12377fr.Element]()
+	assertGroup[bls12378.G1Affine, bls12378.G1Jac, bls12378fr.Element]()
+	assertGroup[bls12378.G2Affine, bls12378.G2Jac, bls12378fr.Element]()
+	assertGroup[bls12381.G1Affine, bls12381.G1Jac, bls12381fr.Element]()
+	assertGroup[bls12381.G2Affine, bls12381.G2Jac, bls12381fr.Element]()
+	assertGroup[bls24315.G1Affine, bls24315.G1Jac, bls24315fr.Element]()
+	assertGroup[bls24315.G2Affine, bls24315.G2Jac, bls24315fr.Element]()
+	assertGroup[bls24317.G1Affine, bls24317.G1Jac, bls24317fr.Element]()
+	assertGroup[bls24317.G2Affine, bls24317.G2Jac, bls24317fr.Element]()
+	assertGroup[bw6633.G1Affine, bw6633.G1Jac, bw6633fr.Element]()
+	assertGroup[bw6633.G2Affine, bw6633.G2Jac, bw6633fr.Element]()
+	assertGroup[bw6756.G1Affine, bw6756.G1Jac, bw6756fr.Element]()
+	assertGroup[bw6756.G2Affine, bw6756.G2Jac, bw6756fr.Element]()
+	assertGroup[bw6761.G1Affine, bw6761.G1Jac, bw6761fr.Element]()
+	assertGroup[bw6761.G2Affine, bw6761.G2Jac, bw6761fr.Element]()
+	assertGroup[secp256k1.G1Affine, secp256k1.G1Jac, secp256k1fr.Element]()
+	assertGroup[grumpkin.G1Affine, grumpkin.G1Jac, bn254fp.Element]()
+	assertGroup[pallas.G1Affine, pallas.G1Jac, pallasfr.Element]()
+	assertGroup[vesta.G1Affine, vesta.G1Jac, pallasfp.Element]()
+	assertGroup[p256.G1Affine, p256.G1Jac, p256fr.Element]()
+	assertGroup[secq256k1.G1Affine, secq256k1.G1Jac, secp256k1fp.Element]()
+
+	assertField[bn254fp.Element]()
+	assertField[bn254fr.Element]()
+	assertField[bls12377fp.Element]()
+	assertField[bls12377fr.Element]()
+	assertField[bls12378fp.Element]()
+	assertField[bls12378fr.Element]()
+	assertField[bls12381fp.Element]()
+	assertField[bls12381fr.Element]()
+	assertField[bls24315fp.Element]()
+	assertField[bls24315fr.Element]()
+	assertField[bls24317fp.Element]()
+	assertField[bls24317fr.Element]()
+	assertField[bw6633fp.Element]()
+	assertField[bw6633fr.Element]()
+	assertField[bw6756fp.Element]()
+	assertField[bw6756fr.Element]()
+	assertField[bw6761fp.Element]()
+	assertField[bw6761fr.Element]()
+	assertField[secp256k1fp.Element]()
+	assertField[secp256k1fr.Element]()
+	assertField[pallasfp.Element]()
+	assertField[pallasfr.Element]()
+	assertField[p256fp.Element]()
+	assertField[p256fr.Element]()
+	assertField[starkcurvefp.Element]()
+	assertField[starkcurvefr.Element]()
+	assertField[curve25519fp.Element]()
+	assertField[curve25519fr.Element]()
+}
+
+func TestGeneric(t *testing.T) {
+	t.Parallel()
+	t.Run("bn254/G1", testGeneric[bn254.G1Affine, bn254.G1Jac, bn254fr.Element])
+	t.Run("bn254/G2", testGeneric[bn254.G2Affine, bn254.G2Jac, bn254fr.Element])
+	t.Run("bls24-315/G2", testGeneric[bls24315.G2Affine, bls24315.G2Jac, bls24315fr.Element])
+	t.Run("bw6-761/G2", testGeneric[bw6761.G2Affine, bw6761.G2Jac, bw6761fr.Element])
+	t.Run("secp256k1/G1", testGeneric[secp256k1.G1Affine, secp256k1.G1Jac, secp256k1fr.Element])
+	t.Run("grumpkin/G1", testGeneric[grumpkin.G1Affine, grumpkin.G1Jac, bn254fp.Element])
+}
+
+// testGeneric exercises the generic helpers of package ecc against the curve specific methods
+func testGeneric[P, J, S any, PP interface {
+	ecc.Group[P, S]
+	BatchFromJacobian([]J) []P
+}, PJ ecc.Jacobian[J, P], PS ecc.Field[S]](t *testing.T) {
+	const n = 17
+
+	points := make([]P, n)
+	scalars := make([]S, n)
+	jac := make([]J, n)
+	var expected P
+	for i := 0; i < n; i++ {
+		if _, err := PS(&scalars[i]).SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		PP(&points[i]).ScalarMultiplicationBase(big.NewInt(int64(i + 1)))
+
+		var term P
+		PP(&term).ScalarMultiplication(&points[i], PS(&scalars[i]).BigInt(new(big.Int)))
+		PP(&expected).Add(&expected, &term)
+
+		PJ(&jac[i]).FromAffine(&points[i])
+		PJ(&jac[i]).DoubleAssign()
+	}
+	// the point at infinity must be preserved by the conversions
+	var infinity J
+	PJ(&infinity).FromAffine(new(P))
+	jac = append(jac, infinity)
+
+	// MSM
+	res, err := ecc.MultiExp[P, S, PP](points, scalars, ecc.MultiExpConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !PP(&res).Equal(&expected) {
+		t.Fatal("MultiExp doesn't match the sum of scalar multiplications")
+	}
+
+	// batch normalization
+	affine := ecc.BatchNormalize[P, J, PP](jac)
+	if len(affine) != len(jac) {
+		t.Fatal("wrong number of points")
+	}
+	for i := 0; i < n; i++ {
+		var double P
+		PP(&double).Double(&points[i])
+		if !PP(&affine[i]).Equal(&double) {
+			t.Fatal("BatchNormalize doesn't match Double")
+		}
+	}
+	if !PP(&affine[n]).IsInfinity() {
+		t.Fatal("BatchNormalize should preserve the point at infinity")
+	}
+
+	// serialization
+	buf := ecc.MarshalPoints[P, PP](affine)
+	decoded, err := ecc.UnmarshalPoints[P, PP](buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != len(affine) {
+		t.Fatal("wrong number of decoded points")
+	}
+	for i := range decoded {
+		if !PP(&decoded[i]).Equal(&affine[i]) {
+			t.Fatal("UnmarshalPoints(MarshalPoints(points)) != points")
+		}
+	}
+	if !bytes.Equal(ecc.MarshalPoints[P, PP](decoded), buf) {
+		t.Fatal("encoding is not canonical")
+	}
+	if _, err := ecc.UnmarshalPoints[P, PP](buf[:len(buf)-1]); err == nil {
+		t.Fatal("truncated encoding should be rejected")
+	}
+
+	// batch inversion
+	inverses := ecc.BatchInvert[S, PS](append(scalars, *new(S)))
+	for i := 0; i < n; i++ {
+		var one S
+		PS(&one).Mul(&inverses[i], &scalars[i])
+		if !PS(&one).IsOne() {
+			t.Fatal("BatchInvert: a⋅a⁻¹ != 1")
+		}
+	}
+	if !PS(&inverses[n]).IsZero() {
+		t.Fatal("BatchInvert should leave zero unchanged")
+	}
+}
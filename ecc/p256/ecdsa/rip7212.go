@@ -0,0 +1,51 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+// RIP7212InputSize is the size of the input of the P256VERIFY precompile:
+// hash||r||s||x||y, each a 32-byte big endian integer.
+const RIP7212InputSize = sizeFr + sizeSignature + sizePublicKey
+
+// rip7212Success is the output of the precompile when the signature is valid
+var rip7212Success = [32]byte{31: 1}
+
+// Verify implements the P256VERIFY precompile of RIP-7212
+// (https://github.com/ethereum/RIPs/blob/master/RIPS/rip-7212.md).
+//
+// input is hash||r||s||x||y where hash is the signed message hash, (r, s) the
+// signature and (x, y) the public key. Verify returns the 32-byte big endian
+// encoding of 1 if the signature is valid, and an empty output otherwise; as
+// for the precompile, a malformed input (wrong size, r or s not in [1, n-1],
+// coordinates not in 𝔽p, public key not on the curve or at infinity) is not an
+// error but an invalid signature.
+func Verify(input []byte) []byte {
+	if len(input) != RIP7212InputSize {
+		return nil
+	}
+	var publicKey PublicKey
+	if _, err := publicKey.SetBytes(input[sizeFr+sizeSignature:]); err != nil {
+		return nil
+	}
+	if publicKey.A.IsInfinity() {
+		return nil
+	}
+	// the hash is used as is (hFunc == nil); Signature.SetBytes checks the range of r and s
+	valid, err := publicKey.Verify(input[sizeFr:sizeFr+sizeSignature], input[:sizeFr], nil)
+	if err != nil || !valid {
+		return nil
+	}
+	res := rip7212Success
+	return res[:]
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+import (
+	"bytes"
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/p256/fp"
+	"github.com/consensys/gnark-crypto/ecc/p256/fr"
+)
+
+// rip7212Input signs msg with the standard library and returns the corresponding precompile input
+func rip7212Input(t *testing.T, msg []byte) []byte {
+	t.Helper()
+	sk, err := stdecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.Sum256(msg)
+	r, s, err := stdecdsa.Sign(rand.Reader, sk, h[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := make([]byte, RIP7212InputSize)
+	copy(input, h[:])
+	r.FillBytes(input[32:64])
+	s.FillBytes(input[64:96])
+	sk.X.FillBytes(input[96:128])
+	sk.Y.FillBytes(input[128:160])
+	return input
+}
+
+func TestRIP7212(t *testing.T) {
+	success := make([]byte, 32)
+	success[31] = 1
+
+	input := rip7212Input(t, []byte("testing RIP-7212"))
+	if res := Verify(input); !bytes.Equal(res, success) {
+		t.Fatalf("valid signature rejected: %x", res)
+	}
+
+	invalid := map[string]func(in []byte){
+		"wrong hash":   func(in []byte) { in[0] ^= 1 },
+		"wrong r":      func(in []byte) { in[63] ^= 1 },
+		"wrong s":      func(in []byte) { in[95] ^= 1 },
+		"r = 0":        func(in []byte) { copy(in[32:64], make([]byte, 32)) },
+		"s = 0":        func(in []byte) { copy(in[64:96], make([]byte, 32)) },
+		"r = n":        func(in []byte) { fr.Modulus().FillBytes(in[32:64]) },
+		"s = n":        func(in []byte) { fr.Modulus().FillBytes(in[64:96]) },
+		"x = p":        func(in []byte) { fp.Modulus().FillBytes(in[96:128]) },
+		"not on curve": func(in []byte) { in[159] ^= 1 },
+		"infinity":     func(in []byte) { copy(in[96:], make([]byte, 64)) },
+	}
+	for name, tamper := range invalid {
+		in := append([]byte(nil), input...)
+		tamper(in)
+		if res := Verify(in); len(res) != 0 {
+			t.Errorf("%s: invalid input accepted", name)
+		}
+	}
+
+	if res := Verify(input[:RIP7212InputSize-1]); len(res) != 0 {
+		t.Error("short input accepted")
+	}
+	if res := Verify(append(append([]byte(nil), input...), 0)); len(res) != 0 {
+		t.Error("long input accepted")
+	}
+}
+
+func TestStdlibInterop(t *testing.T) {
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("testing ECDSA interop")
+	sig, err := privKey.Sign(msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkBin := privKey.PublicKey.Bytes()
+	pk := stdecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(pkBin[:32]),
+		Y:     new(big.Int).SetBytes(pkBin[32:]),
+	}
+	h := sha256.Sum256(msg)
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if !stdecdsa.Verify(&pk, h[:], r, s) {
+		t.Fatal("signature rejected by crypto/ecdsa")
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	v := make(Vector, 3)
+	v[0].SetUint64(2)
+	v[1].SetUint64(3)
+	v[2].SetUint64(1)
+
+	sort.Sort(v)
+
+	assert.Equal("[1,2,3]", v.String())
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 3)
+	v1[0].SetUint64(2)
+	v1[1].SetUint64(3)
+	v1[2].SetUint64(1)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2, v3 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	err = v3.unmarshalBinaryAsync(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+	assert.True(reflect.DeepEqual(v3, v2))
+}
+
+func TestVectorEmptyRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 0)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2, v3 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	err = v3.unmarshalBinaryAsync(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+	assert.True(reflect.DeepEqual(v3, v2))
+}
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{0, 1, 7, 1<<13 + 3} {
+		a, b, c := make(Vector, size), make(Vector, size), make(Vector, size)
+		for i := 0; i < size; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+		if size > 2 {
+			// q - 1 and 0, for the carries and the reductions
+			a[0].SetOne().Neg(&a[0])
+			b[0].Set(&a[0])
+			b[1].Set(&a[0])
+			a[2].SetZero()
+		}
+		var s Element
+		s.SetRandom()
+		k := big.NewInt(-5)
+
+		var expected, tmp Element
+
+		c.Add(a, b)
+		for i := 0; i < size; i++ {
+			expected.Add(&a[i], &b[i])
+			assert.True(c[i].Equal(&expected), "Add")
+		}
+
+		c.Sub(a, b)
+		for i := 0; i < size; i++ {
+			expected.Sub(&a[i], &b[i])
+			assert.True(c[i].Equal(&expected), "Sub")
+		}
+
+		c.Mul(a, b)
+		for i := 0; i < size; i++ {
+			expected.Mul(&a[i], &b[i])
+			assert.True(c[i].Equal(&expected), "Mul")
+		}
+
+		c.ScalarMul(a, &s)
+		for i := 0; i < size; i++ {
+			expected.Mul(&a[i], &s)
+			assert.True(c[i].Equal(&expected), "ScalarMul")
+		}
+
+		c.Exp(a, k)
+		for i := 0; i < size; i++ {
+			expected.Exp(a[i], k)
+			assert.True(c[i].Equal(&expected), "Exp")
+		}
+
+		expected.SetZero()
+		for i := 0; i < size; i++ {
+			expected.Add(&expected, &a[i])
+		}
+		sum := a.Sum()
+		assert.True(sum.Equal(&expected), "Sum")
+
+		expected.SetZero()
+		for i := 0; i < size; i++ {
+			tmp.Mul(&a[i], &b[i])
+			expected.Add(&expected, &tmp)
+		}
+		ip := a.InnerProduct(b)
+		assert.True(ip.Equal(&expected), "InnerProduct")
+
+		// in place operations
+		c = make(Vector, size)
+		copy(c, a)
+		c.Add(c, b)
+		c.Sub(c, b)
+		assert.True(reflect.DeepEqual(a, c), "in place Add / Sub")
+	}
+}
+
+func TestVectorOpsPanic(t *testing.T) {
+	assert := require.New(t)
+
+	a, b := make(Vector, 3), make(Vector, 4)
+	var s Element
+
+	assert.Panics(func() { a.Add(a, b) })
+	assert.Panics(func() { a.Sub(a, b) })
+	assert.Panics(func() { a.Mul(a, b) })
+	assert.Panics(func() { b.ScalarMul(a, &s) })
+	assert.Panics(func() { b.Exp(a, big.NewInt(2)) })
+	assert.Panics(func() { a.InnerProduct(b) })
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const N = 1 << 20
+	a1, a2, a3 := make(Vector, N), make(Vector, N), make(Vector, N)
+	for i := 0; i < N; i++ {
+		a1[i].SetRandom()
+		a2[i].SetRandom()
+	}
+	var s Element
+	s.SetRandom()
+
+	b.Run("Add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a3.Add(a1, a2)
+		}
+	})
+	b.Run("Sub", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a3.Sub(a1, a2)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a3.Mul(a1, a2)
+		}
+	})
+	b.Run("ScalarMul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a3.ScalarMul(a1, &s)
+		}
+	})
+	b.Run("Sum", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = a1.Sum()
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = a1.InnerProduct(a2)
+		}
+	})
+}
+
+func (vector *Vector) unmarshalBinaryAsync(data []byte) error {
+	r := bytes.NewReader(data)
+	_, err, chErr := vector.AsyncReadFrom(r)
+	if err != nil {
+		return err
+	}
+	return <-chErr
+}
@@ -0,0 +1,1120 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0x992d30ed00000001
+DATA q<>+8(SB)/8, $0x224698fc094cf91b
+DATA q<>+16(SB)/8, $0
+DATA q<>+24(SB)/8, $0x4000000000000000
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0x992d30ecffffffff
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, rb0, rb1, rb2, rb3) \
+	MOVQ    ra0, rb0;        \
+	SUBQ    q<>(SB), ra0;    \
+	MOVQ    ra1, rb1;        \
+	SBBQ    q<>+8(SB), ra1;  \
+	MOVQ    ra2, rb2;        \
+	SBBQ    q<>+16(SB), ra2; \
+	MOVQ    ra3, rb3;        \
+	SBBQ    q<>+24(SB), ra3; \
+	CMOVQCS rb0, ra0;        \
+	CMOVQCS rb1, ra1;        \
+	CMOVQCS rb2, ra2;        \
+	CMOVQCS rb3, ra3;        \
+
+TEXT ·reduce(SB), NOSPLIT, $0-8
+	MOVQ res+0(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	RET
+
+// MulBy3(x *Element)
+TEXT ·MulBy3(SB), NOSPLIT, $0-8
+	MOVQ x+0(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	ADDQ DX, DX
+	ADCQ CX, CX
+	ADCQ BX, BX
+	ADCQ SI, SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	ADDQ 0(AX), DX
+	ADCQ 8(AX), CX
+	ADCQ 16(AX), BX
+	ADCQ 24(AX), SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (R11,R12,R13,R14)
+	REDUCE(DX,CX,BX,SI,R11,R12,R13,R14)
+
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	RET
+
+// MulBy5(x *Element)
+TEXT ·MulBy5(SB), NOSPLIT, $0-8
+	MOVQ x+0(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	ADDQ DX, DX
+	ADCQ CX, CX
+	ADCQ BX, BX
+	ADCQ SI, SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	ADDQ DX, DX
+	ADCQ CX, CX
+	ADCQ BX, BX
+	ADCQ SI, SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (R11,R12,R13,R14)
+	REDUCE(DX,CX,BX,SI,R11,R12,R13,R14)
+
+	ADDQ 0(AX), DX
+	ADCQ 8(AX), CX
+	ADCQ 16(AX), BX
+	ADCQ 24(AX), SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (R15,DI,R8,R9)
+	REDUCE(DX,CX,BX,SI,R15,DI,R8,R9)
+
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	RET
+
+// MulBy13(x *Element)
+TEXT ·MulBy13(SB), NOSPLIT, $0-8
+	MOVQ x+0(FP), AX
+	MOVQ 0(AX), DX
+	MOVQ 8(AX), CX
+	MOVQ 16(AX), BX
+	MOVQ 24(AX), SI
+	ADDQ DX, DX
+	ADCQ CX, CX
+	ADCQ BX, BX
+	ADCQ SI, SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	ADDQ DX, DX
+	ADCQ CX, CX
+	ADCQ BX, BX
+	ADCQ SI, SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (R11,R12,R13,R14)
+	REDUCE(DX,CX,BX,SI,R11,R12,R13,R14)
+
+	MOVQ DX, R11
+	MOVQ CX, R12
+	MOVQ BX, R13
+	MOVQ SI, R14
+	ADDQ DX, DX
+	ADCQ CX, CX
+	ADCQ BX, BX
+	ADCQ SI, SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	ADDQ R11, DX
+	ADCQ R12, CX
+	ADCQ R13, BX
+	ADCQ R14, SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	ADDQ 0(AX), DX
+	ADCQ 8(AX), CX
+	ADCQ 16(AX), BX
+	ADCQ 24(AX), SI
+
+	// reduce element(DX,CX,BX,SI) using temp registers (DI,R8,R9,R10)
+	REDUCE(DX,CX,BX,SI,DI,R8,R9,R10)
+
+	MOVQ DX, 0(AX)
+	MOVQ CX, 8(AX)
+	MOVQ BX, 16(AX)
+	MOVQ SI, 24(AX)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVQ    a+0(FP), AX
+	MOVQ    0(AX), CX
+	MOVQ    8(AX), BX
+	MOVQ    16(AX), SI
+	MOVQ    24(AX), DI
+	MOVQ    CX, R8
+	MOVQ    BX, R9
+	MOVQ    SI, R10
+	MOVQ    DI, R11
+	XORQ    AX, AX
+	MOVQ    b+8(FP), DX
+	ADDQ    0(DX), CX
+	ADCQ    8(DX), BX
+	ADCQ    16(DX), SI
+	ADCQ    24(DX), DI
+	SUBQ    0(DX), R8
+	SBBQ    8(DX), R9
+	SBBQ    16(DX), R10
+	SBBQ    24(DX), R11
+	MOVQ    $0x992d30ed00000001, R12
+	MOVQ    $0x224698fc094cf91b, R13
+	MOVQ    $0, R14
+	MOVQ    $0x4000000000000000, R15
+	CMOVQCC AX, R12
+	CMOVQCC AX, R13
+	CMOVQCC AX, R14
+	CMOVQCC AX, R15
+	ADDQ    R12, R8
+	ADCQ    R13, R9
+	ADCQ    R14, R10
+	ADCQ    R15, R11
+	MOVQ    R8, 0(DX)
+	MOVQ    R9, 8(DX)
+	MOVQ    R10, 16(DX)
+	MOVQ    R11, 24(DX)
+
+	// reduce element(CX,BX,SI,DI) using temp registers (R8,R9,R10,R11)
+	REDUCE(CX,BX,SI,DI,R8,R9,R10,R11)
+
+	MOVQ a+0(FP), AX
+	MOVQ CX, 0(AX)
+	MOVQ BX, 8(AX)
+	MOVQ SI, 16(AX)
+	MOVQ DI, 24(AX)
+	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l1:
+	MOVQ    0(DX), SI
+	MOVQ    8(DX), DI
+	MOVQ    16(DX), R8
+	MOVQ    24(DX), R9
+	ADDQ    0(CX), SI
+	ADCQ    8(CX), DI
+	ADCQ    16(CX), R8
+	ADCQ    24(CX), R9
+	MOVQ    SI, 0(AX)
+	MOVQ    DI, 8(AX)
+	MOVQ    R8, 16(AX)
+	MOVQ    R9, 24(AX)
+	SUBQ    q<>+0(SB), SI
+	SBBQ    q<>+8(SB), DI
+	SBBQ    q<>+16(SB), R8
+	SBBQ    q<>+24(SB), R9
+	CMOVQCS 0(AX), SI
+	CMOVQCS 8(AX), DI
+	CMOVQCS 16(AX), R8
+	CMOVQCS 24(AX), R9
+	MOVQ    SI, 0(AX)
+	MOVQ    DI, 8(AX)
+	MOVQ    R8, 16(AX)
+	MOVQ    R9, 24(AX)
+	DECQ    BX
+	JEQ     l2
+	ADDQ    $0x0000000000000020, DX
+	ADDQ    $0x0000000000000020, CX
+	ADDQ    $0x0000000000000020, AX
+	JMP     l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), AX
+	MOVQ a+8(FP), DX
+	MOVQ b+16(FP), CX
+	MOVQ n+24(FP), BX
+
+l3:
+	MOVQ    0(DX), DI
+	MOVQ    8(DX), R8
+	MOVQ    16(DX), R9
+	MOVQ    24(DX), R10
+	SUBQ    0(CX), DI
+	SBBQ    8(CX), R8
+	SBBQ    16(CX), R9
+	SBBQ    24(CX), R10
+	SBBQ    SI, SI
+	MOVQ    DI, 0(AX)
+	MOVQ    R8, 8(AX)
+	MOVQ    R9, 16(AX)
+	MOVQ    R10, 24(AX)
+	ADDQ    q<>+0(SB), DI
+	ADCQ    q<>+8(SB), R8
+	ADCQ    q<>+16(SB), R9
+	ADCQ    q<>+24(SB), R10
+	TESTQ   SI, SI
+	CMOVQEQ 0(AX), DI
+	CMOVQEQ 8(AX), R8
+	CMOVQEQ 16(AX), R9
+	CMOVQEQ 24(AX), R10
+	MOVQ    DI, 0(AX)
+	MOVQ    R8, 8(AX)
+	MOVQ    R9, 16(AX)
+	MOVQ    R10, 24(AX)
+	DECQ    BX
+	JEQ     l4
+	ADDQ    $0x0000000000000020, DX
+	ADDQ    $0x0000000000000020, CX
+	ADDQ    $0x0000000000000020, AX
+	JMP     l3
+
+l4:
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+// requires the ADX and BMI2 instructions
+TEXT ·mulVec(SB), $8-32
+	NO_LOCAL_POINTERS
+	MOVQ a+8(FP), R14
+	MOVQ b+16(FP), R13
+
+l5:
+	// A -> BP
+	// t[0] -> CX
+	// t[1] -> BX
+	// t[2] -> SI
+	// t[3] -> DI
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R13), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R14), CX, BX
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R14), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R14), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// reduce element(CX,BX,SI,DI) using temp registers (R9,R10,R11,R12)
+	REDUCE(CX,BX,SI,DI,R9,R10,R11,R12)
+
+	MOVQ res+0(FP), AX
+	MOVQ CX, 0(AX)
+	MOVQ BX, 8(AX)
+	MOVQ SI, 16(AX)
+	MOVQ DI, 24(AX)
+	DECQ n+24(FP)
+	JEQ  l6
+	ADDQ $0x0000000000000020, R14
+	ADDQ $0x0000000000000020, R13
+	ADDQ $0x0000000000000020, res+0(FP)
+	JMP  l5
+
+l6:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+// requires the ADX and BMI2 instructions
+TEXT ·scalarMulVec(SB), $8-32
+	NO_LOCAL_POINTERS
+	MOVQ a+8(FP), R14
+	MOVQ b+16(FP), R13
+
+l7:
+	// A -> BP
+	// t[0] -> CX
+	// t[1] -> BX
+	// t[2] -> SI
+	// t[3] -> DI
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R13), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R14), CX, BX
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R14), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R14), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// reduce element(CX,BX,SI,DI) using temp registers (R9,R10,R11,R12)
+	REDUCE(CX,BX,SI,DI,R9,R10,R11,R12)
+
+	MOVQ res+0(FP), AX
+	MOVQ CX, 0(AX)
+	MOVQ BX, 8(AX)
+	MOVQ SI, 16(AX)
+	MOVQ DI, 24(AX)
+	DECQ n+24(FP)
+	JEQ  l8
+	ADDQ $0x0000000000000020, R14
+	ADDQ $0x0000000000000020, res+0(FP)
+	JMP  l7
+
+l8:
+	RET
+
+// innerProdVec(res, a, b *Element, n uint64) res = res + a[0]*b[0] + ... + a[n-1]*b[n-1]
+// requires the ADX and BMI2 instructions
+TEXT ·innerProdVec(SB), $8-32
+	NO_LOCAL_POINTERS
+	MOVQ a+8(FP), R14
+	MOVQ b+16(FP), R13
+
+l9:
+	// A -> BP
+	// t[0] -> CX
+	// t[1] -> BX
+	// t[2] -> SI
+	// t[3] -> DI
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R13), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R14), CX, BX
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R14), AX, SI
+	ADOXQ AX, BX
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R14), AX, DI
+	ADOXQ AX, SI
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R13), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R14), AX, BP
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ BP, BX
+	MULXQ 8(R14), AX, BP
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ BP, SI
+	MULXQ 16(R14), AX, BP
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ BP, DI
+	MULXQ 24(R14), AX, BP
+	ADOXQ AX, DI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, BP
+	ADOXQ AX, BP
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// clear the flags
+	XORQ AX, AX
+
+	// C,_ := t[0] + m*q[0]
+	MULXQ q<>+0(SB), AX, R8
+	ADCXQ CX, AX
+	MOVQ  R8, CX
+
+	// (C,t[0]) := t[1] + m*q[1] + C
+	ADCXQ BX, CX
+	MULXQ q<>+8(SB), AX, BX
+	ADOXQ AX, CX
+
+	// (C,t[1]) := t[2] + m*q[2] + C
+	ADCXQ SI, BX
+	MULXQ q<>+16(SB), AX, SI
+	ADOXQ AX, BX
+
+	// (C,t[2]) := t[3] + m*q[3] + C
+	ADCXQ DI, SI
+	MULXQ q<>+24(SB), AX, DI
+	ADOXQ AX, SI
+
+	// t[3] = C + A
+	MOVQ  $0, AX
+	ADCXQ AX, DI
+	ADOXQ BP, DI
+
+	// reduce element(CX,BX,SI,DI) using temp registers (R9,R10,R11,R12)
+	REDUCE(CX,BX,SI,DI,R9,R10,R11,R12)
+
+	MOVQ res+0(FP), AX
+	ADDQ 0(AX), CX
+	ADCQ 8(AX), BX
+	ADCQ 16(AX), SI
+	ADCQ 24(AX), DI
+
+	// reduce element(CX,BX,SI,DI) using temp registers (R8,R9,R10,R11)
+	REDUCE(CX,BX,SI,DI,R8,R9,R10,R11)
+
+	MOVQ CX, 0(AX)
+	MOVQ BX, 8(AX)
+	MOVQ SI, 16(AX)
+	MOVQ DI, 24(AX)
+	DECQ n+24(FP)
+	JEQ  l10
+	ADDQ $0x0000000000000020, R14
+	ADDQ $0x0000000000000020, R13
+	JMP  l9
+
+l10:
+	RET
@@ -0,0 +1,292 @@
+// +build !purego
+
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "textflag.h"
+#include "funcdata.h"
+
+// modulus q
+DATA q<>+0(SB)/8, $0xfffffffefffffc2f
+DATA q<>+8(SB)/8, $0xffffffffffffffff
+DATA q<>+16(SB)/8, $0xffffffffffffffff
+DATA q<>+24(SB)/8, $0xffffffffffffffff
+GLOBL q<>(SB), (RODATA+NOPTR), $32
+
+// qInv0 q'[0]
+DATA qInv0<>(SB)/8, $0xd838091dd2253531
+GLOBL qInv0<>(SB), (RODATA+NOPTR), $8
+
+#define REDUCE(ra0, ra1, ra2, ra3, rb0, rb1, rb2, rb3) \
+	MOVQ    ra0, rb0;        \
+	SUBQ    q<>(SB), ra0;    \
+	MOVQ    ra1, rb1;        \
+	SBBQ    q<>+8(SB), ra1;  \
+	MOVQ    ra2, rb2;        \
+	SBBQ    q<>+16(SB), ra2; \
+	MOVQ    ra3, rb3;        \
+	SBBQ    q<>+24(SB), ra3; \
+	CMOVQCS rb0, ra0;        \
+	CMOVQCS rb1, ra1;        \
+	CMOVQCS rb2, ra2;        \
+	CMOVQCS rb3, ra3;        \
+
+DATA c<>(SB)/8, $0x00000001000003d1
+GLOBL c<>(SB), (RODATA+NOPTR), $8
+
+// mul(res, x, y *Element)
+TEXT ·mul(SB), $24-24
+
+	// the algorithm is described in the Element.Mul declaration (.go)
+	// q = 2^256 - c, and since q = -c mod W, in each step
+	// (t + m*q) / W = t/W - hi(m*c) + m*2^192
+	// for i=0 to N-1
+	// 		for j=0 to N-1
+	// 		    (A,t[j])  := t[j] + x[j]*y[i] + A
+	// 		(t[N],A) := A + t[N]
+	// 		m := t[0]*q'[0] mod W
+	// 		hi, _ := m*c
+	// 		t := (t[1], ..., t[N-1], A, t[N]) - hi + m*2^192
+
+	NO_LOCAL_POINTERS
+	CMPB ·supportAdx(SB), $1
+	JNE  l1
+	MOVQ y+16(FP), R9
+	MOVQ x+8(FP), R10
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 0(R9), DX
+
+	// (A,t[0])  := x[0]*y[0] + A
+	MULXQ 0(R10), R14, R13
+
+	// (A,t[1])  := x[1]*y[0] + A
+	MULXQ 8(R10), AX, CX
+	ADOXQ AX, R13
+
+	// (A,t[2])  := x[2]*y[0] + A
+	MULXQ 16(R10), AX, BX
+	ADOXQ AX, CX
+
+	// (A,t[3])  := x[3]*y[0] + A
+	MULXQ 24(R10), AX, SI
+	ADOXQ AX, BX
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADOXQ AX, SI
+	MOVQ  $0, DI
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R14, DX
+
+	// hi, _ := m*c, the low word is t[0]
+	MULXQ c<>(SB), AX, R8
+
+	// t := t - hi
+	SUBQ R8, R13
+	SBBQ $0, CX
+	SBBQ $0, BX
+	SBBQ $0, SI
+	SBBQ $0, DI
+
+	// t := t + m*2^192
+	ADDQ DX, SI
+	ADCQ $0, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 8(R9), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[1] + A
+	MULXQ 0(R10), AX, R14
+	ADOXQ AX, R13
+
+	// (A,t[1])  := t[1] + x[1]*y[1] + A
+	ADCXQ R14, CX
+	MULXQ 8(R10), AX, R14
+	ADOXQ AX, CX
+
+	// (A,t[2])  := t[2] + x[2]*y[1] + A
+	ADCXQ R14, BX
+	MULXQ 16(R10), AX, R14
+	ADOXQ AX, BX
+
+	// (A,t[3])  := t[3] + x[3]*y[1] + A
+	ADCXQ R14, SI
+	MULXQ 24(R10), AX, R14
+	ADOXQ AX, SI
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, R14
+	ADOXQ AX, R14
+
+	// (t[4],A) := A + t[4]
+	ADDQ DI, R14
+	MOVQ $0, DI
+	ADCQ $0, DI
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ R13, DX
+
+	// hi, _ := m*c, the low word is t[0]
+	MULXQ c<>(SB), AX, R8
+
+	// t := t - hi
+	SUBQ R8, CX
+	SBBQ $0, BX
+	SBBQ $0, SI
+	SBBQ $0, R14
+	SBBQ $0, DI
+
+	// t := t + m*2^192
+	ADDQ DX, R14
+	ADCQ $0, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 16(R9), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[2] + A
+	MULXQ 0(R10), AX, R13
+	ADOXQ AX, CX
+
+	// (A,t[1])  := t[1] + x[1]*y[2] + A
+	ADCXQ R13, BX
+	MULXQ 8(R10), AX, R13
+	ADOXQ AX, BX
+
+	// (A,t[2])  := t[2] + x[2]*y[2] + A
+	ADCXQ R13, SI
+	MULXQ 16(R10), AX, R13
+	ADOXQ AX, SI
+
+	// (A,t[3])  := t[3] + x[3]*y[2] + A
+	ADCXQ R13, R14
+	MULXQ 24(R10), AX, R13
+	ADOXQ AX, R14
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, R13
+	ADOXQ AX, R13
+
+	// (t[4],A) := A + t[4]
+	ADDQ DI, R13
+	MOVQ $0, DI
+	ADCQ $0, DI
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ CX, DX
+
+	// hi, _ := m*c, the low word is t[0]
+	MULXQ c<>(SB), AX, R8
+
+	// t := t - hi
+	SUBQ R8, BX
+	SBBQ $0, SI
+	SBBQ $0, R14
+	SBBQ $0, R13
+	SBBQ $0, DI
+
+	// t := t + m*2^192
+	ADDQ DX, R13
+	ADCQ $0, DI
+
+	// clear the flags
+	XORQ AX, AX
+	MOVQ 24(R9), DX
+
+	// (A,t[0])  := t[0] + x[0]*y[3] + A
+	MULXQ 0(R10), AX, CX
+	ADOXQ AX, BX
+
+	// (A,t[1])  := t[1] + x[1]*y[3] + A
+	ADCXQ CX, SI
+	MULXQ 8(R10), AX, CX
+	ADOXQ AX, SI
+
+	// (A,t[2])  := t[2] + x[2]*y[3] + A
+	ADCXQ CX, R14
+	MULXQ 16(R10), AX, CX
+	ADOXQ AX, R14
+
+	// (A,t[3])  := t[3] + x[3]*y[3] + A
+	ADCXQ CX, R13
+	MULXQ 24(R10), AX, CX
+	ADOXQ AX, R13
+
+	// A += carries from ADCXQ and ADOXQ
+	MOVQ  $0, AX
+	ADCXQ AX, CX
+	ADOXQ AX, CX
+
+	// (t[4],A) := A + t[4]
+	ADDQ DI, CX
+	MOVQ $0, DI
+	ADCQ $0, DI
+
+	// m := t[0]*q'[0] mod W
+	MOVQ  qInv0<>(SB), DX
+	IMULQ BX, DX
+
+	// hi, _ := m*c, the low word is t[0]
+	MULXQ c<>(SB), AX, R8
+
+	// t := t - hi
+	SUBQ R8, SI
+	SBBQ $0, R14
+	SBBQ $0, R13
+	SBBQ $0, CX
+	SBBQ $0, DI
+
+	// t := t + m*2^192
+	ADDQ DX, CX
+	ADCQ $0, DI
+
+	// t < 2q on 5 words, reduce it
+	MOVQ    SI, R11
+	SUBQ    q<>+0(SB), SI
+	MOVQ    R14, R12
+	SBBQ    q<>+8(SB), R14
+	MOVQ    R13, BX
+	SBBQ    q<>+16(SB), R13
+	MOVQ    CX, R8
+	SBBQ    q<>+24(SB), CX
+	SBBQ    $0, DI
+	CMOVQCS R11, SI
+	CMOVQCS R12, R14
+	CMOVQCS BX, R13
+	CMOVQCS R8, CX
+	MOVQ    res+0(FP), AX
+	MOVQ    SI, 0(AX)
+	MOVQ    R14, 8(AX)
+	MOVQ    R13, 16(AX)
+	MOVQ    CX, 24(AX)
+	RET
+
+l1:
+	MOVQ res+0(FP), AX
+	MOVQ AX, (SP)
+	MOVQ x+8(FP), AX
+	MOVQ AX, 8(SP)
+	MOVQ y+16(FP), AX
+	MOVQ AX, 16(SP)
+	CALL ·_mulGeneric(SB)
+	RET
@@ -16,8 +16,6 @@
 
 package fp
 
-import "math/bits"
-
 // MulBy3 x *= 3 (mod q)
 func MulBy3(x *Element) {
 	_x := *x
@@ -56,275 +54,3 @@ func fromMont(z *Element) {
 func reduce(z *Element) {
 	_reduceGeneric(z)
 }
-
-// Mul z = x * y (mod q)
-func (z *Element) Mul(x, y *Element) *Element {
-
-	// Implements CIOS multiplication -- section 2.3.2 of Tolga Acar's thesis
-	// https://www.microsoft.com/en-us/research/wp-content/uploads/1998/06/97Acar.pdf
-	//
-	// The algorithm:
-	//
-	// for i=0 to N-1
-	// 		C := 0
-	// 		for j=0 to N-1
-	// 			(C,t[j]) := t[j] + x[j]*y[i] + C
-	// 		(t[N+1],t[N]) := t[N] + C
-	//
-	// 		C := 0
-	// 		m := t[0]*q'[0] mod D
-	// 		(C,_) := t[0] + m*q[0]
-	// 		for j=1 to N-1
-	// 			(C,t[j-1]) := t[j] + m*q[j] + C
-	//
-	// 		(C,t[N-1]) := t[N] + C
-	// 		t[N] := t[N+1] + C
-	//
-	// → N is the number of machine words needed to store the modulus q
-	// → D is the word size. For example, on a 64-bit architecture D is 2	64
-	// → x[i], y[i], q[i] is the ith word of the numbers x,y,q
-	// → q'[0] is the lowest word of the number -q⁻¹ mod r. This quantity is pre-computed, as it does not depend on the inputs.
-	// → t is a temporary array of size N+2
-	// → C, S are machine words. A pair (C,S) refers to (hi-bits, lo-bits) of a two-word number
-
-	var t [5]uint64
-	var D uint64
-	var m, C uint64
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = bits.Mul64(y[0], x[0])
-	C, t[1] = madd1(y[0], x[1], C)
-	C, t[2] = madd1(y[0], x[2], C)
-	C, t[3] = madd1(y[0], x[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = madd1(y[1], x[0], t[0])
-	C, t[1] = madd2(y[1], x[1], t[1], C)
-	C, t[2] = madd2(y[1], x[2], t[2], C)
-	C, t[3] = madd2(y[1], x[3], t[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = madd1(y[2], x[0], t[0])
-	C, t[1] = madd2(y[2], x[1], t[1], C)
-	C, t[2] = madd2(y[2], x[2], t[2], C)
-	C, t[3] = madd2(y[2], x[3], t[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = madd1(y[3], x[0], t[0])
-	C, t[1] = madd2(y[3], x[1], t[1], C)
-	C, t[2] = madd2(y[3], x[2], t[2], C)
-	C, t[3] = madd2(y[3], x[3], t[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-
-	if t[4] != 0 {
-		// we need to reduce, we have a result on 5 words
-		var b uint64
-		z[0], b = bits.Sub64(t[0], q0, 0)
-		z[1], b = bits.Sub64(t[1], q1, b)
-		z[2], b = bits.Sub64(t[2], q2, b)
-		z[3], _ = bits.Sub64(t[3], q3, b)
-		return z
-	}
-
-	// copy t into z
-	z[0] = t[0]
-	z[1] = t[1]
-	z[2] = t[2]
-	z[3] = t[3]
-
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], _ = bits.Sub64(z[3], q3, b)
-	}
-	return z
-}
-
-// Square z = x * x (mod q)
-func (z *Element) Square(x *Element) *Element {
-	// see Mul for algorithm documentation
-
-	var t [5]uint64
-	var D uint64
-	var m, C uint64
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = bits.Mul64(x[0], x[0])
-	C, t[1] = madd1(x[0], x[1], C)
-	C, t[2] = madd1(x[0], x[2], C)
-	C, t[3] = madd1(x[0], x[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = madd1(x[1], x[0], t[0])
-	C, t[1] = madd2(x[1], x[1], t[1], C)
-	C, t[2] = madd2(x[1], x[2], t[2], C)
-	C, t[3] = madd2(x[1], x[3], t[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = madd1(x[2], x[0], t[0])
-	C, t[1] = madd2(x[2], x[1], t[1], C)
-	C, t[2] = madd2(x[2], x[2], t[2], C)
-	C, t[3] = madd2(x[2], x[3], t[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-	// -----------------------------------
-	// First loop
-
-	C, t[0] = madd1(x[3], x[0], t[0])
-	C, t[1] = madd2(x[3], x[1], t[1], C)
-	C, t[2] = madd2(x[3], x[2], t[2], C)
-	C, t[3] = madd2(x[3], x[3], t[3], C)
-
-	t[4], D = bits.Add64(t[4], C, 0)
-
-	// m = t[0]n'[0] mod W
-	m = t[0] * qInvNeg
-
-	// -----------------------------------
-	// Second loop
-	C = madd0(m, q0, t[0])
-	C, t[0] = madd2(m, q1, t[1], C)
-	C, t[1] = madd2(m, q2, t[2], C)
-	C, t[2] = madd2(m, q3, t[3], C)
-
-	t[3], C = bits.Add64(t[4], C, 0)
-	t[4], _ = bits.Add64(0, D, C)
-
-	if t[4] != 0 {
-		// we need to reduce, we have a result on 5 words
-		var b uint64
-		z[0], b = bits.Sub64(t[0], q0, 0)
-		z[1], b = bits.Sub64(t[1], q1, b)
-		z[2], b = bits.Sub64(t[2], q2, b)
-		z[3], _ = bits.Sub64(t[3], q3, b)
-		return z
-	}
-
-	// copy t into z
-	z[0] = t[0]
-	z[1] = t[1]
-	z[2] = t[2]
-	z[3] = t[3]
-
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], _ = bits.Sub64(z[3], q3, b)
-	}
-	return z
-}
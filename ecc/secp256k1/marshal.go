@@ -29,6 +29,18 @@ const SizeOfG1AffineCompressed = 32
 // SizeOfG1AffineUncompressed represents the size in bytes that a G1Affine need in binary form, uncompressed
 const SizeOfG1AffineUncompressed = SizeOfG1AffineCompressed * 2
 
+// Marshal converts p to a byte slice (without point compression)
+func (p *G1Affine) Marshal() []byte {
+	b := p.RawBytes()
+	return b[:]
+}
+
+// Unmarshal is an alias to SetBytes()
+func (p *G1Affine) Unmarshal(buf []byte) error {
+	_, err := p.SetBytes(buf)
+	return err
+}
+
 // RawBytes returns binary representation of p (stores X and Y coordinate)
 func (p *G1Affine) RawBytes() (res [SizeOfG1AffineUncompressed]byte) {
 
@@ -55,7 +67,7 @@ func (p *G1Affine) SetBytes(buf []byte) (int, error) {
 
 // we store both X and Y and there is no spare bit for flagging
 func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
-	if len(buf) < SizeOfG1AffineCompressed {
+	if len(buf) < SizeOfG1AffineUncompressed {
 		return 0, io.ErrShortBuffer
 	}
 
@@ -139,20 +139,36 @@ func PrecomputeLattice(r, lambda *big.Int, res *Lattice) {
 // u+v.λ=s[r].
 // cf https://www.iacr.org/archive/crypto2001/21390189.pdf
 func SplitScalar(s *big.Int, l *Lattice) [2]big.Int {
+	var res [2]big.Int
+	var buf [3]big.Int
+	SplitScalarTo(&res, &buf, s, l)
+	return res
+}
 
-	var k1, k2 big.Int
+// SplitScalarTo sets res to SplitScalar(s, l), using buf as temporaries.
+//
+// It doesn't allocate once res and buf have grown, so that splitting many scalars
+// with the same res and buf, as the GLV multi-exponentiations do, is allocation free.
+func SplitScalarTo(res *[2]big.Int, buf *[3]big.Int, s *big.Int, l *Lattice) {
+	k1, k2, tmp := &buf[0], &buf[1], &buf[2]
 	k1.Mul(s, &l.b1)
-	k2.Mul(s, &l.b2).Neg(&k2)
+	k2.Mul(s, &l.b2).Neg(k2)
 	// right-shift instead of division by lattice determinant
 	// this increases the bounds on k1 and k2 by 1
 	// but we check this ScalarMultiplication alg. (not constant-time)
 	n := 2 * uint(((l.Det.BitLen()+32)>>6)<<6)
-	k1.Rsh(&k1, n)
-	k2.Rsh(&k2, n)
-	v := getVector(l, &k1, &k2)
-	v[0].Sub(s, &v[0])
-	v[1].Neg(&v[1])
-	return v
+	k1.Rsh(k1, n)
+	k2.Rsh(k2, n)
+
+	// res = (s, 0) - (k1·V1 + k2·V2)
+	res[0].Mul(k1, &l.V1[0])
+	tmp.Mul(k2, &l.V2[0])
+	res[0].Add(&res[0], tmp)
+	res[0].Sub(s, &res[0])
+	res[1].Mul(k1, &l.V1[1])
+	tmp.Mul(k2, &l.V2[1])
+	res[1].Add(&res[1], tmp)
+	res[1].Neg(&res[1])
 }
 
 // sets res to the closest integer from n/d
@@ -167,17 +183,6 @@ func rounding(n, d, res *big.Int) {
 	}
 }
 
-// getVector returns aV1 + bV2
-func getVector(l *Lattice, a, b *big.Int) [2]big.Int {
-	var res [2]big.Int
-	var tmp big.Int
-	tmp.Mul(b, &l.V2[0])
-	res[0].Mul(a, &l.V1[0]).Add(&res[0], &tmp)
-	tmp.Mul(b, &l.V2[1])
-	res[1].Mul(a, &l.V1[1]).Add(&res[1], &tmp)
-	return res
-}
-
 // NextPowerOfTwo returns the next power of 2 of n
 func NextPowerOfTwo(n uint64) uint64 {
 	c := bits.OnesCount64(n)
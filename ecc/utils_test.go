@@ -40,7 +40,28 @@ func TestSplitting(t *testing.T) {
 	if _s.Cmp(&zero) != 0 {
 		t.Fatal("Error split scalar")
 	}
+}
+
+func TestSplitScalarTo(t *testing.T) {
+	var lambda, r, s big.Int
+	var l Lattice
 
+	r.SetString("21888242871839275222246405745257275088548364400416034343698204186575808495617", 10)
+	lambda.SetString("4407920970296243842393367215006156084916469457145843978461", 10)
+	PrecomputeLattice(&r, &lambda, &l)
+	s.SetString("183927522224640574525727508854836440041603434369820418657580", 10)
+
+	// SplitScalarTo gives the same result as SplitScalar, and doesn't allocate once its buffers have grown
+	v := SplitScalar(&s, &l)
+	var res [2]big.Int
+	var buf [3]big.Int
+	SplitScalarTo(&res, &buf, &s, &l)
+	if res[0].Cmp(&v[0]) != 0 || res[1].Cmp(&v[1]) != 0 {
+		t.Fatal("SplitScalarTo doesn't match SplitScalar")
+	}
+	if allocs := testing.AllocsPerRun(10, func() { SplitScalarTo(&res, &buf, &s, &l) }); allocs != 0 {
+		t.Fatalf("SplitScalarTo allocates %v times", allocs)
+	}
 }
 
 func BenchmarkSplitting256(b *testing.B) {
@@ -53,9 +74,17 @@ func BenchmarkSplitting256(b *testing.B) {
 	PrecomputeLattice(&r, &lambda, &l)
 	s.SetString("183927522224640574525727508854836440041603434369820418657580", 10)
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		SplitScalar(&s, &l)
-	}
+	b.Run("SplitScalar", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			SplitScalar(&s, &l)
+		}
+	})
 
+	b.Run("SplitScalarTo", func(b *testing.B) {
+		var res [2]big.Int
+		var buf [3]big.Int
+		for i := 0; i < b.N; i++ {
+			SplitScalarTo(&res, &buf, &s, &l)
+		}
+	})
 }
@@ -0,0 +1,54 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package babybear
+
+// Mul z = x * y (mod q)
+//
+// x and y must be less than q
+func (z *Element) Mul(x, y *Element) *Element {
+
+	// q < 2³¹ and R = 2³², hence x * y + m * q < 2⁶² + 2⁶³ fits on a uint64;
+	// we compute the Montgomery reduction (textbook REDC) with 64-bit intermediate products
+	v := uint64(x[0]) * uint64(y[0])
+	m := uint32(v) * qInvNeg
+	r := uint32((v + uint64(m)*uint64(q)) >> 32)
+	if r >= q {
+		r -= q
+	}
+	z[0] = r
+
+	return z
+}
+
+// Square z = x * x (mod q)
+//
+// x must be less than q
+func (z *Element) Square(x *Element) *Element {
+	// see Mul for algorithm documentation
+
+	// q < 2³¹ and R = 2³², hence x * y + m * q < 2⁶² + 2⁶³ fits on a uint64;
+	// we compute the Montgomery reduction (textbook REDC) with 64-bit intermediate products
+	v := uint64(x[0]) * uint64(x[0])
+	m := uint32(v) * qInvNeg
+	r := uint32((v + uint64(m)*uint64(q)) >> 32)
+	if r >= q {
+		r -= q
+	}
+	z[0] = r
+
+	return z
+}
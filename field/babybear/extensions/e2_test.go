@@ -0,0 +1,374 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// GenE2 generates an E2 elmt
+func GenE2() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var e E2
+		if _, err := e.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&e, gopter.NoShrinker)
+	}
+}
+
+func TestE2ReceiverIsOperand(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE2()
+	genB := GenE2()
+
+	properties.Property("[E2] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (inverse) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (mul by non residue) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.MulByNonResidue(a)
+			a.MulByNonResidue(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Having the receiver as operand (Frobenius) should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE2Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE2()
+	genB := GenE2()
+	genC := GenE2()
+
+	properties.Property("[E2] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] mul should be distributive over add", prop.ForAll(
+		func(a, b, c *E2) bool {
+			var l, r, tmp E2
+			l.Add(b, c).Mul(&l, a)
+			r.Mul(a, b)
+			tmp.Mul(a, c)
+			r.Add(&r, &tmp)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E2] mul should be associative", prop.ForAll(
+		func(a, b, c *E2) bool {
+			var l, r E2
+			l.Mul(a, b).Mul(&l, c)
+			r.Mul(b, c).Mul(a, &r)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E2] square and mul should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] a * a⁻¹ should equal 1", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Inverse(a).Mul(&b, a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E2] div should be the inverse of mul", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Mul(a, b).Div(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] MulByNonResidue should be the multiplication by u", prop.ForAll(
+		func(a *E2) bool {
+			var b, u E2
+			u.A1.SetOne()
+			b.MulByNonResidue(a)
+			u.Mul(&u, a)
+			return b.Equal(&u)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] MulByElement should be the multiplication by an embedded base field element", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			e := b.A0
+			d.A0 = e
+			c.MulByElement(a, &e)
+			d.Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Frobenius should be the exponentiation by p", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Frobenius(a)
+			c.Exp(*a, babybear.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Norm should be the product of the conjugates", prop.ForAll(
+		func(a *E2) bool {
+			var n babybear.Element
+			a.Norm(&n)
+			var b, c E2
+			b.Set(a)
+			c.Set(a)
+			b.Frobenius(&b)
+			c.Mul(&c, &b)
+			return c.A0.Equal(&n) && c.A1.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Legendre on square should output 1", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Sqrt(x²) should be ±x", prop.ForAll(
+		func(a *E2) bool {
+			var b, c, minusA E2
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			minusA.Neg(a)
+			return c.Equal(a) || c.Equal(&minusA)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil && b.IsZero()
+		},
+		genA,
+	))
+
+	// every element of babybear is a square in E2
+	properties.Property("[E2] Sqrt of embedded base field elements should be correct", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.A0 = a.A0
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			c.Square(&c)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Exp should match repeated multiplication", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Exp(*a, big.NewInt(5))
+			c.Square(a).Square(&c).Mul(&c, a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Exp by a negative exponent should invert", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Exp(*a, big.NewInt(-1))
+			c.Inverse(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] BatchInvert should output the same result as Inverse", prop.ForAll(
+		func(a, b *E2) bool {
+			var zero E2
+			res := BatchInvertE2([]E2{*a, zero, *b})
+			var ia, ib E2
+			ia.Inverse(a)
+			ib.Inverse(b)
+			return res[0].Equal(&ia) && res[1].IsZero() && res[2].Equal(&ib)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE2Mul(b *testing.B) {
+	var a, c E2
+	_, _ = a.SetRandom()
+	_, _ = c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE2Square(b *testing.B) {
+	var a E2
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE2Inverse(b *testing.B) {
+	var a E2
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE2Sqrt(b *testing.B) {
+	var a E2
+	_, _ = a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
+
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 50
+)
@@ -0,0 +1,342 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+)
+
+// E4 is a degree two finite field extension of E2
+//
+// E4 = A0 + A1·v, with v² = u
+type E4 struct {
+	A0, A1 E2
+}
+
+// nonResidueE4 is v² = u
+var nonResidueE4 E2
+
+// frobeniusE4 is (u)^((p-1)/2), such that v^p = frobeniusE4·v
+var frobeniusE4 E2
+
+func init() {
+	nonResidueE4.A0.SetString("0")
+	nonResidueE4.A1.SetString("1")
+	frobeniusE4.A0.SetString("1728404513")
+	frobeniusE4.A1.SetString("0")
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E4) Cmp(x *E4) int {
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// SetZero sets an E4 elmt to zero
+func (z *E4) SetZero() *E4 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// Set sets an E4 from x
+func (z *E4) Set(x *E4) *E4 {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E4) SetOne() *E4 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// SetRandom sets a0 and a1 to random values
+func (z *E4) SetRandom() (*E4, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E4) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E4) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// Add adds two elements of E4
+func (z *E4) Add(x, y *E4) *E4 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub two elements of E4
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double doubles an E4 element
+func (z *E4) Double(x *E4) *E4 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg negates an E4 element
+func (z *E4) Neg(x *E4) *E4 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E4) String() string {
+	return "(" + z.A0.String() + ")+(" + z.A1.String() + ")*v"
+}
+
+// Mul sets z to the E4-product of x,y, returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	var a, b, c E2
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	c.MulByNonResidue(&c)
+	z.A0.Add(&b, &c)
+	return z
+}
+
+// Square sets z to the E4-product of x,x returns z
+func (z *E4) Square(x *E4) *E4 {
+	var a, b E2
+	a.Square(&x.A0)
+	b.Square(&x.A1)
+	b.MulByNonResidue(&b)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&a, &b)
+	return z
+}
+
+// MulByElement multiplies an element in E4 by an element in babybear
+func (z *E4) MulByElement(x *E4, y *babybear.Element) *E4 {
+	var yCopy babybear.Element
+	yCopy.Set(y)
+	z.A0.MulByElement(&x.A0, &yCopy)
+	z.A1.MulByElement(&x.A1, &yCopy)
+	return z
+}
+
+// MulByNonResidue multiplies an element in E4 by v
+func (z *E4) MulByNonResidue(x *E4) *E4 {
+	var a E2
+	a.Set(&x.A1)
+	a.MulByNonResidue(&a)
+	z.A1 = x.A0
+	z.A0 = a
+	return z
+}
+
+// Conjugate conjugates an element in E4
+func (z *E4) Conjugate(x *E4) *E4 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Frobenius sets z to x^p, where p is the modulus of babybear, and returns z
+func (z *E4) Frobenius(x *E4) *E4 {
+	z.A0.Frobenius(&x.A0)
+	z.A1.Frobenius(&x.A1).Mul(&z.A1, &frobeniusE4)
+	return z
+}
+
+// Norm sets x to the norm of z over E2, x = a0² - (u)·a1²
+func (z *E4) Norm(x *E2) {
+	var tmp E2
+	x.Square(&z.A0)
+	tmp.Square(&z.A1)
+	tmp.MulByNonResidue(&tmp)
+	x.Sub(x, &tmp)
+}
+
+// Inverse sets z to the E4-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E4) Inverse(x *E4) *E4 {
+	var norm E2
+	x.Norm(&norm)
+	norm.Inverse(&norm)
+	z.A0.Mul(&x.A0, &norm)
+	z.A1.Mul(&x.A1, &norm).Neg(&z.A1)
+	return z
+}
+
+// Div sets z = x / y and returns z
+func (z *E4) Div(x, y *E4) *E4 {
+	var r E4
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z=xᵏ in E4 and returns it
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z
+//
+// z is a square in E4 iff its norm is a square in E2.
+func (z *E4) Legendre() int {
+	var n E2
+	z.Norm(&n)
+	return n.Legendre()
+}
+
+// Sqrt z = √x in E4
+// if the square root doesn't exist (x is not a square in E4)
+// Sqrt leaves z unchanged and returns nil
+func (z *E4) Sqrt(x *E4) *E4 {
+	var a0, a1, delta E2
+	var half babybear.Element
+
+	if x.A1.IsZero() {
+		// x = a0 ∈ E2: either a0 is a square in E2, or a0/(u) is
+		if a0.Sqrt(&x.A0) != nil {
+			z.A0 = a0
+			z.A1.SetZero()
+			return z
+		}
+		a1.Inverse(&nonResidueE4).Mul(&a1, &x.A0)
+		if a1.Sqrt(&a1) == nil {
+			return nil
+		}
+		z.A0.SetZero()
+		z.A1 = a1
+		return z
+	}
+
+	// x = (a0 + a1·v)², with a0² + (u)·a1² = x.A0 and 2·a0·a1 = x.A1
+	// then a0² = (x.A0 ± √N(x)) / 2
+	x.Norm(&delta)
+	if delta.Sqrt(&delta) == nil {
+		return nil
+	}
+	half.SetUint64(2).Inverse(&half)
+	a0.Add(&x.A0, &delta).MulByElement(&a0, &half)
+	if a0.Legendre() == -1 {
+		a0.Sub(&x.A0, &delta).MulByElement(&a0, &half)
+	}
+	if a0.Sqrt(&a0) == nil {
+		return nil
+	}
+	a1.Double(&a0).Inverse(&a1).Mul(&a1, &x.A1)
+
+	z.A0 = a0
+	z.A1 = a1
+	return z
+}
+
+// BatchInvertE4 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE4(a []E4) []E4 {
+	res := make([]E4, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E4
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
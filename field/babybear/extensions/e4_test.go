@@ -0,0 +1,370 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// GenE4 generates an E4 elmt
+func GenE4() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var e E4
+		if _, err := e.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&e, gopter.NoShrinker)
+	}
+}
+
+func TestE4ReceiverIsOperand(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE4()
+	genB := GenE4()
+
+	properties.Property("[E4] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Having the receiver as operand (inverse) should output the same result", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Having the receiver as operand (mul by non residue) should output the same result", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.MulByNonResidue(a)
+			a.MulByNonResidue(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Having the receiver as operand (Frobenius) should output the same result", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE4Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE4()
+	genB := GenE4()
+	genC := GenE4()
+
+	properties.Property("[E4] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E4) bool {
+			var c E4
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] mul should be distributive over add", prop.ForAll(
+		func(a, b, c *E4) bool {
+			var l, r, tmp E4
+			l.Add(b, c).Mul(&l, a)
+			r.Mul(a, b)
+			tmp.Mul(a, c)
+			r.Add(&r, &tmp)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E4] mul should be associative", prop.ForAll(
+		func(a, b, c *E4) bool {
+			var l, r E4
+			l.Mul(a, b).Mul(&l, c)
+			r.Mul(b, c).Mul(a, &r)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E4] square and mul should output the same result", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] a * a⁻¹ should equal 1", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Inverse(a).Mul(&b, a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E4] div should be the inverse of mul", prop.ForAll(
+		func(a, b *E4) bool {
+			var c E4
+			c.Mul(a, b).Div(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] MulByNonResidue should be the multiplication by v", prop.ForAll(
+		func(a *E4) bool {
+			var b, u E4
+			u.A1.SetOne()
+			b.MulByNonResidue(a)
+			u.Mul(&u, a)
+			return b.Equal(&u)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] MulByElement should be the multiplication by an embedded base field element", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			e := b.A0.A0
+			d.A0.A0 = e
+			c.MulByElement(a, &e)
+			d.Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] Frobenius should be the exponentiation by p", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Frobenius(a)
+			c.Exp(*a, babybear.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Norm should be the product of the conjugates", prop.ForAll(
+		func(a *E4) bool {
+			var n E2
+			a.Norm(&n)
+			var b, c E4
+			b.Set(a)
+			c.Set(a)
+			b.Frobenius(&b)
+			b.Frobenius(&b)
+			c.Mul(&c, &b)
+			return c.A0.Equal(&n) && c.A1.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Legendre on square should output 1", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Sqrt(x²) should be ±x", prop.ForAll(
+		func(a *E4) bool {
+			var b, c, minusA E4
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			minusA.Neg(a)
+			return c.Equal(a) || c.Equal(&minusA)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil && b.IsZero()
+		},
+		genA,
+	))
+
+	// every element of E2 is a square in E4
+	properties.Property("[E4] Sqrt of embedded base field elements should be correct", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.A0 = a.A0
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			c.Square(&c)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Exp should match repeated multiplication", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Exp(*a, big.NewInt(5))
+			c.Square(a).Square(&c).Mul(&c, a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Exp by a negative exponent should invert", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Exp(*a, big.NewInt(-1))
+			c.Inverse(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] BatchInvert should output the same result as Inverse", prop.ForAll(
+		func(a, b *E4) bool {
+			var zero E4
+			res := BatchInvertE4([]E4{*a, zero, *b})
+			var ia, ib E4
+			ia.Inverse(a)
+			ib.Inverse(b)
+			return res[0].Equal(&ia) && res[1].IsZero() && res[2].Equal(&ib)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE4Mul(b *testing.B) {
+	var a, c E4
+	_, _ = a.SetRandom()
+	_, _ = c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE4Square(b *testing.B) {
+	var a E4
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE4Inverse(b *testing.B) {
+	var a E4
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE4Sqrt(b *testing.B) {
+	var a E4
+	_, _ = a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x78000001" // 2³¹ - 2²⁷ + 1
+	babybear, err := config.NewFieldConfig("babybear", "Element", modulus, true)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(babybear, "../"); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated babybear field")
+
+	// E4 = E2[v]/(v² - u) is isomorphic to babybear[X]/(X⁴ - 11), with v = X and u = X²
+	e2 := config.BinomialExtension{Name: "E2", Degree: 2, NonResidue: []string{"11"}}
+	e4 := config.BinomialExtension{Name: "E4", Base: "E2", Degree: 2, NonResidue: []string{"0", "1"}}
+	if err := generator.GenerateExtensions(babybear, "github.com/consensys/gnark-crypto/field/babybear", "../extensions", e2, e4); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated babybear extensions")
+}
@@ -0,0 +1,133 @@
+package dynamic
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// limbs stores little-endian 64-bit words; the words above the number of words of the field are zero.
+//
+// The arithmetic functions below take slices of exactly the number of words of the field, so that they
+// work both on the storage of the elements and on limbs.
+type limbs = [MaxNbWords]uint64
+
+// toLimbs returns the words of 0 ≤ v < 2^(64·MaxNbWords)
+func toLimbs(v *big.Int) (res limbs) {
+	var buf [MaxNbWords * 8]byte
+	v.FillBytes(buf[:])
+	for i := 0; i < MaxNbWords; i++ {
+		j := len(buf) - 8*(i+1)
+		for k := 0; k < 8; k++ {
+			res[i] = res[i]<<8 | uint64(buf[j+k])
+		}
+	}
+	return
+}
+
+// mulGeneric sets z = x·y·R⁻¹ mod q, following the CIOS algorithm
+// (Çetin Koç, Tolga Acar, Burton Kaliski, "Analyzing and Comparing Montgomery Multiplication Algorithms").
+//
+// It handles any number of words; mul dispatches the small ones to unrolled versions.
+func (f *Field) mulGeneric(z, x, y []uint64) {
+	n := f.nbWords
+	var t [MaxNbWords + 2]uint64
+	for i := 0; i < n; i++ {
+		// t += x·y[i]
+		var c, carry uint64
+		for j := 0; j < n; j++ {
+			hi, lo := bits.Mul64(x[j], y[i])
+			lo, carry = bits.Add64(lo, t[j], 0)
+			hi += carry
+			lo, carry = bits.Add64(lo, c, 0)
+			hi += carry
+			t[j], c = lo, hi
+		}
+		t[n], carry = bits.Add64(t[n], c, 0)
+		t[n+1] = carry
+
+		// t = (t + m·q) / 2⁶⁴
+		m := t[0] * f.qInvNeg
+		hi, lo := bits.Mul64(m, f.q[0])
+		_, carry = bits.Add64(lo, t[0], 0)
+		c = hi + carry
+		for j := 1; j < n; j++ {
+			hi, lo = bits.Mul64(m, f.q[j])
+			lo, carry = bits.Add64(lo, t[j], 0)
+			hi += carry
+			lo, carry = bits.Add64(lo, c, 0)
+			hi += carry
+			t[j-1], c = lo, hi
+		}
+		t[n-1], carry = bits.Add64(t[n], c, 0)
+		t[n] = t[n+1] + carry
+	}
+
+	// t < 2q
+	copy(z, t[:n])
+	if t[n] != 0 || !f.smallerThanModulus(z) {
+		f.subQ(z)
+	}
+}
+
+// add sets z = x + y mod q
+func (f *Field) add(z, x, y []uint64) {
+	var carry uint64
+	for i := 0; i < f.nbWords; i++ {
+		z[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+	if carry != 0 || !f.smallerThanModulus(z) {
+		f.subQ(z)
+	}
+}
+
+// sub sets z = x - y mod q
+func (f *Field) sub(z, x, y []uint64) {
+	var borrow uint64
+	for i := 0; i < f.nbWords; i++ {
+		z[i], borrow = bits.Sub64(x[i], y[i], borrow)
+	}
+	if borrow != 0 {
+		var carry uint64
+		for i := 0; i < f.nbWords; i++ {
+			z[i], carry = bits.Add64(z[i], f.q[i], carry)
+		}
+	}
+}
+
+// subQ sets z = z - q, ignoring the final borrow
+func (f *Field) subQ(z []uint64) {
+	var borrow uint64
+	for i := 0; i < f.nbWords; i++ {
+		z[i], borrow = bits.Sub64(z[i], f.q[i], borrow)
+	}
+}
+
+// smallerThanModulus returns true if z < q
+func (f *Field) smallerThanModulus(z []uint64) bool {
+	for i := f.nbWords - 1; i >= 0; i-- {
+		if z[i] != f.q[i] {
+			return z[i] < f.q[i]
+		}
+	}
+	return false
+}
+
+// fromMont returns z·R⁻¹ mod q
+func (f *Field) fromMont(z []uint64) (res limbs) {
+	var one limbs
+	one[0] = 1
+	f.mul(res[:f.nbWords], z, one[:f.nbWords])
+	return
+}
+
+// cmp compares x and y as integers
+func (f *Field) cmp(x, y []uint64) int {
+	for i := f.nbWords - 1; i >= 0; i-- {
+		if x[i] > y[i] {
+			return 1
+		} else if x[i] < y[i] {
+			return -1
+		}
+	}
+	return 0
+}
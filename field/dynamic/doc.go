@@ -0,0 +1,15 @@
+// Package dynamic provides prime fields whose modulus is only known at runtime.
+//
+// Field is constructed from a *big.Int modulus, and Element mirrors the API of the code-generated
+// field elements (Montgomery multiplication, inverse, square root, Legendre symbol, serialization) as
+// well as their Vector operations. Arithmetic is implemented on up to MaxNbWords 64-bit words, and
+// costs are proportional to the number of words of the modulus. Elements of fields of at most SmallNbWords
+// words hold their words inline, and don't allocate; the ones of larger fields allocate their words on each
+// operation.
+//
+// When the modulus is known at compile time, the generated packages (see field/goff) are faster.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package dynamic
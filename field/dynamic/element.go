@@ -0,0 +1,605 @@
+package dynamic
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// Element is an element of a Field, stored in Montgomery form.
+//
+// The zero value has no field: elements are obtained from Field.NewElement, Field.Zero, Field.One or
+// Field.NewVector, and the receiver of an operation takes the field of its operands.
+// Operands of different fields are not supported.
+//
+// The words of elements of fields of at most SmallNbWords words are stored in the Element; the ones of
+// larger fields are allocated by each operation.
+type Element struct {
+	field *Field
+	small [SmallNbWords]uint64
+	// large holds the words when the field has more than SmallNbWords words, or is nil for 0.
+	// It is never modified once set, so that copies of the element can share it.
+	large *limbs
+}
+
+// zeroLimbs are the words of the large elements with no storage
+var zeroLimbs limbs
+
+// words returns the words of x, which must not be modified
+func (x *Element) words() []uint64 {
+	n := x.field.nbWords
+	if n <= SmallNbWords {
+		return x.small[:n]
+	}
+	if x.large == nil {
+		return zeroLimbs[:n]
+	}
+	return x.large[:n]
+}
+
+// result sets the field of z to f and returns the storage for its words; since the storage of the
+// large elements is replaced, it must be called after the words of the operands are read.
+func (z *Element) result(f *Field) []uint64 {
+	z.field = f
+	if f.nbWords <= SmallNbWords {
+		return z.small[:f.nbWords]
+	}
+	z.large = new(limbs)
+	return z.large[:f.nbWords]
+}
+
+// setWords sets z to the element of f of words v, which must not be modified afterwards
+func (z *Element) setWords(f *Field, v *limbs) {
+	z.field = f
+	if f.nbWords <= SmallNbWords {
+		copy(z.small[:], v[:SmallNbWords])
+	} else {
+		z.large = v
+	}
+}
+
+// Field returns the field of z
+func (z *Element) Field() *Field {
+	return z.field
+}
+
+// fieldOf returns the field of x, and panics if x has none
+func fieldOf(x *Element) *Field {
+	if x.field == nil {
+		panic("dynamic: element has no field; use Field.NewElement")
+	}
+	return x.field
+}
+
+// fieldOf2 returns the field of x and y, and panics if they differ
+func fieldOf2(x, y *Element) *Field {
+	f := fieldOf(x)
+	if y.field != f {
+		panic("dynamic: operands belong to different fields")
+	}
+	return f
+}
+
+// SetUint64 sets z to v and returns z
+func (z *Element) SetUint64(v uint64) *Element {
+	*z = fieldOf(z).NewElement(v)
+	return z
+}
+
+// SetInt64 sets z to v and returns z
+func (z *Element) SetInt64(v int64) *Element {
+	// absolute value of v
+	m := v >> 63
+	z.SetUint64(uint64((v ^ m) - m))
+	if m != 0 {
+		// v is negative
+		z.Neg(z)
+	}
+	return z
+}
+
+// Set z = x and returns z
+func (z *Element) Set(x *Element) *Element {
+	*z = *x
+	return z
+}
+
+// SetZero z = 0
+func (z *Element) SetZero() *Element {
+	z.small = [SmallNbWords]uint64{}
+	z.large = nil
+	return z
+}
+
+// SetOne z = 1 (in Montgomery form)
+func (z *Element) SetOne() *Element {
+	f := fieldOf(z)
+	z.setWords(f, &f.one)
+	return z
+}
+
+// Div z = x*y⁻¹ (mod q)
+func (z *Element) Div(x, y *Element) *Element {
+	var yInv Element
+	yInv.Inverse(y)
+	z.Mul(x, &yInv)
+	return z
+}
+
+// Equal returns z == x
+func (z *Element) Equal(x *Element) bool {
+	if z.field == nil || x.field == nil {
+		return z.IsZero() && x.IsZero()
+	}
+	return equal(z.words(), x.words())
+}
+
+// IsZero returns z == 0
+func (z *Element) IsZero() bool {
+	if z.field == nil {
+		return true
+	}
+	for _, w := range z.words() {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOne returns z == 1
+func (z *Element) IsOne() bool {
+	return z.field != nil && equal(z.words(), z.field.one[:z.field.nbWords])
+}
+
+// equal returns x == y, for slices of the same length
+func equal(x, y []uint64) bool {
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsUint64 reports whether z can be represented as an uint64.
+func (z *Element) IsUint64() bool {
+	b := z.Bits()
+	for i := 1; i < len(b); i++ {
+		if b[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Uint64 returns the uint64 representation of x. If x cannot be represented in a uint64, the result is undefined.
+func (z *Element) Uint64() uint64 {
+	return z.Bits()[0]
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Element) Cmp(x *Element) int {
+	f := fieldOf2(z, x)
+	_z := f.fromMont(z.words())
+	_x := f.fromMont(x.words())
+	return f.cmp(_z[:f.nbWords], _x[:f.nbWords])
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *Element) LexicographicallyLargest() bool {
+	var neg Element
+	neg.Neg(z)
+	return z.Cmp(&neg) == 1
+}
+
+// SetRandom sets z to a uniform random value in [0, q).
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *Element) SetRandom() (*Element, error) {
+	f := fieldOf(z)
+	v, err := rand.Int(rand.Reader, &f.modulus)
+	if err != nil {
+		return nil, err
+	}
+	z.setBigInt(v)
+	return z, nil
+}
+
+// Add z = x + y (mod q)
+func (z *Element) Add(x, y *Element) *Element {
+	f := fieldOf2(x, y)
+	_x, _y := x.words(), y.words()
+	f.add(z.result(f), _x, _y)
+	return z
+}
+
+// Double z = x + x (mod q), aka Lsh 1
+func (z *Element) Double(x *Element) *Element {
+	return z.Add(x, x)
+}
+
+// Sub z = x - y (mod q)
+func (z *Element) Sub(x, y *Element) *Element {
+	f := fieldOf2(x, y)
+	_x, _y := x.words(), y.words()
+	f.sub(z.result(f), _x, _y)
+	return z
+}
+
+// Neg z = q - x
+func (z *Element) Neg(x *Element) *Element {
+	f := fieldOf(x)
+	_x := x.words()
+	f.sub(z.result(f), zeroLimbs[:f.nbWords], _x)
+	return z
+}
+
+// Mul z = x * y (mod q)
+//
+// x and y must be less than q
+func (z *Element) Mul(x, y *Element) *Element {
+	f := fieldOf2(x, y)
+	if f.nbWords <= SmallNbWords {
+		f.mulSmall(&z.small, &x.small, &y.small)
+		z.field = f
+		return z
+	}
+	_x, _y := x.words(), y.words()
+	f.mul(z.result(f), _x, _y)
+	return z
+}
+
+// Square z = x * x (mod q)
+//
+// x must be less than q
+func (z *Element) Square(x *Element) *Element {
+	return z.Mul(x, x)
+}
+
+// Inverse z = x⁻¹ (mod q)
+//
+// if x == 0, sets and returns z = x
+func (z *Element) Inverse(x *Element) *Element {
+	f := fieldOf(x)
+	if x.IsZero() {
+		z.field = f
+		return z.SetZero()
+	}
+	vv := pool.BigInt.Get()
+	defer pool.BigInt.Put(vv)
+	x.BigInt(vv)
+	vv.ModInverse(vv, &f.modulus)
+	z.field = f
+	return z.setBigInt(vv)
+}
+
+// Exp z = xᵏ (mod q)
+func (z *Element) Exp(x Element, k *big.Int) *Element {
+	fieldOf(&x)
+	if k.IsUint64() && k.Uint64() == 0 {
+		z.field = x.field
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *Element) Legendre() int {
+	var l Element
+	// z^((q-1)/2)
+	l.Exp(*z, &fieldOf(z).legendreExponent)
+
+	if l.IsZero() {
+		return 0
+	}
+
+	// if l == 1
+	if l.IsOne() {
+		return 1
+	}
+	return -1
+}
+
+// Sqrt z = √x (mod q)
+// if the square root doesn't exist (x is not a square mod q)
+// Sqrt leaves z unchanged and returns nil
+func (z *Element) Sqrt(x *Element) *Element {
+	// q ≡ 1 (mod 4), or q ≡ 3 (mod 4) with sqrtS = 1
+	// see modSqrtTonelliShanks in math/big/int.go
+	// using https://www.maa.org/sites/default/files/pdf/upload_library/22/Polya/07468342.di020786.02p0470a.pdf
+	f := fieldOf(x)
+
+	var y, b, t, w Element
+	// w = x^((s-1)/2))
+	w.Exp(*x, &f.sqrtTMinusOneOver2)
+
+	// y = x^((s+1)/2)) = w * x
+	y.Mul(x, &w)
+
+	// b = xˢ = w * w * x = y * x
+	b.Mul(&w, &y)
+
+	// g = nonResidue ^ s
+	var g Element
+	g.setWords(f, &f.sqrtG)
+	r := f.sqrtS
+
+	// compute legendre symbol
+	// t = x^((q-1)/2) = r-1 squaring of xˢ
+	t = b
+	for i := 0; i < r-1; i++ {
+		t.Square(&t)
+	}
+	if t.IsZero() {
+		z.field = f
+		return z.SetZero()
+	}
+	if !t.IsOne() {
+		// t != 1, we don't have a square root
+		return nil
+	}
+	for {
+		var m int
+		t = b
+
+		// for t != 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1)) (mod q)
+		ge := r - m - 1
+		t = g
+		for ge > 0 {
+			t.Square(&t)
+			ge--
+		}
+
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+}
+
+// BatchInvert returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvert(a []Element) []Element {
+	res := make([]Element, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	accumulator := fieldOf(&a[0]).One()
+
+	for i := 0; i < len(a); i++ {
+		res[i].field = accumulator.field
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// String returns the decimal representation of z as generated by
+// z.Text(10).
+func (z *Element) String() string {
+	return z.Text(10)
+}
+
+// Text returns the string representation of z in the given base.
+// Base must be between 2 and 36, inclusive. The result uses the
+// lower-case letters 'a' to 'z' for digit values 10 to 35.
+// No prefix (such as "0x") is added to the string. If z is a nil
+// pointer it returns "<nil>".
+// If base == 10 and -z fits in a uint16 and is smaller than z, prefix "-" is added to the string.
+func (z *Element) Text(base int) string {
+	if base < 2 || base > 36 {
+		panic("invalid base")
+	}
+	if z == nil {
+		return "<nil>"
+	}
+	if z.field == nil {
+		return "0"
+	}
+
+	var zzNeg Element
+	zzNeg.Neg(z)
+	if base == 10 && zzNeg.IsUint64() && zzNeg.Uint64() <= 0xFFFF && zzNeg.Cmp(z) == -1 {
+		return "-" + strconv.FormatUint(zzNeg.Uint64(), 10)
+	}
+	vv := pool.BigInt.Get()
+	r := z.BigInt(vv).Text(base)
+	pool.BigInt.Put(vv)
+	return r
+}
+
+// BigInt sets and return z as a *big.Int
+func (z *Element) BigInt(res *big.Int) *big.Int {
+	var b [MaxNbWords * 8]byte
+	n := z.fieldBytes()
+	z.putBytes(b[:n])
+	return res.SetBytes(b[:n])
+}
+
+// Bits provides access to z by returning its value as a little-endian []uint64 slice
+// of NbWords words, out of Montgomery form
+func (z *Element) Bits() []uint64 {
+	f := fieldOf(z)
+	_z := f.fromMont(z.words())
+	return _z[:f.nbWords]
+}
+
+// Bytes returns the value of z as a big-endian byte slice of NbBytes bytes
+func (z *Element) Bytes() []byte {
+	res := make([]byte, fieldOf(z).nbBytes)
+	z.putBytes(res)
+	return res
+}
+
+// Marshal returns the value of z as a big-endian byte slice.
+func (z *Element) Marshal() []byte {
+	return z.Bytes()
+}
+
+// Unmarshal is an alias for SetBytes, it sets z to the value of e.
+func (z *Element) Unmarshal(e []byte) {
+	z.SetBytes(e)
+}
+
+// SetBytes interprets e as the bytes of a big-endian unsigned integer,
+// sets z to that value (mod q), and returns z.
+func (z *Element) SetBytes(e []byte) *Element {
+	vv := pool.BigInt.Get()
+	vv.SetBytes(e)
+	z.SetBigInt(vv)
+	pool.BigInt.Put(vv)
+	return z
+}
+
+// SetBytesCanonical interprets e as the bytes of a big-endian NbBytes-byte integer.
+// If e is not a NbBytes-byte slice or encodes a value higher than q,
+// SetBytesCanonical returns an error.
+func (z *Element) SetBytesCanonical(e []byte) error {
+	f := fieldOf(z)
+	if len(e) != f.nbBytes {
+		return errors.New("invalid dynamic.Element encoding")
+	}
+	var v limbs
+	for i := 0; i < f.nbWords; i++ {
+		j := len(e) - 8*(i+1)
+		for k := 0; k < 8; k++ {
+			v[i] = v[i]<<8 | uint64(e[j+k])
+		}
+	}
+	if !f.smallerThanModulus(v[:f.nbWords]) {
+		return errors.New("invalid dynamic.Element encoding")
+	}
+	f.mul(z.result(f), v[:f.nbWords], f.rSquare[:f.nbWords])
+	return nil
+}
+
+// SetBigInt sets z to v (mod q) and returns z
+func (z *Element) SetBigInt(v *big.Int) *Element {
+	f := fieldOf(z)
+	if v.Sign() >= 0 && v.Cmp(&f.modulus) == -1 {
+		return z.setBigInt(v)
+	}
+	vv := pool.BigInt.Get()
+	vv.Mod(v, &f.modulus)
+	z.setBigInt(vv)
+	pool.BigInt.Put(vv)
+	return z
+}
+
+// setBigInt assumes 0 ⩽ v < q
+func (z *Element) setBigInt(v *big.Int) *Element {
+	f := z.field
+	l := toLimbs(v)
+	f.mul(z.result(f), l[:f.nbWords], f.rSquare[:f.nbWords])
+	return z
+}
+
+// SetString creates a big.Int with number and calls SetBigInt on z
+//
+// The number prefix determines the actual base: A prefix of
+// ”0b” or ”0B” selects base 2, ”0”, ”0o” or ”0O” selects base 8,
+// and ”0x” or ”0X” selects base 16. Otherwise, the selected base is 10
+// and no prefix is accepted.
+//
+// If the number is invalid this method leaves z unchanged and returns nil, error.
+func (z *Element) SetString(number string) (*Element, error) {
+	vv := pool.BigInt.Get()
+	defer pool.BigInt.Put(vv)
+
+	if _, ok := vv.SetString(number, 0); !ok {
+		return nil, errors.New("Element.SetString failed -> can't parse number into a big.Int " + number)
+	}
+
+	return z.SetBigInt(vv), nil
+}
+
+// BitLen returns the minimum number of bits needed to represent z
+// returns 0 if z == 0
+func (z *Element) BitLen() int {
+	b := z.Bits()
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != 0 {
+			return i*64 + bits.Len64(b[i])
+		}
+	}
+	return 0
+}
+
+// fieldBytes returns the number of bytes of the encoding of z
+func (z *Element) fieldBytes() int {
+	return fieldOf(z).nbBytes
+}
+
+// putBytes writes the big-endian encoding of z, out of Montgomery form, in b
+func (z *Element) putBytes(b []byte) {
+	_z := z.Bits()
+	for i, w := range _z {
+		j := len(b) - 8*(i+1)
+		for k := 7; k >= 0; k-- {
+			b[j+k] = byte(w)
+			w >>= 8
+		}
+	}
+}
@@ -0,0 +1,426 @@
+package dynamic
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/stretchr/testify/require"
+)
+
+const nbFuzz = 200
+
+// generatedElement is implemented by the code-generated field elements
+type generatedElement[T any] interface {
+	*T
+	SetBigInt(*big.Int) *T
+	BigInt(*big.Int) *big.Int
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Mul(x, y *T) *T
+	Inverse(x *T) *T
+	Sqrt(x *T) *T
+	Legendre() int
+	Marshal() []byte
+}
+
+func TestCrossGenerated(t *testing.T) {
+	t.Run("goldilocks", crossTest[goldilocks.Element](goldilocks.Modulus()))
+	t.Run("bn254/fp", crossTest[bn254.Element](bn254.Modulus()))
+	t.Run("bls12-381/fp", crossTest[bls12381.Element](bls12381.Modulus()))
+	t.Run("bw6-761/fp", crossTest[bw6761.Element](bw6761.Modulus()))
+}
+
+// crossTest checks that the arithmetic of a dynamic field matches the generated field of the same modulus
+func crossTest[T any, PT generatedElement[T]](modulus *big.Int) func(*testing.T) {
+	return func(t *testing.T) {
+		assert := require.New(t)
+
+		f, err := NewField(modulus)
+		assert.NoError(err)
+
+		toDynamic := func(v *big.Int) Element {
+			z := f.Zero()
+			z.SetBigInt(v)
+			return z
+		}
+		toGenerated := func(v *big.Int) T {
+			var z T
+			PT(&z).SetBigInt(v)
+			return z
+		}
+		equal := func(x *Element, y *T, msg string) {
+			var a, b big.Int
+			x.BigInt(&a)
+			PT(y).BigInt(&b)
+			assert.Equal(0, a.Cmp(&b), msg)
+		}
+
+		for i := 0; i < nbFuzz; i++ {
+			a, _ := rand.Int(rand.Reader, modulus)
+			b, _ := rand.Int(rand.Reader, modulus)
+			if i == 0 {
+				a.SetUint64(0)
+			}
+			if i == 1 {
+				b.Sub(modulus, big.NewInt(1))
+			}
+
+			x, y := toDynamic(a), toDynamic(b)
+			gx, gy := toGenerated(a), toGenerated(b)
+
+			var z Element
+			var gz T
+
+			z.Add(&x, &y)
+			PT(&gz).Add(&gx, &gy)
+			equal(&z, &gz, "Add")
+
+			z.Sub(&x, &y)
+			PT(&gz).Sub(&gx, &gy)
+			equal(&z, &gz, "Sub")
+
+			z.Mul(&x, &y)
+			PT(&gz).Mul(&gx, &gy)
+			equal(&z, &gz, "Mul")
+
+			z.Inverse(&x)
+			PT(&gz).Inverse(&gx)
+			equal(&z, &gz, "Inverse")
+
+			assert.Equal(PT(&gx).Legendre(), x.Legendre(), "Legendre")
+
+			// the square roots may differ by their sign, depending on the chosen non-residue
+			z.Sqrt(&x)
+			rgz := PT(&gz).Sqrt(&gx)
+			if rgz == nil {
+				assert.Equal(-1, x.Legendre(), "Sqrt of a non-residue")
+			} else {
+				z.Square(&z)
+				PT(&gz).Mul(&gz, &gz)
+				equal(&z, &gz, "Sqrt")
+			}
+
+			assert.Equal(PT(&gx).Marshal(), x.Marshal(), "Marshal")
+		}
+	}
+}
+
+// randomElement returns a uniform random element of f
+func randomElement(f *Field) *Element {
+	z := f.Zero()
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return &z
+}
+
+func TestNewField(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewField(big.NewInt(15))
+	assert.Error(err, "composite modulus")
+	_, err = NewField(big.NewInt(2))
+	assert.Error(err, "even modulus")
+	_, err = NewField(big.NewInt(-7))
+	assert.Error(err, "negative modulus")
+
+	var tooLarge big.Int
+	tooLarge.Lsh(big.NewInt(1), 64*MaxNbWords+10)
+	tooLarge.Sub(&tooLarge, big.NewInt(1))
+	for !tooLarge.ProbablyPrime(10) {
+		tooLarge.Sub(&tooLarge, big.NewInt(2))
+	}
+	_, err = NewField(&tooLarge)
+	assert.Error(err, "modulus too large")
+
+	f, err := NewField(bn254.Modulus())
+	assert.NoError(err)
+	assert.Equal(4, f.NbWords())
+	assert.Equal(254, f.NbBits())
+	assert.Equal(32, f.NbBytes())
+	assert.Equal(0, f.Modulus().Cmp(bn254.Modulus()))
+}
+
+// testModuli covers small fields, moduli close to a word boundary, q ≡ 1 mod 2ˢ for large s,
+// and both the unrolled and the generic multiplications
+func testModuli(t *testing.T) []*Field {
+	moduli := []string{
+		"7",
+		"18446744069414584321", // goldilocks, 2-adicity 32
+		"18446744073709551557", // largest 64-bit prime
+		"340282366920938463463374607431768211297",                                       // largest 128-bit prime
+		"21888242871839275222246405745257275088548364400416034343698204186575808495617", // bn254 fr, 2-adicity 28
+		"0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab",
+	}
+	var res []*Field
+	for _, m := range moduli {
+		var q big.Int
+		if _, ok := q.SetString(m, 0); !ok {
+			t.Fatal("invalid modulus")
+		}
+		f, err := NewField(&q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, f)
+	}
+
+	// largest primes on 3, 5 and 7 words
+	for _, nbWords := range []uint{3, 5, 7} {
+		var q big.Int
+		q.Lsh(big.NewInt(1), 64*nbWords).Sub(&q, big.NewInt(1))
+		for !q.ProbablyPrime(20) {
+			q.Sub(&q, big.NewInt(2))
+		}
+		f, err := NewField(&q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
+func TestElementArithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for _, f := range testModuli(t) {
+		q := f.Modulus()
+		for i := 0; i < nbFuzz; i++ {
+			x, y := randomElement(f), randomElement(f)
+			var a, b, c big.Int
+			x.BigInt(&a)
+			y.BigInt(&b)
+
+			var z Element
+			z.Add(x, y)
+			c.Add(&a, &b).Mod(&c, q)
+			assert.Equal(c.Text(16), z.Text(16), "Add")
+
+			z.Sub(x, y)
+			c.Sub(&a, &b).Mod(&c, q)
+			assert.Equal(c.Text(16), z.Text(16), "Sub")
+
+			z.Mul(x, y)
+			c.Mul(&a, &b).Mod(&c, q)
+			assert.Equal(c.Text(16), z.Text(16), "Mul")
+
+			if !y.IsZero() {
+				z.Div(x, y)
+				z.Mul(&z, y)
+				assert.True(z.Equal(x), "Div")
+			}
+
+			k := big.NewInt(int64(i) - 100)
+			z.Exp(*x, k)
+			c.Exp(&a, new(big.Int).Abs(k), q)
+			if k.Sign() < 0 {
+				c.ModInverse(&c, q)
+			}
+			assert.Equal(c.Text(16), z.Text(16), "Exp")
+
+			z.Square(x)
+			if !x.IsZero() {
+				assert.Equal(1, z.Legendre(), "Legendre of a square")
+			}
+			r := z.Sqrt(&z)
+			assert.NotNil(r, "Sqrt of a square")
+			r.Square(r)
+			z.Square(x)
+			assert.True(r.Equal(&z), "Sqrt")
+
+			if x.Legendre() == -1 {
+				z.Set(x)
+				assert.Nil(z.Sqrt(x), "Sqrt of a non-residue")
+				assert.True(z.Equal(x), "Sqrt leaves z unchanged")
+			}
+
+			var back Element = f.Zero()
+			assert.NoError(back.SetBytesCanonical(x.Bytes()))
+			assert.True(back.Equal(x), "Bytes round trip")
+		}
+
+		z := f.Zero()
+		assert.True(z.IsZero())
+		assert.Equal(0, z.Legendre())
+		assert.True(z.Sqrt(&z).IsZero(), "Sqrt of 0")
+		one := f.One()
+		assert.True(one.IsOne())
+		assert.True(one.IsUint64())
+		assert.Equal(uint64(1), one.Uint64())
+		z.SetInt64(-1)
+		z.Add(&z, &one)
+		assert.True(z.IsZero(), "SetInt64")
+		z.SetInt64(-2)
+		assert.Equal("-2", z.String())
+
+		e := f.NewElement(0)
+		assert.True(e.Inverse(&e).IsZero(), "Inverse of 0")
+
+		// non-canonical encodings
+		assert.Error(z.SetBytesCanonical(make([]byte, f.NbBytes()+1)))
+		qb := q.FillBytes(make([]byte, f.NbBytes()))
+		assert.Error(z.SetBytesCanonical(qb))
+		z.SetBytes(qb)
+		assert.True(z.IsZero(), "SetBytes reduces")
+	}
+}
+
+func TestElementSetString(t *testing.T) {
+	assert := require.New(t)
+
+	f, err := NewField(goldilocks.Modulus())
+	assert.NoError(err)
+
+	z := f.Zero()
+	_, err = z.SetString("0x10")
+	assert.NoError(err)
+	assert.Equal(uint64(16), z.Uint64())
+	assert.Equal(5, z.BitLen())
+
+	_, err = z.SetString("not a number")
+	assert.Error(err)
+
+	var v big.Int
+	v.Add(goldilocks.Modulus(), big.NewInt(3))
+	z.SetBigInt(&v)
+	assert.Equal("3", z.String())
+	v.SetInt64(-3)
+	z.SetBigInt(&v)
+	assert.Equal("-3", z.String())
+
+	m := f.NewElement(1)
+	assert.False(m.LexicographicallyLargest())
+	m.Neg(&m)
+	assert.True(m.LexicographicallyLargest())
+}
+
+func TestElementPanics(t *testing.T) {
+	assert := require.New(t)
+
+	f1, _ := NewField(big.NewInt(101))
+	f2, _ := NewField(big.NewInt(103))
+	x, y := f1.One(), f2.One()
+	var z, noField Element
+
+	assert.Panics(func() { z.Add(&x, &y) }, "different fields")
+	assert.Panics(func() { z.Mul(&x, &noField) }, "operand without field")
+	assert.Panics(func() { noField.SetUint64(1) }, "receiver without field")
+}
+
+func TestBatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	for _, f := range testModuli(t) {
+		a := f.NewVector(10)
+		for i := 1; i < len(a); i++ {
+			a[i].SetRandom()
+		}
+		res := BatchInvert(a)
+		for i := range a {
+			var expected Element
+			expected.Inverse(&a[i])
+			assert.True(res[i].Equal(&expected))
+		}
+	}
+}
+
+func BenchmarkElementMul(b *testing.B) {
+	for _, modulus := range []*big.Int{goldilocks.Modulus(), bn254.Modulus(), bls12381.Modulus(), bw6761.Modulus()} {
+		f, _ := NewField(modulus)
+		x, y := randomElement(f), randomElement(f)
+		b.Run(f.Modulus().Text(16)[:8], func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.Mul(x, y)
+			}
+		})
+	}
+}
+
+// BenchmarkElementVsGenerated compares the arithmetic with the one of the generated bn254 fr package
+func BenchmarkElementVsGenerated(b *testing.B) {
+	f, _ := NewField(bn254fr.Modulus())
+	x, y := randomElement(f), randomElement(f)
+	var gx, gy bn254fr.Element
+	gx.SetRandom()
+	gy.SetRandom()
+
+	b.Run("Mul/dynamic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.Mul(x, y)
+		}
+	})
+	b.Run("Mul/bn254fr", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			gx.Mul(&gx, &gy)
+		}
+	})
+	b.Run("Add/dynamic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.Add(x, y)
+		}
+	})
+	b.Run("Add/bn254fr", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			gx.Add(&gx, &gy)
+		}
+	})
+	b.Run("Inverse/dynamic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.Inverse(x)
+		}
+	})
+	b.Run("Inverse/bn254fr", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			gx.Inverse(&gx)
+		}
+	})
+}
+
+func TestLargeElementCopies(t *testing.T) {
+	assert := require.New(t)
+
+	// the words of the elements of fields of more than SmallNbWords words are shared by the copies
+	f, err := NewField(bw6761.Modulus())
+	assert.NoError(err)
+	x := randomElement(f)
+	expected := x.String()
+
+	y := *x
+	y.Mul(&y, &y)
+	y.Add(&y, x)
+	assert.Equal(expected, x.String(), "x changed with its copy")
+
+	one := f.One()
+	one.Double(&one)
+	one = f.One()
+	assert.True(one.IsOne(), "One changed with its copy")
+
+	var z Element
+	z.Set(x).Square(&z)
+	var expectedSquare Element
+	expectedSquare.Mul(x, x)
+	assert.True(z.Equal(&expectedSquare), "z.Square(z)")
+	zero := f.Zero()
+	assert.True(zero.Equal(new(Element).Sub(x, x)), "x - x")
+}
+
+func TestMulUnrolled(t *testing.T) {
+	assert := require.New(t)
+
+	for _, f := range testModuli(t) {
+		for i := 0; i < nbFuzz; i++ {
+			x, y := randomElement(f), randomElement(f)
+			var z1, z2 limbs
+			f.mul(z1[:f.nbWords], x.words(), y.words())
+			f.mulGeneric(z2[:f.nbWords], x.words(), y.words())
+			assert.Equal(z2, z1)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package dynamic
+
+import (
+	"errors"
+	"math/big"
+)
+
+// MaxNbWords is the maximum number of 64-bit words of the modulus of a Field
+const MaxNbWords = 12
+
+// SmallNbWords is the largest number of 64-bit words of the modulus of a Field whose elements
+// are stored without allocation
+const SmallNbWords = 6
+
+var (
+	errNotPrime        = errors.New("dynamic: modulus must be an odd prime")
+	errModulusTooLarge = errors.New("dynamic: modulus must fit on MaxNbWords words")
+)
+
+// Field is a prime field whose modulus is known at runtime.
+type Field struct {
+	modulus big.Int
+	q       limbs
+	qInvNeg uint64 // -q⁻¹ mod 2⁶⁴
+	nbWords int
+	nbBits  int
+	nbBytes int
+
+	one     limbs // R mod q, where R = 2^(64·nbWords)
+	rSquare limbs // R² mod q
+
+	legendreExponent big.Int // (q-1)/2
+
+	// Tonelli-Shanks parameters: q-1 = 2^sqrtS · t, with t odd
+	sqrtS              int
+	sqrtTMinusOneOver2 big.Int // (t-1)/2
+	sqrtG              limbs   // a non-residue raised to the power t, in Montgomery form
+}
+
+// the constants of the field are shared by the large elements, and must not be modified once set
+
+// NewField returns the prime field of the given modulus.
+//
+// It returns an error if the modulus is not an odd prime, or doesn't fit on MaxNbWords words.
+func NewField(modulus *big.Int) (*Field, error) {
+	if modulus.Sign() <= 0 || modulus.Bit(0) == 0 || !modulus.ProbablyPrime(20) {
+		return nil, errNotPrime
+	}
+	if modulus.BitLen() > 64*MaxNbWords {
+		return nil, errModulusTooLarge
+	}
+
+	f := &Field{}
+	f.modulus.Set(modulus)
+	f.nbBits = modulus.BitLen()
+	f.nbWords = (f.nbBits + 63) / 64
+	f.nbBytes = f.nbWords * 8
+	f.q = toLimbs(modulus)
+
+	// Newton iteration for q⁻¹ mod 2⁶⁴; each step doubles the number of correct bits
+	inv := uint64(1)
+	for i := 0; i < 6; i++ {
+		inv *= 2 - f.q[0]*inv
+	}
+	f.qInvNeg = -inv
+
+	var r big.Int
+	r.Lsh(big.NewInt(1), uint(64*f.nbWords)).Mod(&r, modulus)
+	f.one = toLimbs(&r)
+	r.Mul(&r, &r).Mod(&r, modulus)
+	f.rSquare = toLimbs(&r)
+
+	var qMinusOne big.Int
+	qMinusOne.Sub(modulus, big.NewInt(1))
+	f.legendreExponent.Rsh(&qMinusOne, 1)
+
+	f.sqrtS = int(qMinusOne.TrailingZeroBits())
+	var t big.Int
+	t.Rsh(&qMinusOne, uint(f.sqrtS))
+	f.sqrtTMinusOneOver2.Rsh(&t, 1)
+
+	// smallest non-residue
+	var z Element
+	for i := uint64(2); ; i++ {
+		z = f.NewElement(i)
+		if z.Legendre() == -1 {
+			break
+		}
+	}
+	z.Exp(z, &t)
+	copy(f.sqrtG[:], z.words())
+
+	return f, nil
+}
+
+// Modulus returns q as a new big.Int
+func (f *Field) Modulus() *big.Int {
+	return new(big.Int).Set(&f.modulus)
+}
+
+// NbWords returns the number of 64-bit words needed to represent an element
+func (f *Field) NbWords() int {
+	return f.nbWords
+}
+
+// NbBits returns the number of bits needed to represent an element
+func (f *Field) NbBits() int {
+	return f.nbBits
+}
+
+// NbBytes returns the number of bytes needed to represent an element
+func (f *Field) NbBytes() int {
+	return f.nbBytes
+}
+
+// NewElement returns a new Element with given value
+func (f *Field) NewElement(v uint64) Element {
+	var l limbs
+	l[0] = v
+	if f.nbWords == 1 {
+		l[0] %= f.q[0]
+	}
+	var z Element
+	f.mul(z.result(f), l[:f.nbWords], f.rSquare[:f.nbWords])
+	return z
+}
+
+// Zero returns 0 in the field
+func (f *Field) Zero() Element {
+	return Element{field: f}
+}
+
+// One returns 1 in the field
+func (f *Field) One() Element {
+	var z Element
+	z.setWords(f, &f.one)
+	return z
+}
+
+// NewVector returns a vector of n zero elements of the field
+func (f *Field) NewVector(n int) Vector {
+	v := make(Vector, n)
+	for i := range v {
+		v[i].field = f
+	}
+	return v
+}
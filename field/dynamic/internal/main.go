@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/dynamic/internal/templates"
+)
+
+// maxUnrolled is the largest number of words for which the multiplication is unrolled; it is
+// dynamic.SmallNbWords, so that mulSmall covers the small fields
+const maxUnrolled = 6
+
+//go:generate go run main.go
+func main() {
+	const outputDir = "../"
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package("dynamic"),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	data := struct{ Sizes []int }{}
+	for n := 1; n <= maxUnrolled; n++ {
+		data.Sizes = append(data.Sizes, n)
+	}
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, "mul.go"), []string{templates.Mul}, data, bavardOpts...); err != nil {
+		panic(err)
+	}
+
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated dynamic field multiplication")
+}
@@ -0,0 +1,110 @@
+package templates
+
+// Mul is the template of the Montgomery multiplication unrolled for small numbers of words
+const Mul = `
+import "math/bits"
+
+// mul sets z = x·y·R⁻¹ mod q
+//
+// z, x and y have the number of words of the field.
+func (f *Field) mul(z, x, y []uint64) {
+	switch f.nbWords {
+	{{- range $n := .Sizes}}
+	case {{$n}}:
+		mul{{$n}}((*[{{$n}}]uint64)(z), (*[{{$n}}]uint64)(x), (*[{{$n}}]uint64)(y), (*[{{$n}}]uint64)(f.q[:{{$n}}]), f.qInvNeg)
+	{{- end}}
+	default:
+		f.mulGeneric(z, x, y)
+	}
+}
+
+// mulSmall sets z = x·y·R⁻¹ mod q, for the fields of at most SmallNbWords words
+func (f *Field) mulSmall(z, x, y *[SmallNbWords]uint64) {
+	switch f.nbWords {
+	{{- range $n := .Sizes}}
+	case {{$n}}:
+		mul{{$n}}((*[{{$n}}]uint64)(z[:{{$n}}]), (*[{{$n}}]uint64)(x[:{{$n}}]), (*[{{$n}}]uint64)(y[:{{$n}}]), (*[{{$n}}]uint64)(f.q[:{{$n}}]), f.qInvNeg)
+	{{- end}}
+	}
+}
+
+{{- range $n := .Sizes}}
+
+// mul{{$n}} is the CIOS Montgomery multiplication on {{$n}} word{{if ne $n 1}}s{{end}}, unrolled
+func mul{{$n}}(z, x, y, q *[{{$n}}]uint64, qInvNeg uint64) {
+	var t [{{add $n 1}}]uint64
+	var D, C, m uint64
+	{{- range $i := iterate 0 $n}}
+
+	// -----------------------------------
+	// t += x·y[{{$i}}]
+	{{- if eq $i 0}}
+	C, t[0] = bits.Mul64(y[0], x[0])
+	{{- range $j := iterate 1 $n}}
+	C, t[{{$j}}] = madd1(y[0], x[{{$j}}], C)
+	{{- end}}
+	{{- else}}
+	C, t[0] = madd1(y[{{$i}}], x[0], t[0])
+	{{- range $j := iterate 1 $n}}
+	C, t[{{$j}}] = madd2(y[{{$i}}], x[{{$j}}], t[{{$j}}], C)
+	{{- end}}
+	{{- end}}
+	t[{{$n}}], D = bits.Add64(t[{{$n}}], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	{{- range $j := iterate 1 $n}}
+	C, t[{{sub $j 1}}] = madd2(m, q[{{$j}}], t[{{$j}}], C)
+	{{- end}}
+	t[{{sub $n 1}}], C = bits.Add64(t[{{$n}}], C, 0)
+	t[{{$n}}], _ = bits.Add64(0, D, C)
+	{{- end}}
+
+	// t < 2q; we subtract q unless t < q, that is unless t - q borrows without a carry in t[{{$n}}]
+	var b uint64
+	var u [{{$n}}]uint64
+	{{- range $j := iterate 0 $n}}
+	u[{{$j}}], b = bits.Sub64(t[{{$j}}], q[{{$j}}], b)
+	{{- end}}
+	if t[{{$n}}] == 0 && b != 0 {
+		{{- range $j := iterate 0 $n}}
+		z[{{$j}}] = t[{{$j}}]
+		{{- end}}
+		return
+	}
+	{{- range $j := iterate 0 $n}}
+	z[{{$j}}] = u[{{$j}}]
+	{{- end}}
+}
+{{- end}}
+
+// madd0 hi = a*b + c (discards lo bits)
+func madd0(a, b, c uint64) (hi uint64) {
+	var carry, lo uint64
+	hi, lo = bits.Mul64(a, b)
+	_, carry = bits.Add64(lo, c, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	return
+}
+
+// madd1 hi, lo = a*b + c
+func madd1(a, b, c uint64) (hi uint64, lo uint64) {
+	var carry uint64
+	hi, lo = bits.Mul64(a, b)
+	lo, carry = bits.Add64(lo, c, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	return
+}
+
+// madd2 hi, lo = a*b + c + d
+func madd2(a, b, c, d uint64) (hi uint64, lo uint64) {
+	var carry uint64
+	hi, lo = bits.Mul64(a, b)
+	c, carry = bits.Add64(c, d, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	lo, carry = bits.Add64(lo, c, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	return
+}
+`
@@ -0,0 +1,600 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package dynamic
+
+import "math/bits"
+
+// mul sets z = x·y·R⁻¹ mod q
+//
+// z, x and y have the number of words of the field.
+func (f *Field) mul(z, x, y []uint64) {
+	switch f.nbWords {
+	case 1:
+		mul1((*[1]uint64)(z), (*[1]uint64)(x), (*[1]uint64)(y), (*[1]uint64)(f.q[:1]), f.qInvNeg)
+	case 2:
+		mul2((*[2]uint64)(z), (*[2]uint64)(x), (*[2]uint64)(y), (*[2]uint64)(f.q[:2]), f.qInvNeg)
+	case 3:
+		mul3((*[3]uint64)(z), (*[3]uint64)(x), (*[3]uint64)(y), (*[3]uint64)(f.q[:3]), f.qInvNeg)
+	case 4:
+		mul4((*[4]uint64)(z), (*[4]uint64)(x), (*[4]uint64)(y), (*[4]uint64)(f.q[:4]), f.qInvNeg)
+	case 5:
+		mul5((*[5]uint64)(z), (*[5]uint64)(x), (*[5]uint64)(y), (*[5]uint64)(f.q[:5]), f.qInvNeg)
+	case 6:
+		mul6((*[6]uint64)(z), (*[6]uint64)(x), (*[6]uint64)(y), (*[6]uint64)(f.q[:6]), f.qInvNeg)
+	default:
+		f.mulGeneric(z, x, y)
+	}
+}
+
+// mulSmall sets z = x·y·R⁻¹ mod q, for the fields of at most SmallNbWords words
+func (f *Field) mulSmall(z, x, y *[SmallNbWords]uint64) {
+	switch f.nbWords {
+	case 1:
+		mul1((*[1]uint64)(z[:1]), (*[1]uint64)(x[:1]), (*[1]uint64)(y[:1]), (*[1]uint64)(f.q[:1]), f.qInvNeg)
+	case 2:
+		mul2((*[2]uint64)(z[:2]), (*[2]uint64)(x[:2]), (*[2]uint64)(y[:2]), (*[2]uint64)(f.q[:2]), f.qInvNeg)
+	case 3:
+		mul3((*[3]uint64)(z[:3]), (*[3]uint64)(x[:3]), (*[3]uint64)(y[:3]), (*[3]uint64)(f.q[:3]), f.qInvNeg)
+	case 4:
+		mul4((*[4]uint64)(z[:4]), (*[4]uint64)(x[:4]), (*[4]uint64)(y[:4]), (*[4]uint64)(f.q[:4]), f.qInvNeg)
+	case 5:
+		mul5((*[5]uint64)(z[:5]), (*[5]uint64)(x[:5]), (*[5]uint64)(y[:5]), (*[5]uint64)(f.q[:5]), f.qInvNeg)
+	case 6:
+		mul6((*[6]uint64)(z[:6]), (*[6]uint64)(x[:6]), (*[6]uint64)(y[:6]), (*[6]uint64)(f.q[:6]), f.qInvNeg)
+	}
+}
+
+// mul1 is the CIOS Montgomery multiplication on 1 word, unrolled
+func mul1(z, x, y, q *[1]uint64, qInvNeg uint64) {
+	var t [2]uint64
+	var D, C, m uint64
+
+	// -----------------------------------
+	// t += x·y[0]
+	C, t[0] = bits.Mul64(y[0], x[0])
+	t[1], D = bits.Add64(t[1], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	t[0], C = bits.Add64(t[1], C, 0)
+	t[1], _ = bits.Add64(0, D, C)
+
+	// t < 2q; we subtract q unless t < q, that is unless t - q borrows without a carry in t[1]
+	var b uint64
+	var u [1]uint64
+	u[0], b = bits.Sub64(t[0], q[0], b)
+	if t[1] == 0 && b != 0 {
+		z[0] = t[0]
+		return
+	}
+	z[0] = u[0]
+}
+
+// mul2 is the CIOS Montgomery multiplication on 2 words, unrolled
+func mul2(z, x, y, q *[2]uint64, qInvNeg uint64) {
+	var t [3]uint64
+	var D, C, m uint64
+
+	// -----------------------------------
+	// t += x·y[0]
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	t[2], D = bits.Add64(t[2], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	t[1], C = bits.Add64(t[2], C, 0)
+	t[2], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[1]
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	t[2], D = bits.Add64(t[2], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	t[1], C = bits.Add64(t[2], C, 0)
+	t[2], _ = bits.Add64(0, D, C)
+
+	// t < 2q; we subtract q unless t < q, that is unless t - q borrows without a carry in t[2]
+	var b uint64
+	var u [2]uint64
+	u[0], b = bits.Sub64(t[0], q[0], b)
+	u[1], b = bits.Sub64(t[1], q[1], b)
+	if t[2] == 0 && b != 0 {
+		z[0] = t[0]
+		z[1] = t[1]
+		return
+	}
+	z[0] = u[0]
+	z[1] = u[1]
+}
+
+// mul3 is the CIOS Montgomery multiplication on 3 words, unrolled
+func mul3(z, x, y, q *[3]uint64, qInvNeg uint64) {
+	var t [4]uint64
+	var D, C, m uint64
+
+	// -----------------------------------
+	// t += x·y[0]
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	t[3], D = bits.Add64(t[3], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	t[2], C = bits.Add64(t[3], C, 0)
+	t[3], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[1]
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	t[3], D = bits.Add64(t[3], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	t[2], C = bits.Add64(t[3], C, 0)
+	t[3], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[2]
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	t[3], D = bits.Add64(t[3], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	t[2], C = bits.Add64(t[3], C, 0)
+	t[3], _ = bits.Add64(0, D, C)
+
+	// t < 2q; we subtract q unless t < q, that is unless t - q borrows without a carry in t[3]
+	var b uint64
+	var u [3]uint64
+	u[0], b = bits.Sub64(t[0], q[0], b)
+	u[1], b = bits.Sub64(t[1], q[1], b)
+	u[2], b = bits.Sub64(t[2], q[2], b)
+	if t[3] == 0 && b != 0 {
+		z[0] = t[0]
+		z[1] = t[1]
+		z[2] = t[2]
+		return
+	}
+	z[0] = u[0]
+	z[1] = u[1]
+	z[2] = u[2]
+}
+
+// mul4 is the CIOS Montgomery multiplication on 4 words, unrolled
+func mul4(z, x, y, q *[4]uint64, qInvNeg uint64) {
+	var t [5]uint64
+	var D, C, m uint64
+
+	// -----------------------------------
+	// t += x·y[0]
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	C, t[3] = madd1(y[0], x[3], C)
+	t[4], D = bits.Add64(t[4], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	t[3], C = bits.Add64(t[4], C, 0)
+	t[4], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[1]
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	C, t[3] = madd2(y[1], x[3], t[3], C)
+	t[4], D = bits.Add64(t[4], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	t[3], C = bits.Add64(t[4], C, 0)
+	t[4], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[2]
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	C, t[3] = madd2(y[2], x[3], t[3], C)
+	t[4], D = bits.Add64(t[4], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	t[3], C = bits.Add64(t[4], C, 0)
+	t[4], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[3]
+	C, t[0] = madd1(y[3], x[0], t[0])
+	C, t[1] = madd2(y[3], x[1], t[1], C)
+	C, t[2] = madd2(y[3], x[2], t[2], C)
+	C, t[3] = madd2(y[3], x[3], t[3], C)
+	t[4], D = bits.Add64(t[4], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	t[3], C = bits.Add64(t[4], C, 0)
+	t[4], _ = bits.Add64(0, D, C)
+
+	// t < 2q; we subtract q unless t < q, that is unless t - q borrows without a carry in t[4]
+	var b uint64
+	var u [4]uint64
+	u[0], b = bits.Sub64(t[0], q[0], b)
+	u[1], b = bits.Sub64(t[1], q[1], b)
+	u[2], b = bits.Sub64(t[2], q[2], b)
+	u[3], b = bits.Sub64(t[3], q[3], b)
+	if t[4] == 0 && b != 0 {
+		z[0] = t[0]
+		z[1] = t[1]
+		z[2] = t[2]
+		z[3] = t[3]
+		return
+	}
+	z[0] = u[0]
+	z[1] = u[1]
+	z[2] = u[2]
+	z[3] = u[3]
+}
+
+// mul5 is the CIOS Montgomery multiplication on 5 words, unrolled
+func mul5(z, x, y, q *[5]uint64, qInvNeg uint64) {
+	var t [6]uint64
+	var D, C, m uint64
+
+	// -----------------------------------
+	// t += x·y[0]
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	C, t[3] = madd1(y[0], x[3], C)
+	C, t[4] = madd1(y[0], x[4], C)
+	t[5], D = bits.Add64(t[5], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	t[4], C = bits.Add64(t[5], C, 0)
+	t[5], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[1]
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	C, t[3] = madd2(y[1], x[3], t[3], C)
+	C, t[4] = madd2(y[1], x[4], t[4], C)
+	t[5], D = bits.Add64(t[5], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	t[4], C = bits.Add64(t[5], C, 0)
+	t[5], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[2]
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	C, t[3] = madd2(y[2], x[3], t[3], C)
+	C, t[4] = madd2(y[2], x[4], t[4], C)
+	t[5], D = bits.Add64(t[5], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	t[4], C = bits.Add64(t[5], C, 0)
+	t[5], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[3]
+	C, t[0] = madd1(y[3], x[0], t[0])
+	C, t[1] = madd2(y[3], x[1], t[1], C)
+	C, t[2] = madd2(y[3], x[2], t[2], C)
+	C, t[3] = madd2(y[3], x[3], t[3], C)
+	C, t[4] = madd2(y[3], x[4], t[4], C)
+	t[5], D = bits.Add64(t[5], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	t[4], C = bits.Add64(t[5], C, 0)
+	t[5], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[4]
+	C, t[0] = madd1(y[4], x[0], t[0])
+	C, t[1] = madd2(y[4], x[1], t[1], C)
+	C, t[2] = madd2(y[4], x[2], t[2], C)
+	C, t[3] = madd2(y[4], x[3], t[3], C)
+	C, t[4] = madd2(y[4], x[4], t[4], C)
+	t[5], D = bits.Add64(t[5], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	t[4], C = bits.Add64(t[5], C, 0)
+	t[5], _ = bits.Add64(0, D, C)
+
+	// t < 2q; we subtract q unless t < q, that is unless t - q borrows without a carry in t[5]
+	var b uint64
+	var u [5]uint64
+	u[0], b = bits.Sub64(t[0], q[0], b)
+	u[1], b = bits.Sub64(t[1], q[1], b)
+	u[2], b = bits.Sub64(t[2], q[2], b)
+	u[3], b = bits.Sub64(t[3], q[3], b)
+	u[4], b = bits.Sub64(t[4], q[4], b)
+	if t[5] == 0 && b != 0 {
+		z[0] = t[0]
+		z[1] = t[1]
+		z[2] = t[2]
+		z[3] = t[3]
+		z[4] = t[4]
+		return
+	}
+	z[0] = u[0]
+	z[1] = u[1]
+	z[2] = u[2]
+	z[3] = u[3]
+	z[4] = u[4]
+}
+
+// mul6 is the CIOS Montgomery multiplication on 6 words, unrolled
+func mul6(z, x, y, q *[6]uint64, qInvNeg uint64) {
+	var t [7]uint64
+	var D, C, m uint64
+
+	// -----------------------------------
+	// t += x·y[0]
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	C, t[3] = madd1(y[0], x[3], C)
+	C, t[4] = madd1(y[0], x[4], C)
+	C, t[5] = madd1(y[0], x[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	C, t[4] = madd2(m, q[5], t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[1]
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	C, t[3] = madd2(y[1], x[3], t[3], C)
+	C, t[4] = madd2(y[1], x[4], t[4], C)
+	C, t[5] = madd2(y[1], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	C, t[4] = madd2(m, q[5], t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[2]
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	C, t[3] = madd2(y[2], x[3], t[3], C)
+	C, t[4] = madd2(y[2], x[4], t[4], C)
+	C, t[5] = madd2(y[2], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	C, t[4] = madd2(m, q[5], t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[3]
+	C, t[0] = madd1(y[3], x[0], t[0])
+	C, t[1] = madd2(y[3], x[1], t[1], C)
+	C, t[2] = madd2(y[3], x[2], t[2], C)
+	C, t[3] = madd2(y[3], x[3], t[3], C)
+	C, t[4] = madd2(y[3], x[4], t[4], C)
+	C, t[5] = madd2(y[3], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	C, t[4] = madd2(m, q[5], t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[4]
+	C, t[0] = madd1(y[4], x[0], t[0])
+	C, t[1] = madd2(y[4], x[1], t[1], C)
+	C, t[2] = madd2(y[4], x[2], t[2], C)
+	C, t[3] = madd2(y[4], x[3], t[3], C)
+	C, t[4] = madd2(y[4], x[4], t[4], C)
+	C, t[5] = madd2(y[4], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	C, t[4] = madd2(m, q[5], t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// -----------------------------------
+	// t += x·y[5]
+	C, t[0] = madd1(y[5], x[0], t[0])
+	C, t[1] = madd2(y[5], x[1], t[1], C)
+	C, t[2] = madd2(y[5], x[2], t[2], C)
+	C, t[3] = madd2(y[5], x[3], t[3], C)
+	C, t[4] = madd2(y[5], x[4], t[4], C)
+	C, t[5] = madd2(y[5], x[5], t[5], C)
+	t[6], D = bits.Add64(t[6], C, 0)
+
+	// t = (t + m·q) / 2⁶⁴
+	m = t[0] * qInvNeg
+	C = madd0(m, q[0], t[0])
+	C, t[0] = madd2(m, q[1], t[1], C)
+	C, t[1] = madd2(m, q[2], t[2], C)
+	C, t[2] = madd2(m, q[3], t[3], C)
+	C, t[3] = madd2(m, q[4], t[4], C)
+	C, t[4] = madd2(m, q[5], t[5], C)
+	t[5], C = bits.Add64(t[6], C, 0)
+	t[6], _ = bits.Add64(0, D, C)
+
+	// t < 2q; we subtract q unless t < q, that is unless t - q borrows without a carry in t[6]
+	var b uint64
+	var u [6]uint64
+	u[0], b = bits.Sub64(t[0], q[0], b)
+	u[1], b = bits.Sub64(t[1], q[1], b)
+	u[2], b = bits.Sub64(t[2], q[2], b)
+	u[3], b = bits.Sub64(t[3], q[3], b)
+	u[4], b = bits.Sub64(t[4], q[4], b)
+	u[5], b = bits.Sub64(t[5], q[5], b)
+	if t[6] == 0 && b != 0 {
+		z[0] = t[0]
+		z[1] = t[1]
+		z[2] = t[2]
+		z[3] = t[3]
+		z[4] = t[4]
+		z[5] = t[5]
+		return
+	}
+	z[0] = u[0]
+	z[1] = u[1]
+	z[2] = u[2]
+	z[3] = u[3]
+	z[4] = u[4]
+	z[5] = u[5]
+}
+
+// madd0 hi = a*b + c (discards lo bits)
+func madd0(a, b, c uint64) (hi uint64) {
+	var carry, lo uint64
+	hi, lo = bits.Mul64(a, b)
+	_, carry = bits.Add64(lo, c, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	return
+}
+
+// madd1 hi, lo = a*b + c
+func madd1(a, b, c uint64) (hi uint64, lo uint64) {
+	var carry uint64
+	hi, lo = bits.Mul64(a, b)
+	lo, carry = bits.Add64(lo, c, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	return
+}
+
+// madd2 hi, lo = a*b + c + d
+func madd2(a, b, c, d uint64) (hi uint64, lo uint64) {
+	var carry uint64
+	hi, lo = bits.Mul64(a, b)
+	c, carry = bits.Add64(c, d, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	lo, carry = bits.Add64(lo, c, 0)
+	hi, _ = bits.Add64(hi, 0, carry)
+	return
+}
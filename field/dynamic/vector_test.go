@@ -0,0 +1,179 @@
+package dynamic
+
+import (
+	"math/big"
+	"reflect"
+	"sort"
+	"testing"
+
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	f, err := NewField(goldilocks.Modulus())
+	assert.NoError(err)
+
+	v := f.NewVector(3)
+	v[0].SetUint64(2)
+	v[1].SetUint64(3)
+	v[2].SetUint64(1)
+
+	sort.Sort(v)
+
+	assert.Equal("[1,2,3]", v.String())
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	for _, f := range testModuli(t) {
+		for _, size := range []int{0, 3} {
+			v1 := f.NewVector(size)
+			for i := range v1 {
+				v1[i].SetRandom()
+			}
+
+			b, err := v1.MarshalBinary()
+			assert.NoError(err)
+			assert.Equal(4+size*f.NbBytes(), len(b))
+
+			v2, err := f.UnmarshalVector(b)
+			assert.NoError(err)
+			assert.True(reflect.DeepEqual(v1, v2))
+
+			_, err = f.UnmarshalVector(append(b, 0))
+			assert.Error(err, "trailing bytes")
+			if size > 0 {
+				_, err = f.UnmarshalVector(b[:len(b)-1])
+				assert.Error(err, "truncated input")
+			}
+		}
+	}
+}
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	for _, f := range testModuli(t) {
+		for _, size := range []int{0, 1, 7, 1<<11 + 3} {
+			a, b, c := f.NewVector(size), f.NewVector(size), f.NewVector(size)
+			for i := 0; i < size; i++ {
+				a[i].SetRandom()
+				b[i].SetRandom()
+			}
+			s := randomElement(f)
+			k := big.NewInt(-5)
+
+			var tmp Element
+			expected := f.Zero()
+
+			c.Add(a, b)
+			for i := 0; i < size; i++ {
+				expected.Add(&a[i], &b[i])
+				assert.True(c[i].Equal(&expected), "Add")
+			}
+
+			c.Sub(a, b)
+			for i := 0; i < size; i++ {
+				expected.Sub(&a[i], &b[i])
+				assert.True(c[i].Equal(&expected), "Sub")
+			}
+
+			c.Mul(a, b)
+			for i := 0; i < size; i++ {
+				expected.Mul(&a[i], &b[i])
+				assert.True(c[i].Equal(&expected), "Mul")
+			}
+
+			c.ScalarMul(a, s)
+			for i := 0; i < size; i++ {
+				expected.Mul(&a[i], s)
+				assert.True(c[i].Equal(&expected), "ScalarMul")
+			}
+
+			c.Exp(a, k)
+			for i := 0; i < size; i++ {
+				expected.Exp(a[i], k)
+				assert.True(c[i].Equal(&expected), "Exp")
+			}
+
+			expected.SetZero()
+			for i := 0; i < size; i++ {
+				expected.Add(&expected, &a[i])
+			}
+			sum := a.Sum()
+			assert.True(sum.Equal(&expected), "Sum")
+
+			expected.SetZero()
+			for i := 0; i < size; i++ {
+				tmp.Mul(&a[i], &b[i])
+				expected.Add(&expected, &tmp)
+			}
+			ip := a.InnerProduct(b)
+			assert.True(ip.Equal(&expected), "InnerProduct")
+
+			// in place operations
+			c = f.NewVector(size)
+			copy(c, a)
+			c.Add(c, b)
+			c.Sub(c, b)
+			assert.True(reflect.DeepEqual(a, c), "in place Add / Sub")
+		}
+	}
+}
+
+func TestVectorOpsPanic(t *testing.T) {
+	assert := require.New(t)
+
+	f, err := NewField(goldilocks.Modulus())
+	assert.NoError(err)
+
+	a, b := f.NewVector(3), f.NewVector(4)
+	s := f.Zero()
+
+	assert.Panics(func() { a.Add(a, b) })
+	assert.Panics(func() { a.Sub(a, b) })
+	assert.Panics(func() { a.Mul(a, b) })
+	assert.Panics(func() { b.ScalarMul(a, &s) })
+	assert.Panics(func() { b.Exp(a, big.NewInt(2)) })
+	assert.Panics(func() { a.InnerProduct(b) })
+}
+
+// BenchmarkVectorVsGenerated compares the vector arithmetic with the one of the generated bn254 fr package
+func BenchmarkVectorVsGenerated(b *testing.B) {
+	const n = 1 << 16
+	f, _ := NewField(bn254fr.Modulus())
+	a, c := f.NewVector(n), f.NewVector(n)
+	ga, gc := make(bn254fr.Vector, n), make(bn254fr.Vector, n)
+	for i := 0; i < n; i++ {
+		a[i].SetRandom()
+		c[i].SetRandom()
+		ga[i].SetRandom()
+		gc[i].SetRandom()
+	}
+
+	b.Run("Add/dynamic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.Add(a, c)
+		}
+	})
+	b.Run("Add/bn254fr", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ga.Add(ga, gc)
+		}
+	})
+	b.Run("InnerProduct/dynamic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.InnerProduct(c)
+		}
+	})
+	b.Run("InnerProduct/bn254fr", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ga.InnerProduct(gc)
+		}
+	})
+}
@@ -158,6 +158,11 @@ func Generate(w io.Writer, F *config.FieldConfig) error {
 	// fft butterflies
 	f.generateButterfly()
 
+	// vector operations
+	if F.ASMVector {
+		f.generateVec()
+	}
+
 	return nil
 }
 
@@ -170,11 +175,23 @@ func GenerateMul(w io.Writer, F *config.FieldConfig) error {
 	f.WriteLn("")
 	f.GenerateDefines()
 
-	// mul
-	f.generateMul(false)
+	if F.SpecialReduction {
+		// c, such that q = 2ᵏ - c
+		f.WriteLn(fmt.Sprintf("DATA c<>(SB)/8, $%#016x", F.SpecialForm.C))
+		f.WriteLn("GLOBL c<>(SB), (RODATA+NOPTR), $8")
+		f.WriteLn("")
+
+		// mul
+		f.generateMulSpecial()
+	} else {
+		// mul
+		f.generateMul(false)
+	}
 
 	// from mont
-	f.generateFromMont(false)
+	if F.ASM {
+		f.generateFromMont(false)
+	}
 
 	return nil
 }
@@ -0,0 +1,222 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// generateMulSpecial generates the multiplication for moduli of the special form q = 2ᵏ - c
+// (see config.SpecialForm).
+//
+// The running result t is kept on NbWords+1 words (tN holds the extra word), since the
+// modulus doesn't necessarily leave a spare bit in the most significant word.
+func (f *FFAmd64) generateMulSpecial() {
+	f.Comment("mul(res, x, y *Element)")
+
+	const argSize = 3 * 8
+	reserved := []amd64.Register{amd64.DX, amd64.AX}
+	if f.NbWords <= 5 {
+		// when dynamic linking, R15 is clobbered by a global variable access
+		// this is a temporary workaround --> don't use R15 when we can avoid it.
+		// see https://github.com/ConsenSys/gnark-crypto/issues/113
+		reserved = append(reserved, amd64.R15)
+	}
+	// the final reduction needs t[0..N] and N scratch registers
+	stackSize := f.StackSize(f.NbWords*2+1, len(reserved), argSize)
+	registers := f.FnHeader("mul", stackSize, argSize, reserved...)
+	defer f.AssertCleanStack(stackSize, argSize)
+
+	f.WriteLn(fmt.Sprintf(`
+	// the algorithm is described in the %s.Mul declaration (.go)
+	// q = 2^%d - c, and since q = -c mod W, in each step
+	// (t + m*q) / W = t/W - hi(m*c) + m*2^%d
+	// for i=0 to N-1
+	// 		for j=0 to N-1
+	// 		    (A,t[j])  := t[j] + x[j]*y[i] + A
+	// 		(t[N],A) := A + t[N]
+	// 		m := t[0]*q'[0] mod W
+	// 		hi, _ := m*c
+	// 		t := (t[1], ..., t[N-1], A, t[N]) - hi + m*2^%d
+	`, f.ElementName, f.SpecialForm.K, f.SpecialForm.K-64, f.SpecialForm.K-64))
+	f.WriteLn("NO_LOCAL_POINTERS")
+
+	noAdx := f.NewLabel()
+
+	// check ADX instruction support
+	f.CMPB("·supportAdx(SB)", 1)
+	f.JNE(noAdx)
+
+	{
+		t := registers.PopN(f.NbWords)
+		A := registers.Pop()
+		tN := registers.Pop()
+		hi := registers.Pop()
+		y := registers.Pop()
+		x := registers.Pop()
+
+		f.MOVQ("y+16(FP)", y)
+		f.MOVQ("x+8(FP)", x)
+
+		// x is stored in registers if we have enough of them
+		var xat func(int) string
+		var _x []amd64.Register
+		if registers.Available() >= f.NbWords {
+			_x = registers.PopN(f.NbWords)
+			f.LabelRegisters("x", _x...)
+			f.Mov(x, _x)
+			registers.Push(x)
+			xat = func(i int) string {
+				return string(_x[i])
+			}
+		} else {
+			_x = []amd64.Register{x}
+			xat = func(i int) string {
+				return x.At(i)
+			}
+		}
+
+		// tAt returns t[i], with t[N] stored in tN
+		tAt := func(i int) amd64.Register {
+			if i == f.NbWords {
+				return tN
+			}
+			return t[i]
+		}
+
+		for i := 0; i < f.NbWords; i++ {
+			f.Comment("clear the flags")
+			f.XORQ(amd64.AX, amd64.AX)
+
+			f.MOVQ(y.At(i), amd64.DX)
+
+			if i == 0 {
+				for j := 0; j < f.NbWords; j++ {
+					f.Comment(fmt.Sprintf("(A,t[%[1]d])  := x[%[1]d]*y[%[2]d] + A", j, i))
+
+					if j == 0 {
+						f.MULXQ(xat(j), t[j], t[j+1])
+					} else {
+						highBits := A
+						if j != f.NbWordsLastIndex {
+							highBits = t[j+1]
+						}
+						f.MULXQ(xat(j), amd64.AX, highBits)
+						f.ADOXQ(amd64.AX, t[j])
+					}
+				}
+			} else {
+				for j := 0; j < f.NbWords; j++ {
+					f.Comment(fmt.Sprintf("(A,t[%[1]d])  := t[%[1]d] + x[%[1]d]*y[%[2]d] + A", j, i))
+
+					if j != 0 {
+						f.ADCXQ(A, t[j])
+					}
+					f.MULXQ(xat(j), amd64.AX, A)
+					f.ADOXQ(amd64.AX, t[j])
+				}
+			}
+
+			f.Comment("A += carries from ADCXQ and ADOXQ")
+			f.MOVQ(0, amd64.AX)
+			if i != 0 {
+				f.ADCXQ(amd64.AX, A)
+			}
+			f.ADOXQ(amd64.AX, A)
+
+			if i == 0 {
+				f.MOVQ(0, tN)
+			} else {
+				f.Comment(fmt.Sprintf("(t[%d],A) := A + t[%[1]d]", f.NbWords))
+				f.ADDQ(tN, A)
+				f.MOVQ(0, tN)
+				f.ADCQ(0, tN)
+			}
+
+			f.Comment("m := t[0]*q'[0] mod W")
+			f.MOVQ(f.qInv0(), amd64.DX)
+			f.IMULQ(t[0], amd64.DX)
+
+			f.Comment("hi, _ := m*c, the low word is t[0]")
+			f.MULXQ("c<>(SB)", amd64.AX, hi)
+
+			// t := t/W; the register of t[0] is free and becomes A
+			t0 := t[0]
+			t = append(append([]amd64.Register{}, t[1:]...), A)
+			A = t0
+
+			f.Comment("t := t - hi")
+			f.SUBQ(hi, t[0])
+			for j := 1; j <= f.NbWords; j++ {
+				f.SBBQ(0, tAt(j))
+			}
+
+			w, s := f.SpecialForm.Word, f.SpecialForm.Shift
+			f.Comment(fmt.Sprintf("t := t + m*2^%d", f.SpecialForm.K-64))
+			if s == 0 {
+				f.ADDQ(amd64.DX, tAt(w))
+				w++
+			} else {
+				f.MOVQ(amd64.DX, amd64.AX)
+				f.WriteLn(fmt.Sprintf("    SHLQ $%d, DX", s))
+				f.SHRQ(fmt.Sprintf("$%d", 64-s), amd64.AX)
+				f.ADDQ(amd64.DX, tAt(w))
+				f.ADCQ(amd64.AX, tAt(w+1))
+				w += 2
+			}
+			for j := w; j <= f.NbWords; j++ {
+				f.ADCQ(0, tAt(j))
+			}
+		}
+
+		registers.Push(A, hi, y)
+		registers.Push(_x...)
+
+		// ---------------------------------------------------------------------------------------------
+		// reduce
+		f.Comment(fmt.Sprintf("t < 2q on %d words, reduce it", f.NbWords+1))
+		scratch := f.PopN(&registers)
+		f.MOVQ(t[0], scratch[0])
+		f.SUBQ(f.qAt(0), t[0])
+		for j := 1; j < f.NbWords; j++ {
+			f.MOVQ(t[j], scratch[j])
+			f.SBBQ(f.qAt(j), t[j])
+		}
+		f.SBBQ(0, tN)
+		for j := 0; j < f.NbWords; j++ {
+			f.CMOVQCS(scratch[j], t[j])
+		}
+		f.Push(&registers, scratch...)
+
+		f.MOVQ("res+0(FP)", amd64.AX)
+		f.Mov(t, amd64.AX)
+		f.RET()
+	}
+
+	// ---------------------------------------------------------------------------------------------
+	// no MULX, ADX instructions
+	f.LABEL(noAdx)
+
+	f.MOVQ("res+0(FP)", amd64.AX)
+	f.MOVQ(amd64.AX, "(SP)")
+	f.MOVQ("x+8(FP)", amd64.AX)
+	f.MOVQ(amd64.AX, "8(SP)")
+	f.MOVQ("y+16(FP)", amd64.AX)
+	f.MOVQ(amd64.AX, "16(SP)")
+	f.WriteLn("CALL ·_mulGeneric(SB)")
+	f.RET()
+}
@@ -0,0 +1,305 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// the vector kernels process n > 0 elements; the caller (see internal/templates/element/vector_ops)
+// handles empty vectors, the parallelism and the CPUs without ADX.
+
+// generateAddVec
+//
+//	func addVec(res, a, b *{{.ElementName}}, n uint64) {
+//		for i := 0; i < n; i++ {
+//			res[i].Add(&a[i], &b[i])
+//		}
+//	}
+func (f *FFAmd64) generateAddVec() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords+4, 0, 0)
+	registers := f.FnHeader("addVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	t := f.PopN(&registers)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+
+	// t = a + b; since q has a spare bit, it can't overflow
+	f.Mov(a, t)
+	f.Add(b, t)
+
+	// t = t - q if t >= q; res is used as scratch space for t
+	f.Mov(t, res)
+	f.subQ(t)
+	for i := 0; i < f.NbWords; i++ {
+		f.CMOVQCS(res.At(i), t[i])
+	}
+	f.Mov(t, res)
+
+	f.DECQ(n)
+	f.JEQ(done)
+	f.ADDQ(8*f.NbWords, a)
+	f.ADDQ(8*f.NbWords, b)
+	f.ADDQ(8*f.NbWords, res)
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, t...)
+	f.Push(&registers, res, a, b, n)
+}
+
+// generateSubVec
+//
+//	func subVec(res, a, b *{{.ElementName}}, n uint64) {
+//		for i := 0; i < n; i++ {
+//			res[i].Sub(&a[i], &b[i])
+//		}
+//	}
+func (f *FFAmd64) generateSubVec() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords+5, 0, 0)
+	registers := f.FnHeader("subVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers
+	res := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	n := f.Pop(&registers)
+	mask := f.Pop(&registers)
+	t := f.PopN(&registers)
+
+	f.MOVQ("res+0(FP)", res)
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+	f.MOVQ("n+24(FP)", n)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+
+	// t = a - b
+	f.Mov(a, t)
+	f.Sub(b, t)
+
+	// mask = 0xFFFF...FFFF if a < b, 0 otherwise
+	f.SBBQ(mask, mask)
+
+	// t = t + q if a < b; res is used as scratch space for t
+	f.Mov(t, res)
+	f.addQ(t)
+	f.TESTQ(mask, mask)
+	for i := 0; i < f.NbWords; i++ {
+		f.CMOVQEQ(res.At(i), t[i])
+	}
+	f.Mov(t, res)
+
+	f.DECQ(n)
+	f.JEQ(done)
+	f.ADDQ(8*f.NbWords, a)
+	f.ADDQ(8*f.NbWords, b)
+	f.ADDQ(8*f.NbWords, res)
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, t...)
+	f.Push(&registers, res, a, b, n, mask)
+}
+
+// generateMulVec generates mulVec, or scalarMulVec if scalar is set (b is then a single element)
+//
+//	func mulVec(res, a, b *{{.ElementName}}, n uint64) {
+//		for i := 0; i < n; i++ {
+//			res[i].Mul(&a[i], &b[i])
+//		}
+//	}
+//
+// res and n are kept in the arguments, and updated there.
+func (f *FFAmd64) generateMulVec(scalar bool) {
+	name := "mulVec"
+	if scalar {
+		name = "scalarMulVec"
+		f.Comment("scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b")
+	} else {
+		f.Comment("mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]")
+	}
+	f.Comment("requires the ADX and BMI2 instructions")
+
+	const argSize = 4 * 8
+	// MulADX uses BP: we need a frame for the assembler to save it
+	const minStackSize = 8
+	reserved := f.mulReserved()
+	stackSize := f.StackSize(f.NbWords*2+2, len(reserved), minStackSize)
+	registers := f.FnHeader(name, stackSize, argSize, reserved...)
+	defer f.AssertCleanStack(stackSize, minStackSize)
+	f.WriteLn("NO_LOCAL_POINTERS")
+
+	// registers
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	t := registers.PopN(f.NbWords)
+
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+
+	// t = a * b
+	f.MulADX(&registers, a.At, b.At, t)
+	f.Reduce(&registers, t)
+
+	f.MOVQ("res+0(FP)", amd64.AX)
+	f.Mov(t, amd64.AX)
+
+	f.DECQ("n+24(FP)")
+	f.JEQ(done)
+	f.ADDQ(8*f.NbWords, a)
+	if !scalar {
+		f.ADDQ(8*f.NbWords, b)
+	}
+	f.ADDQ(8*f.NbWords, "res+0(FP)")
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, t...)
+	f.Push(&registers, a, b)
+}
+
+// generateInnerProdVec
+//
+//	func innerProdVec(res, a, b *{{.ElementName}}, n uint64) {
+//		var t {{.ElementName}}
+//		for i := 0; i < n; i++ {
+//			t.Mul(&a[i], &b[i])
+//			res.Add(res, &t)
+//		}
+//	}
+func (f *FFAmd64) generateInnerProdVec() {
+	f.Comment("innerProdVec(res, a, b *Element, n uint64) res = res + a[0]*b[0] + ... + a[n-1]*b[n-1]")
+	f.Comment("requires the ADX and BMI2 instructions")
+
+	const argSize = 4 * 8
+	// MulADX uses BP: we need a frame for the assembler to save it
+	const minStackSize = 8
+	reserved := f.mulReserved()
+	stackSize := f.StackSize(f.NbWords*2+2, len(reserved), minStackSize)
+	registers := f.FnHeader("innerProdVec", stackSize, argSize, reserved...)
+	defer f.AssertCleanStack(stackSize, minStackSize)
+	f.WriteLn("NO_LOCAL_POINTERS")
+
+	// registers
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	t := registers.PopN(f.NbWords)
+
+	f.MOVQ("a+8(FP)", a)
+	f.MOVQ("b+16(FP)", b)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	f.LABEL(loop)
+
+	// t = a * b
+	f.MulADX(&registers, a.At, b.At, t)
+	f.Reduce(&registers, t)
+
+	// res = res + t
+	f.MOVQ("res+0(FP)", amd64.AX)
+	f.Add(amd64.AX, t)
+	f.Reduce(&registers, t)
+	f.Mov(t, amd64.AX)
+
+	f.DECQ("n+24(FP)")
+	f.JEQ(done)
+	f.ADDQ(8*f.NbWords, a)
+	f.ADDQ(8*f.NbWords, b)
+	f.JMP(loop)
+
+	f.LABEL(done)
+	f.RET()
+
+	f.Push(&registers, t...)
+	f.Push(&registers, a, b)
+}
+
+// mulReserved returns the registers that can't be used with MulADX
+func (f *FFAmd64) mulReserved() []amd64.Register {
+	reserved := []amd64.Register{amd64.DX, amd64.AX}
+	if f.NbWords <= 5 {
+		// R15 is clobbered by global variable accesses when dynamic linking, see generateMul
+		reserved = append(reserved, amd64.R15)
+	}
+	return reserved
+}
+
+// subQ sets t = t - q, and the carry flag if t < q
+func (f *FFAmd64) subQ(t []amd64.Register) {
+	f.SUBQ(f.qAt(0), t[0])
+	for i := 1; i < f.NbWords; i++ {
+		f.SBBQ(f.qAt(i), t[i])
+	}
+}
+
+// addQ sets t = t + q
+func (f *FFAmd64) addQ(t []amd64.Register) {
+	f.ADDQ(f.qAt(0), t[0])
+	for i := 1; i < f.NbWords; i++ {
+		f.ADCQ(f.qAt(i), t[i])
+	}
+}
+
+// generateVec generates the kernels of the Vector arithmetic
+func (f *FFAmd64) generateVec() {
+	if f.NbWords > SmallModulus {
+		panic(fmt.Sprintf("vector kernels are not supported for %d words", f.NbWords))
+	}
+	f.generateAddVec()
+	f.generateSubVec()
+	f.generateMulVec(false)
+	f.generateMulVec(true)
+	f.generateInnerProdVec()
+}
@@ -242,3 +242,13 @@ func NewElement(s []string) []big.Int {
 	}
 	return res
 }
+
+// BinomialExtension describes a binomial extension L = B[v]/(vⁿ - β), as generated by
+// generator.GenerateExtensions. The base B is either the prime field (Base == "")
+// or a previously described extension, which allows to build towers.
+type BinomialExtension struct {
+	Name       string   // name of the generated type, e.g. E2
+	Base       string   // name of the base extension; empty for the prime field
+	Degree     int      // n, 2 or 3
+	NonResidue []string // β, given by its coordinates over the prime field (base 10)
+}
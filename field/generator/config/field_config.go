@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/bits"
 	"strconv"
 	"strings"
 
@@ -72,6 +73,31 @@ type FieldConfig struct {
 	SqrtSMinusOneOver2Data    *addchain.AddChainData
 	SqrtQ3Mod4ExponentData    *addchain.AddChainData
 	UseAddChain               bool
+
+	// SpecialForm is set if the modulus is 2ᵏ - c with a small c; the dedicated reduction
+	// is used only if SpecialReduction is set (see EnableSpecialReduction)
+	SpecialForm      *SpecialForm
+	SpecialReduction bool
+	// ASMMul is set if the multiplication is implemented in amd64 assembly (always the case if ASM is set)
+	ASMMul bool
+	// ASMVector is set if the Vector arithmetic is implemented in amd64 assembly
+	ASMVector bool
+
+	// F31 is set when the modulus fits on 31 bits; elements are then stored on a single uint32
+	// word and arithmetic uses 64-bit intermediate products (see Word)
+	F31  bool
+	Word Word // machine word used to store a limb
+}
+
+// Word describes the machine word used to store a limb of a field element
+type Word struct {
+	BitSize   int    // 32 or 64
+	ByteSize  int    // 4 or 8
+	TypeLower string // uint32 or uint64
+	TypeUpper string // Uint32 or Uint64
+	Add       string // Add32 or Add64
+	Sub       string // Sub32 or Sub64
+	Len       string // Len32 or Len64
 }
 
 // NewFieldConfig returns a data structure with needed information to generate apis for field element
@@ -95,8 +121,14 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	}
 	// pre compute field constants
 	F.NbBits = bModulus.BitLen()
-	F.NbWords = len(bModulus.Bits())
-	F.NbBytes = F.NbWords * 8 // (F.NbBits + 7) / 8
+	F.F31 = F.NbBits <= 31
+	if F.F31 {
+		F.Word = Word{BitSize: 32, ByteSize: 4, TypeLower: "uint32", TypeUpper: "Uint32", Add: "Add32", Sub: "Sub32", Len: "Len32"}
+	} else {
+		F.Word = Word{BitSize: 64, ByteSize: 8, TypeLower: "uint64", TypeUpper: "Uint64", Add: "Add64", Sub: "Sub64", Len: "Len64"}
+	}
+	F.NbWords = (F.NbBits + 63) / 64        // limbs are 64-bit words, whatever the host architecture
+	F.NbBytes = F.NbWords * F.Word.ByteSize // (F.NbBits + 7) / 8
 
 	F.NbWordsLastIndex = F.NbWords - 1
 
@@ -110,7 +142,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 
 	//  setting qInverse
 	_r := big.NewInt(1)
-	_r.Lsh(_r, uint(F.NbWords)*64)
+	_r.Lsh(_r, uint(F.NbWords*F.Word.BitSize))
 	_rInv := big.NewInt(1)
 	_qInv := big.NewInt(0)
 	extendedEuclideanAlgo(_r, &bModulus, _rInv, _qInv)
@@ -139,19 +171,19 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 
 	// rsquare
 	_rSquare := big.NewInt(2)
-	exponent := big.NewInt(int64(F.NbWords) * 64 * 2)
+	exponent := big.NewInt(int64(F.NbWords*F.Word.BitSize) * 2)
 	_rSquare.Exp(_rSquare, exponent, &bModulus)
 	F.RSquare = toUint64Slice(_rSquare, F.NbWords)
 
 	var one big.Int
 	one.SetUint64(1)
-	one.Lsh(&one, uint(F.NbWords)*64).Mod(&one, &bModulus)
+	one.Lsh(&one, uint(F.NbWords*F.Word.BitSize)).Mod(&one, &bModulus)
 	F.One = toUint64Slice(&one, F.NbWords)
 
 	{
 		var n big.Int
 		n.SetUint64(13)
-		n.Lsh(&n, uint(F.NbWords)*64).Mod(&n, &bModulus)
+		n.Lsh(&n, uint(F.NbWords*F.Word.BitSize)).Mod(&n, &bModulus)
 		F.Thirteen = toUint64Slice(&n, F.NbWords)
 	}
 
@@ -240,7 +272,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 			var g big.Int
 			g.Exp(&nonResidue, &s, &bModulus)
 			// store g in montgomery form
-			g.Lsh(&g, uint(F.NbWords)*64).Mod(&g, &bModulus)
+			g.Lsh(&g, uint(F.NbWords*F.Word.BitSize)).Mod(&g, &bModulus)
 			F.SqrtG = toUint64Slice(&g, F.NbWords)
 
 			// store non residue in montgomery form
@@ -260,19 +292,24 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// moduli that meet the condition F.NoCarry
 	// asm code generation for moduli with more than 6 words can be optimized further
 	F.ASM = F.NoCarry && F.NbWords <= 12 && F.NbWords > 1
+	F.ASMMul = F.ASM
+	F.ASMVector = F.ASM && F.NbWords <= 6
+	F.SpecialForm = NewSpecialForm(&bModulus)
 
 	return F, nil
 }
 
 func toUint64Slice(b *big.Int, nbWords ...int) (s []uint64) {
-	if len(nbWords) > 0 && nbWords[0] > len(b.Bits()) {
-		s = make([]uint64, nbWords[0])
-	} else {
-		s = make([]uint64, len(b.Bits()))
+	n := (b.BitLen() + 63) / 64
+	if len(nbWords) > 0 && nbWords[0] > n {
+		n = nbWords[0]
 	}
+	s = make([]uint64, n)
 
+	// a big.Word is 32 bits wide on 32-bit architectures
+	const wordsPerLimb = 64 / bits.UintSize
 	for i, v := range b.Bits() {
-		s[i] = (uint64)(v)
+		s[i/wordsPerLimb] |= uint64(v) << ((i % wordsPerLimb) * bits.UintSize)
 	}
 	return
 }
@@ -324,7 +361,7 @@ func (f *FieldConfig) StringToMont(str string) big.Int {
 
 func (f *FieldConfig) ToMont(nonMont big.Int) big.Int {
 	var mont big.Int
-	mont.Lsh(&nonMont, uint(f.NbWords)*64)
+	mont.Lsh(&nonMont, uint(f.NbWords*f.Word.BitSize))
 	mont.Mod(&mont, f.ModulusBig)
 	return mont
 }
@@ -336,7 +373,7 @@ func (f *FieldConfig) FromMont(nonMont *big.Int, mont *big.Int) *FieldConfig {
 		return f
 	}
 	f.halve(nonMont, mont)
-	for i := 1; i < f.NbWords*64; i++ {
+	for i := 1; i < f.NbWords*f.Word.BitSize; i++ {
 		f.halve(nonMont, nonMont)
 	}
 
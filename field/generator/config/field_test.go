@@ -258,3 +258,51 @@ func bigIntMatchUint64Slice(aInt *big.Int, a []uint64) error {
 
 	return nil
 }
+
+func TestSpecialForm(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		modulus string
+		k       int
+		c       uint64
+		name    string
+	}{
+		{"115792089237316195423570985008687907853269984665640564039457584007908834671663", 256, 0x1000003d1, "Solinas"},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819949", 255, 19, "pseudo-Mersenne"},
+		{"18446744069414584321", 64, 0xffffffff, "Goldilocks-like"},
+		{"170141183460469231731687303715884105727", 127, 1, "Mersenne"},
+		// bn254 base field
+		{"21888242871839275222246405745257275088696311157297823662689037894645226208583", 0, 0, ""},
+		// less than 64 bits
+		{"2147483647", 0, 0, ""},
+	}
+
+	for _, tt := range tests {
+		f, err := NewFieldConfig("dummy", "DummyElement", tt.modulus, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.k == 0 {
+			if f.SpecialForm != nil {
+				t.Fatalf("%s: unexpected special form %s", tt.modulus, f.SpecialForm)
+			}
+			if f.EnableSpecialReduction() == nil {
+				t.Fatalf("%s: special reduction shouldn't be enabled", tt.modulus)
+			}
+			continue
+		}
+		if f.SpecialForm == nil {
+			t.Fatalf("%s: special form not detected", tt.modulus)
+		}
+		if f.SpecialForm.K != tt.k || f.SpecialForm.C != tt.c || f.SpecialForm.Name() != tt.name {
+			t.Fatalf("%s: got %s", tt.modulus, f.SpecialForm)
+		}
+		if err := f.EnableSpecialReduction(); err != nil {
+			t.Fatal(err)
+		}
+		if !f.SpecialReduction || f.ASMMul != (f.NbWords > 1) {
+			t.Fatalf("%s: special reduction not enabled", tt.modulus)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// SpecialForm describes a modulus q = 2ᵏ - c, with 64 ⩽ k ⩽ 64·NbWords and 0 < c < 2⁶⁴.
+//
+// This covers pseudo-Mersenne primes (2²⁵⁵ - 19), Solinas primes with a small tail
+// (secp256k1's 2²⁵⁶ - 2³² - 977) and Goldilocks-like primes (2⁶⁴ - 2³² + 1).
+//
+// For such moduli, q = -c mod 2⁶⁴, and in each step of the Montgomery reduction the product m·q
+// reduces to a single word multiplication m·c and a shifted addition of m·2ᵏ, instead of
+// NbWords word multiplications. The Montgomery form (R = 2^(64·NbWords)) is left unchanged.
+type SpecialForm struct {
+	K int    // q = 2ᵏ - c
+	C uint64 // q = 2ᵏ - c
+
+	// Word and Shift locate 2ᵏ⁻⁶⁴ = 2^(64·Word + Shift), which is where m is added
+	// after the division by 2⁶⁴ in a reduction step
+	Word, Shift int
+}
+
+// Name returns the family of the modulus
+func (s *SpecialForm) Name() string {
+	switch {
+	case s.C == 1:
+		return "Mersenne"
+	case bits.OnesCount64(s.C+1) == 1:
+		// q = 2ᵏ - 2ᵃ + 1
+		return "Goldilocks-like"
+	case bits.Len64(s.C) <= 32:
+		return "pseudo-Mersenne"
+	default:
+		return "Solinas"
+	}
+}
+
+// String returns a human readable description of the modulus form
+func (s *SpecialForm) String() string {
+	return fmt.Sprintf("%s q = 2^%d - %#x", s.Name(), s.K, s.C)
+}
+
+// NewSpecialForm returns the special form of q, or nil if q doesn't have one
+func NewSpecialForm(q *big.Int) *SpecialForm {
+	k := q.BitLen()
+	if k < 64 || q.Bit(0) == 0 {
+		return nil
+	}
+
+	// c = 2ᵏ - q
+	var c big.Int
+	c.Lsh(big.NewInt(1), uint(k)).Sub(&c, q)
+	if c.Sign() <= 0 || !c.IsUint64() {
+		return nil
+	}
+
+	return &SpecialForm{
+		K:     k,
+		C:     c.Uint64(),
+		Word:  (k - 64) / 64,
+		Shift: (k - 64) % 64,
+	}
+}
+
+// EnableSpecialReduction makes the generated multiplication use the reduction dedicated to the
+// special form of the modulus (see SpecialForm). It returns an error if the modulus has no special form.
+func (F *FieldConfig) EnableSpecialReduction() error {
+	if F.SpecialForm == nil {
+		return errors.New("modulus doesn't have a special form 2ᵏ - c with c < 2⁶⁴")
+	}
+	if F.NbWords > 6 {
+		return errors.New("special reduction is only supported for moduli up to 6 words")
+	}
+	F.SpecialReduction = true
+	F.ASMMul = F.NbWords > 1
+	// the vector kernels use the Montgomery multiplication
+	F.ASMVector = false
+	return nil
+}
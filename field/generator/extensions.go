@@ -0,0 +1,435 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/extensions"
+)
+
+// extensionTemplateData is the data passed to the extension templates
+type extensionTemplateData struct {
+	PackageName      string
+	FF               string // name of the base field package
+	ElementName      string // name of the base field element type
+	FieldPackagePath string // import path of the base field package
+
+	Name        string // E2, E3, E4, ...
+	Degree      int    // degree over the base
+	Var         string // generator of the extension over its base: u, v, w, ...
+	BaseType    string // Go type of the coefficients
+	BaseName    string // name of the base, for documentation
+	BaseIsField bool   // true if the base is the prime field
+	BaseDegree  int    // degree of the base over the prime field
+	PrimePath   string // selector of the first prime field coordinate of a coefficient
+
+	NonResidue            string       // β = vⁿ, for documentation
+	NonResidueCoordinates []coordinate // β, over the prime field
+	NonResidueIsBaseVar   bool         // true if β is the generator of the base extension
+	NonResidueIsInteger   bool         // true if β is a non-negative integer, for documentation
+
+	// (vⁱ)^p = FrobeniusCoefficients[i]·v^FrobeniusTargets[i]
+	FrobeniusTargets      []int
+	FrobeniusCoefficients [][]coordinate
+
+	// Tonelli-Shanks parameters (odd degree only)
+	SqrtS              uint64       // |L|-1 = 2^SqrtS · t, t odd
+	SqrtTMinusOneOver2 string       // (t-1)/2, base 16
+	SqrtG              []coordinate // a non-square of the base raised to the power t
+
+	Extensions []extensionTemplateData // set for the package doc only
+}
+
+// coordinate is a prime field coordinate of a constant, and its selector in the Go type
+type coordinate struct {
+	Path  string
+	Value string
+}
+
+// GenerateExtensions will generate go files in outputDir for the binomial extensions
+// B[v]/(vⁿ - β) described by exts, where the base field B is either the prime field F,
+// whose package is importable at fieldPackagePath, or a previously listed extension.
+//
+// Supported degrees are 2 and 3; towers of any height can be built.
+//
+// Example usage
+//
+//	babybear, _ := config.NewFieldConfig("babybear", "Element", modulus, true)
+//	e2 := config.BinomialExtension{Name: "E2", Degree: 2, NonResidue: []string{"11"}}
+//	e4 := config.BinomialExtension{Name: "E4", Base: "E2", Degree: 2, NonResidue: []string{"0", "1"}}
+//	generator.GenerateExtensions(babybear, "github.com/consensys/gnark-crypto/field/babybear", "extensions", e2, e4)
+func GenerateExtensions(F *config.FieldConfig, fieldPackagePath, outputDir string, exts ...config.BinomialExtension) error {
+	if len(exts) == 0 {
+		return errors.New("no extension to generate")
+	}
+
+	packageName := filepath.Base(outputDir)
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(packageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	doc := extensionTemplateData{
+		PackageName:      packageName,
+		FF:               F.PackageName,
+		ElementName:      F.ElementName,
+		FieldPackagePath: fieldPackagePath,
+	}
+
+	tower, err := newTowerTemplateData(F, exts)
+	if err != nil {
+		return err
+	}
+
+	for i, data := range tower {
+		data.PackageName = packageName
+		data.FieldPackagePath = fieldPackagePath
+		doc.Extensions = append(doc.Extensions, data)
+
+		var src string
+		switch data.Degree {
+		case 2:
+			src = extensions.E2
+		case 3:
+			src = extensions.E3
+		}
+
+		eName := strings.ToLower(data.Name)
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+".go"), []string{src, extensions.Common}, data, bavardOpts...); err != nil {
+			return err
+		}
+
+		testFiles := []string{extensions.Test}
+		if i == 0 {
+			testFiles = append(testFiles, extensions.TestConstants)
+		}
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+"_test.go"), testFiles, data, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, "doc.go"), []string{extensions.Doc}, doc, bavardOpts...); err != nil {
+		return err
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// ValidateExtensions returns an error if exts don't describe a tower of binomial extensions
+// over F supported by GenerateExtensions; it generates nothing.
+func ValidateExtensions(F *config.FieldConfig, exts ...config.BinomialExtension) error {
+	if len(exts) == 0 {
+		return errors.New("no extension to generate")
+	}
+	_, err := newTowerTemplateData(F, exts)
+	return err
+}
+
+// newTowerTemplateData checks the extensions in order, and returns their template data
+func newTowerTemplateData(F *config.FieldConfig, exts []config.BinomialExtension) ([]extensionTemplateData, error) {
+	primeField := &towerField{p: F.ModulusBig, degree: 1, name: F.PackageName}
+	fields := make(map[string]*towerField)
+
+	res := make([]extensionTemplateData, 0, len(exts))
+	for _, ext := range exts {
+		if _, ok := fields[ext.Name]; ok || ext.Name == "" {
+			return nil, fmt.Errorf("invalid or duplicate extension name %q", ext.Name)
+		}
+		base := primeField
+		if ext.Base != "" {
+			var ok bool
+			if base, ok = fields[ext.Base]; !ok {
+				return nil, fmt.Errorf("%s: unknown base extension %q", ext.Name, ext.Base)
+			}
+		}
+		L, data, err := newExtensionTemplateData(F, base, ext)
+		if err != nil {
+			return nil, err
+		}
+		fields[ext.Name] = L
+		res = append(res, data)
+	}
+	return res, nil
+}
+
+// newExtensionTemplateData checks that vⁿ - β is irreducible over the base and
+// precomputes the constants needed by the extension templates
+func newExtensionTemplateData(F *config.FieldConfig, base *towerField, ext config.BinomialExtension) (*towerField, extensionTemplateData, error) {
+	data := extensionTemplateData{
+		FF:          F.PackageName,
+		ElementName: F.ElementName,
+		Name:        ext.Name,
+		Degree:      ext.Degree,
+		BaseIsField: base.base == nil,
+		BaseDegree:  base.size(),
+	}
+	if data.BaseIsField {
+		data.BaseType = F.PackageName + "." + F.ElementName
+		data.BaseName = F.PackageName
+		data.Var = "u"
+	} else {
+		data.BaseType = base.name
+		data.BaseName = base.name
+		data.Var = string(rune(base.variable[0] + 1))
+		data.PrimePath = base.path(0)
+	}
+
+	if ext.Degree != 2 && ext.Degree != 3 {
+		return nil, data, fmt.Errorf("%s: unsupported extension degree %d", ext.Name, ext.Degree)
+	}
+	if len(ext.NonResidue) == 0 || len(ext.NonResidue) > base.size() {
+		return nil, data, fmt.Errorf("%s: β must have between 1 and %d coordinates", ext.Name, base.size())
+	}
+	beta := base.zero()
+	for i, s := range ext.NonResidue {
+		if _, ok := beta[i].SetString(s, 10); !ok {
+			return nil, data, fmt.Errorf("%s: can't parse coordinate %q of β", ext.Name, s)
+		}
+		beta[i].Mod(beta[i], F.ModulusBig)
+	}
+	if base.isZero(beta) {
+		return nil, data, fmt.Errorf("%s: β must be non-zero", ext.Name)
+	}
+
+	L := &towerField{p: F.ModulusBig, degree: ext.Degree, base: base, beta: beta, name: ext.Name, variable: data.Var}
+	data.NonResidue = base.format(beta)
+	data.NonResidueCoordinates = base.coordinates(beta)
+	_, err := strconv.ParseUint(data.NonResidue, 10, 64)
+	data.NonResidueIsInteger = err == nil
+	if !data.BaseIsField {
+		generator := base.zero()
+		generator[base.base.size()].SetUint64(1)
+		data.NonResidueIsBaseVar = base.equal(beta, generator)
+	}
+
+	// vⁿ - β is irreducible iff n | Q-1 and β is not a n-th power in B, where Q = |B|
+	Q := base.order()
+	qMinusOne := new(big.Int).Sub(Q, big.NewInt(1))
+	exponent, r := new(big.Int).DivMod(qMinusOne, big.NewInt(int64(ext.Degree)), new(big.Int))
+	if r.Sign() != 0 {
+		return nil, data, fmt.Errorf("%s: v^%d - β is never irreducible when |%s| ≢ 1 mod %d", ext.Name, ext.Degree, base.name, ext.Degree)
+	}
+	if base.isOne(base.exp(beta, exponent)) {
+		return nil, data, fmt.Errorf("%s: v^%d - (%s) is not irreducible: β is a %d-th power", ext.Name, ext.Degree, data.NonResidue, ext.Degree)
+	}
+
+	// Frobenius: (vⁱ)^p = β^⌊i·p/n⌋ · v^(i·p mod n)
+	n := big.NewInt(int64(ext.Degree))
+	for i := 0; i < ext.Degree; i++ {
+		var e, target big.Int
+		e.Mul(F.ModulusBig, big.NewInt(int64(i)))
+		e.DivMod(&e, n, &target)
+		data.FrobeniusTargets = append(data.FrobeniusTargets, int(target.Int64()))
+		data.FrobeniusCoefficients = append(data.FrobeniusCoefficients, base.coordinates(base.exp(beta, &e)))
+	}
+
+	if ext.Degree == 3 {
+		// Tonelli-Shanks parameters over L
+		var t big.Int
+		t.Sub(L.order(), big.NewInt(1))
+		data.SqrtS = uint64(t.TrailingZeroBits())
+		t.Rsh(&t, uint(data.SqrtS))
+
+		// a non-square of B is a non-square of L since the degree is odd
+		halfQMinusOne := new(big.Int).Rsh(qMinusOne, 1)
+		nonSquare := base.zero()
+		nonSquare[0].SetUint64(2)
+		if !data.BaseIsField {
+			// look for c + (generator of B)
+			nonSquare[base.base.size()].SetUint64(1)
+		}
+		for base.isOne(base.exp(nonSquare, halfQMinusOne)) {
+			nonSquare[0].Add(nonSquare[0], big.NewInt(1))
+		}
+		data.SqrtG = base.coordinates(base.exp(nonSquare, &t))
+		t.Rsh(&t, 1)
+		data.SqrtTMinusOneOver2 = t.Text(16)
+	}
+
+	return L, data, nil
+}
+
+// towerField is a big.Int model of a tower of binomial extensions,
+// used to precompute the constants of the generated code.
+//
+// Elements are stored as their coordinates over the prime field.
+type towerField struct {
+	p        *big.Int
+	degree   int         // degree over the base; 1 for the prime field
+	base     *towerField // nil for the prime field
+	beta     []*big.Int  // vⁿ = β ∈ base
+	name     string      // Go type
+	variable string      // v
+}
+
+// size returns the degree of f over the prime field
+func (f *towerField) size() int {
+	if f.base == nil {
+		return 1
+	}
+	return f.degree * f.base.size()
+}
+
+// order returns the number of elements of f
+func (f *towerField) order() *big.Int {
+	return new(big.Int).Exp(f.p, big.NewInt(int64(f.size())), nil)
+}
+
+func (f *towerField) zero() []*big.Int {
+	z := make([]*big.Int, f.size())
+	for i := range z {
+		z[i] = new(big.Int)
+	}
+	return z
+}
+
+func (f *towerField) one() []*big.Int {
+	z := f.zero()
+	z[0].SetUint64(1)
+	return z
+}
+
+func (f *towerField) equal(x, y []*big.Int) bool {
+	for i := range x {
+		if x[i].Cmp(y[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *towerField) isZero(x []*big.Int) bool {
+	return f.equal(x, f.zero())
+}
+
+func (f *towerField) isOne(x []*big.Int) bool {
+	return f.equal(x, f.one())
+}
+
+func (f *towerField) add(x, y []*big.Int) []*big.Int {
+	z := f.zero()
+	for i := range z {
+		z[i].Add(x[i], y[i]).Mod(z[i], f.p)
+	}
+	return z
+}
+
+func (f *towerField) mul(x, y []*big.Int) []*big.Int {
+	if f.base == nil {
+		z := new(big.Int).Mul(x[0], y[0])
+		return []*big.Int{z.Mod(z, f.p)}
+	}
+	s := f.base.size()
+	chunk := func(x []*big.Int, i int) []*big.Int { return x[i*s : (i+1)*s] }
+
+	// schoolbook product, then vⁿ⁺ⁱ = β·vⁱ
+	res := make([][]*big.Int, 2*f.degree-1)
+	for i := range res {
+		res[i] = f.base.zero()
+	}
+	for i := 0; i < f.degree; i++ {
+		for j := 0; j < f.degree; j++ {
+			res[i+j] = f.base.add(res[i+j], f.base.mul(chunk(x, i), chunk(y, j)))
+		}
+	}
+	for i := len(res) - 1; i >= f.degree; i-- {
+		res[i-f.degree] = f.base.add(res[i-f.degree], f.base.mul(res[i], f.beta))
+	}
+
+	z := make([]*big.Int, 0, f.size())
+	for i := 0; i < f.degree; i++ {
+		z = append(z, res[i]...)
+	}
+	return z
+}
+
+func (f *towerField) exp(x []*big.Int, k *big.Int) []*big.Int {
+	z := f.one()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		z = f.mul(z, z)
+		if k.Bit(i) == 1 {
+			z = f.mul(z, x)
+		}
+	}
+	return z
+}
+
+// path returns the Go selector of the i-th prime field coordinate of an element of f
+func (f *towerField) path(i int) string {
+	if f.base == nil {
+		return ""
+	}
+	s := f.base.size()
+	return fmt.Sprintf(".A%d", i/s) + f.base.path(i%s)
+}
+
+func (f *towerField) coordinates(x []*big.Int) []coordinate {
+	c := make([]coordinate, len(x))
+	for i := range x {
+		c[i] = coordinate{Path: f.path(i), Value: x[i].String()}
+	}
+	return c
+}
+
+// monomials returns the names of the elements of the basis of f over the prime field
+func (f *towerField) monomials() []string {
+	if f.base == nil {
+		return []string{""}
+	}
+	var res []string
+	for i := 0; i < f.degree; i++ {
+		for _, m := range f.base.monomials() {
+			var power string
+			switch i {
+			case 0:
+			case 1:
+				power = f.variable
+			default:
+				power = fmt.Sprintf("%s^%d", f.variable, i)
+			}
+			if m != "" && power != "" {
+				m += "·"
+			}
+			res = append(res, m+power)
+		}
+	}
+	return res
+}
+
+// format returns a human readable representation of x; coordinates larger than p/2
+// are printed as negative integers
+func (f *towerField) format(x []*big.Int) string {
+	halfP := new(big.Int).Rsh(f.p, 1)
+	var terms []string
+	for i, m := range f.monomials() {
+		c := new(big.Int).Set(x[i])
+		if c.Cmp(halfP) > 0 {
+			c.Sub(c, f.p)
+		}
+		switch {
+		case c.Sign() == 0:
+		case m == "":
+			terms = append(terms, c.String())
+		case c.IsInt64() && c.Int64() == 1:
+			terms = append(terms, m)
+		case c.IsInt64() && c.Int64() == -1:
+			terms = append(terms, "-"+m)
+		default:
+			terms = append(terms, c.String()+"·"+m)
+		}
+	}
+	return strings.Join(terms, " + ")
+}
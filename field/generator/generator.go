@@ -16,6 +16,14 @@ import (
 	"github.com/consensys/gnark-crypto/field/generator/internal/templates/element"
 )
 
+// build constraints (in the legacy // +build syntax used by bavard) selecting the Mul and Square
+// implementations on 32-bit architectures
+const (
+	arch32bits        = "386 arm mips mipsle"
+	not32bits         = "!386,!arm,!mips,!mipsle"
+	notAMD64Not32bits = "!amd64," + not32bits
+)
+
 // GenerateFF will generate go (and .s) files in outputDir for modulus (in base 10)
 //
 // Example usage
@@ -33,6 +41,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		element.MulCIOS,
 		element.MulNoCarry,
 		element.Sqrt,
+		element.ConstantTime,
 		element.Inverse,
 		element.BigNum,
 	}
@@ -44,6 +53,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		element.Reduce,
 		element.Test,
 		element.InverseTests,
+		element.ConstantTimeTests,
 	}
 	// output files
 	eName := strings.ToLower(F.ElementName)
@@ -103,8 +113,10 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
-	// generate arithmetics source file
-	if err := bavard.GenerateFromString(pathSrcArith, []string{element.Arith}, F, bavardOpts...); err != nil {
+	// generate arithmetics source file; F31 fields only need 64-bit products and don't use it
+	if F.F31 {
+		_ = os.Remove(pathSrcArith)
+	} else if err := bavard.GenerateFromString(pathSrcArith, []string{element.Arith}, F, bavardOpts...); err != nil {
 		return err
 	}
 
@@ -124,6 +136,30 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		return err
 	}
 
+	// generate vector arithmetic, in assembly if possible
+	pathSrcVectorAMD64 := filepath.Join(outputDir, "vector_amd64.go")
+	pathSrcVectorPurego := filepath.Join(outputDir, "vector_purego.go")
+	{
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		if F.ASMVector {
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!amd64 purego"))
+		}
+		if err := bavard.GenerateFromString(pathSrcVectorPurego, []string{element.VectorOpsPurego}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+	if F.ASMVector {
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
+		if err := bavard.GenerateFromString(pathSrcVectorAMD64, []string{element.VectorOpsAMD64}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(pathSrcVectorAMD64)
+	}
+
 	// if we generate assembly code
 	if F.ASM {
 		// generate ops.s
@@ -152,7 +188,9 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 				return err
 			}
 		}
+	}
 
+	if F.ASMMul {
 		{
 			pathSrc := filepath.Join(outputDir, eName+"_mul_amd64.s")
 			fmt.Println("generating", pathSrc)
@@ -181,7 +219,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 
 	}
 
-	if F.ASM {
+	if F.ASMMul {
 		// generate ops_amd64.go
 		src := []string{
 			element.MulDoc,
@@ -190,7 +228,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		pathSrc := filepath.Join(outputDir, eName+"_ops_amd64.go")
 		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
 		copy(bavardOptsCpy, bavardOpts)
-		if F.ASM {
+		if F.ASMMul {
 			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag("!purego"))
 		}
 		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
@@ -218,6 +256,44 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		}
 	}
 
+	{
+		// generate mul_purego.go: Mul and Square on 64-bit words.
+		// F31 fields use 64-bit products of 32-bit words and have no dedicated 32-bit path.
+		src := []string{
+			element.MulPurego,
+			element.MulSpecial,
+			element.MulCIOS,
+			element.MulNoCarry,
+			element.Reduce,
+			element.MulDoc,
+		}
+		pathSrc := filepath.Join(outputDir, eName+"_mul_purego.go")
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		switch {
+		case F.ASMMul:
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag(notAMD64Not32bits+" purego,"+not32bits))
+		case !F.F31:
+			bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag(not32bits))
+		}
+		if err := bavard.GenerateFromString(pathSrc, src, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
+	// generate mul_32bits.go: Mul and Square on 32-bit words, for 32-bit architectures
+	pathSrcMul32 := filepath.Join(outputDir, eName+"_mul_32bits.go")
+	if F.F31 {
+		_ = os.Remove(pathSrcMul32)
+	} else {
+		bavardOptsCpy := make([]func(*bavard.Bavard) error, len(bavardOpts))
+		copy(bavardOptsCpy, bavardOpts)
+		bavardOptsCpy = append(bavardOptsCpy, bavard.BuildTag(arch32bits))
+		if err := bavard.GenerateFromString(pathSrcMul32, []string{element.Mul32}, F, bavardOptsCpy...); err != nil {
+			return err
+		}
+	}
+
 	{
 		// generate doc.go
 		src := []string{
@@ -229,7 +305,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		}
 	}
 
-	if F.ASM {
+	if F.ASMMul {
 		// generate asm.go and asm_noadx.go
 		src := []string{
 			element.Asm,
@@ -242,7 +318,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 			return err
 		}
 	}
-	if F.ASM {
+	if F.ASMMul {
 		// generate asm.go and asm_noadx.go
 		src := []string{
 			element.AsmNoAdx,
@@ -65,6 +65,8 @@ func TestIntegration(t *testing.T) {
 
 	moduli["forty_seven"] = "47"
 	moduli["small"] = "9459143039767"
+	moduli["babybear"] = "2013265921"                         // 31 bits, uint32 words
+	moduli["mersenne31"] = "2147483647"                       // 31 bits, uint32 words
 	moduli["small_without_no_carry"] = "18446744073709551557" // 64bits
 
 	moduli["e_secp256k1"] = "115792089237316195423570985008687907853269984665640564039457584007908834671663"
@@ -73,6 +75,21 @@ func TestIntegration(t *testing.T) {
 	moduli["e_nocarry_edge_0127"] = "170141183460469231731687303715884105727"
 	moduli["e_nocarry_edge_1279"] = "10407932194664399081925240327364085538615262247266704805319112350403608059673360298012239441732324184842421613954281007791383566248323464908139906605677320762924129509389220345773183349661583550472959420547689811211693677147548478866962501384438260291732348885311160828538416585028255604666224831890918801847068222203140521026698435488732958028878050869736186900714720710555703168729087"
 
+	// moduli of the form 2ᵏ - c, generated with the dedicated reduction
+	specialModuli := map[string]string{
+		"e_special_goldilocks": "18446744069414584321",                                                                                                 // 2⁶⁴ - 2³² + 1
+		"e_special_0127":       "170141183460469231731687303715884105727",                                                                              // 2¹²⁷ - 1
+		"e_special_0192":       "6277101735386680763835789423207666416102355444464034512659",                                                           // 2¹⁹² - 237
+		"e_special_0254":       "28948022309329048855892746252171976963317496166410141009864396001978282409739",                                        // 2²⁵⁴ - 245
+		"e_special_0255":       "57896044618658097711785492504343953926634992332820282019728792003956564819949",                                        // 2²⁵⁵ - 19
+		"e_special_secp256k1":  "115792089237316195423570985008687907853269984665640564039457584007908834671663",                                       // 2²⁵⁶ - 2³² - 977
+		"e_special_0383":       "19701003098197239606139520050071806902539869635232723333974146702122860885748605305707133127442457820403313995153221", // 2³⁸³ - 187
+	}
+
+	for elementName, modulus := range specialModuli {
+		moduli[elementName] = modulus
+	}
+
 	for elementName, modulus := range moduli {
 		var fIntegration *field.FieldConfig
 		// generate field
@@ -81,6 +98,11 @@ func TestIntegration(t *testing.T) {
 		if err != nil {
 			t.Fatal(elementName, err)
 		}
+		if _, ok := specialModuli[elementName]; ok {
+			if err = fIntegration.EnableSpecialReduction(); err != nil {
+				t.Fatal(elementName, err)
+			}
+		}
 		if err = GenerateFF(fIntegration, childDir); err != nil {
 			t.Fatal(elementName, err)
 		}
@@ -18,7 +18,7 @@ import (
 	"github.com/bits-and-blooms/bitset"
 )
 
-// {{.ElementName}} represents a field element stored on {{.NbWords}} words (uint64)
+// {{.ElementName}} represents a field element stored on {{.NbWords}} words ({{$.Word.TypeLower}})
 //
 // {{.ElementName}} are assumed to be in Montgomery form in all methods.
 //
@@ -30,10 +30,10 @@ import (
 // Warning
 //
 // This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
-type {{.ElementName}} [{{.NbWords}}]uint64
+type {{.ElementName}} [{{.NbWords}}]{{$.Word.TypeLower}}
 
 const (
-	Limbs = {{.NbWords}} 	// number of 64 bits words needed to represent a {{.ElementName}}
+	Limbs = {{.NbWords}} 	// number of {{$.Word.BitSize}} bits words needed to represent a {{.ElementName}}
 	Bits = {{.NbBits}} 		// number of bits needed to represent a {{.ElementName}}
 	Bytes = {{.NbBytes}} 	// number of bytes needed to represent a {{.ElementName}}
 )
@@ -42,9 +42,9 @@ const (
 // Field modulus q
 const (
 {{- range $i := $.NbWordsIndexesFull}}
-	q{{$i}} uint64 = {{index $.Q $i}}
+	q{{$i}} {{$.Word.TypeLower}} = {{index $.Q $i}}
 	{{- if eq $.NbWords 1}}
-		q uint64 = q0
+		q {{$.Word.TypeLower}} = q0
 	{{- end}}
 {{- end}}
 )
@@ -66,7 +66,7 @@ func Modulus() *big.Int {
 
 // q + r'.r = 1, i.e., qInvNeg = - q⁻¹ mod r
 // used for Montgomery reduction
-const qInvNeg uint64 = {{index .QInverse 0}}
+const qInvNeg {{$.Word.TypeLower}} = {{index .QInverse 0}}
 
 func init() {
 	_modulus.SetString("{{.ModulusHex}}", 16)
@@ -78,7 +78,11 @@ func init() {
 // 		var v {{.ElementName}}
 // 		v.SetUint64(...)
 func New{{.ElementName}}(v uint64) {{.ElementName}} {
+	{{- if $.F31}}
+	z := {{.ElementName}}{uint32(v % uint64(q0))}
+	{{- else}}
 	z := {{.ElementName}}{v}
+	{{- end}}
 	z.Mul(&z, &rSquare)
 	return z
 }
@@ -86,7 +90,11 @@ func New{{.ElementName}}(v uint64) {{.ElementName}} {
 // SetUint64 sets z to v and returns z
 func (z *{{.ElementName}}) SetUint64(v uint64) *{{.ElementName}} {
 	//  sets z LSB to v (non-Montgomery form) and convert z to Montgomery form
+	{{- if $.F31}}
+	*z = {{.ElementName}}{uint32(v % uint64(q0))}
+	{{- else}}
 	*z = {{.ElementName}}{v}
+	{{- end}}
 	return z.Mul(z, &rSquare) // z.toMont()
 }
 
@@ -205,7 +213,11 @@ func (z *{{.ElementName}}) Equal(x *{{.ElementName}}) bool {
 
 // NotEqual returns 0 if and only if z == x; constant-time
 func (z *{{.ElementName}}) NotEqual(x *{{.ElementName}}) uint64 {
+{{- if $.F31}}
+	return uint64(z[0] ^ x[0])
+{{- else}}
 return {{- range $i :=  reverse .NbWordsIndexesNoZero}}(z[{{$i}}] ^ x[{{$i}}]) | {{end}}(z[0] ^ x[0])
+{{- end}}
 }
 
 // IsZero returns z == 0
@@ -235,7 +247,11 @@ func (z *{{.ElementName}}) IsUint64() bool {
 
 // Uint64 returns the uint64 representation of x. If x cannot be represented in a uint64, the result is undefined.
 func (z *{{.ElementName}}) Uint64() uint64 {
+	{{- if $.F31}}
+	return uint64(z.Bits()[0])
+	{{- else}}
 	return z.Bits()[0]
+	{{- end}}
 }
 
 // FitsOnOneWord reports whether z words (except the least significant word) are 0
@@ -277,10 +293,10 @@ func (z *{{.ElementName}}) LexicographicallyLargest() bool {
 
 	_z := z.Bits()
 
-	var b uint64
-	_, b = bits.Sub64(_z[0], {{index .QMinusOneHalvedP 0}}, 0)
+	var b {{$.Word.TypeLower}}
+	_, b = bits.{{$.Word.Sub}}(_z[0], {{index .QMinusOneHalvedP 0}}, 0)
 	{{- range $i := .NbWordsIndexesNoZero}}
-		_, b = bits.Sub64(_z[{{$i}}], {{index $.QMinusOneHalvedP $i}}, b)
+		_, b = bits.{{$.Word.Sub}}(_z[{{$i}}], {{index $.QMinusOneHalvedP $i}}, b)
 	{{- end}}
 
 	return b == 0
@@ -294,8 +310,8 @@ func (z *{{.ElementName}}) SetRandom() (*{{.ElementName}}, error) {
 	// this code is generated for all modulus
 	// and derived from go/src/crypto/rand/util.go
 
-	// l is number of limbs * 8; the number of bytes needed to reconstruct {{.NbWords}} uint64
-	const l = {{mul 8 .NbWords}}
+	// l is number of limbs * {{$.Word.ByteSize}}; the number of bytes needed to reconstruct {{.NbWords}} {{$.Word.TypeLower}}
+	const l = {{mul $.Word.ByteSize .NbWords}}
 
 	// bitLen is the maximum bit length needed to encode a value < q.
 	const bitLen = {{.NbBits}}
@@ -323,7 +339,7 @@ func (z *{{.ElementName}}) SetRandom() (*{{.ElementName}}, error) {
 
 		{{- range $i :=  .NbWordsIndexesFull}}
 			{{- $k := add $i 1}}
-			z[{{$i}}] = binary.LittleEndian.Uint64(bytes[{{mul $i 8}}:{{mul $k 8}}])
+			z[{{$i}}] = binary.LittleEndian.{{$.Word.TypeUpper}}(bytes[{{mul $i $.Word.ByteSize}}:{{mul $k $.Word.ByteSize}}])
 		{{- end}}
 
 		if !z.smallerThanModulus() {
@@ -355,7 +371,7 @@ func One() {{.ElementName}} {
 // Halve sets z to z / 2 (mod q)
 func (z *{{.ElementName}}) Halve()  {
 	{{- if not (and (eq .NbWords 1) (.NoCarry))}}
-		var carry uint64
+		var carry {{$.Word.TypeLower}}
 	{{- end}}
 
 	if z[0]&1 == 1 {
@@ -377,7 +393,7 @@ func (z *{{.ElementName}}) Halve()  {
 	{{- range $i := $.all.NbWordsIndexesFull }}
 		{{- $carryIn := ne $i 0}}
 		{{- $carryOut := or (ne $i $.all.NbWordsLastIndex) (and (eq $i $.all.NbWordsLastIndex) (not $.all.NoCarry))}}
-		{{$.V1}}[{{$i}}], {{- if $carryOut}}carry{{- else}}_{{- end}} = bits.Add64({{$.V1}}[{{$i}}], q{{$i}}, {{- if $carryIn}}carry{{- else}}0{{- end}})
+		{{$.V1}}[{{$i}}], {{- if $carryOut}}carry{{- else}}_{{- end}} = bits.{{$.all.Word.Add}}({{$.V1}}[{{$i}}], q{{$i}}, {{- if $carryIn}}carry{{- else}}0{{- end}})
 	{{- end}}
 {{ end }}
 
@@ -394,11 +410,11 @@ func (z *{{.ElementName}}) fromMont() *{{.ElementName}} {
 func (z *{{.ElementName}}) Add( x, y *{{.ElementName}}) *{{.ElementName}} {
 	{{ $hasCarry := or (not $.NoCarry) (gt $.NbWords 1)}}
 	{{- if $hasCarry}}
-		var carry uint64
+		var carry {{$.Word.TypeLower}}
 	{{- end}}
 	{{- range $i := iterate 0 $.NbWords}}
 		{{- $hasCarry := or (not $.NoCarry) (lt $i $.NbWordsLastIndex)}}
-		z[{{$i}}], {{- if $hasCarry}}carry{{- else}}_{{- end}} = bits.Add64(x[{{$i}}], y[{{$i}}], {{- if eq $i 0}}0{{- else}}carry{{- end}})
+		z[{{$i}}], {{- if $hasCarry}}carry{{- else}}_{{- end}} = bits.{{$.Word.Add}}(x[{{$i}}], y[{{$i}}], {{- if eq $i 0}}0{{- else}}carry{{- end}})
 	{{- end}}
 
 	{{- if eq $.NbWords 1}}
@@ -427,7 +443,13 @@ func (z *{{.ElementName}}) Add( x, y *{{.ElementName}}) *{{.ElementName}} {
 
 // Double z = x + x (mod q), aka Lsh 1
 func (z *{{.ElementName}}) Double( x *{{.ElementName}}) *{{.ElementName}} {
-	{{- if eq .NbWords 1}}
+	{{- if $.F31}}
+	// q < 2³¹, so x + x fits on 32 bits
+	z[0] = x[0] << 1
+	if z[0] >= q {
+		z[0] -= q
+	}
+	{{- else if eq .NbWords 1}}
 	if x[0] & (1 << 63) == (1 << 63) {
 		// if highest bit is set, then we have a carry to x + x, we shift and subtract q
 		z[0] = (x[0] << 1) - q
@@ -469,10 +491,10 @@ func (z *{{.ElementName}}) Double( x *{{.ElementName}}) *{{.ElementName}} {
 
 // Sub z = x - y (mod q)
 func (z *{{.ElementName}}) Sub( x, y *{{.ElementName}}) *{{.ElementName}} {
-	var b uint64
-	z[0], b = bits.Sub64(x[0], y[0], 0)
+	var b {{$.Word.TypeLower}}
+	z[0], b = bits.{{$.Word.Sub}}(x[0], y[0], 0)
 	{{- range $i := .NbWordsIndexesNoZero}}
-		z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], b)
+		z[{{$i}}], b = bits.{{$.Word.Sub}}(x[{{$i}}], y[{{$i}}], b)
 	{{- end}}
 	if b != 0 {
 		{{- if eq .NbWords 1}}
@@ -517,7 +539,7 @@ func (z *{{.ElementName}}) Neg( x *{{.ElementName}}) *{{.ElementName}} {
 // Select is a constant-time conditional move.
 // If c=0, z = x0. Else z = x1
 func (z *{{.ElementName}}) Select(c int, x0 *{{.ElementName}}, x1 *{{.ElementName}}) *{{.ElementName}} {
-	cC := uint64( (int64(c) | -int64(c)) >> 63 )	// "canonicized" into: 0 if c=0, -1 otherwise
+	cC := {{$.Word.TypeLower}}( (int64(c) | -int64(c)) >> 63 )	// "canonicized" into: 0 if c=0, -1 otherwise
 	{{- range $i := .NbWordsIndexesFull }}
 	z[{{$i}}] = x0[{{$i}}] ^ cC & (x0[{{$i}}] ^ x1[{{$i}}])
 	{{- end}}
@@ -528,9 +550,13 @@ func (z *{{.ElementName}}) Select(c int, x0 *{{.ElementName}}, x1 *{{.ElementNam
 // it is a fallback solution on x86 when ADX instruction set is not available
 // and is used for testing purposes.
 func _mulGeneric(z,x,y *{{.ElementName}}) {
+	{{- if $.F31}}
+	{{ template "mul_cios_one_limb" dict "all" . "V1" "x" "V2" "y" }}
+	{{- else}}
 	{{ mul_doc false }}
 	{{ template "mul_cios" dict "all" . "V1" "x" "V2" "y"}}
 	{{ template "reduce"  . }}
+	{{- end}}
 }
 
 
@@ -538,6 +564,10 @@ func _fromMontGeneric(z *{{.ElementName}}) {
 	// the following lines implement z = z * 1
 	// with a modified CIOS montgomery multiplication
 	// see Mul for algorithm documentation
+	{{- if $.F31}}
+	m := z[0] * qInvNeg
+	z[0] = uint32((uint64(z[0]) + uint64(m)*uint64(q)) >> 32)
+	{{- else}}
 	{{- range $j := .NbWordsIndexesFull}}
 	{
 		// m = z[0]n'[0] mod W
@@ -549,6 +579,7 @@ func _fromMontGeneric(z *{{.ElementName}}) {
 		z[{{sub $.NbWords 1}}] = C
 	}
 	{{- end}}
+	{{- end}}
 
 	{{ template "reduce" .}}
 }
@@ -604,7 +635,7 @@ func (z *{{.ElementName}}) BitLen() int {
 		return {{mul $i 64}} + bits.Len64(z[{{$i}}])
 	}
 	{{- end}}
-	return bits.Len64(z[0])
+	return bits.{{$.Word.Len}}(z[0])
 }
 
 // Hash msg to count prime field elements.
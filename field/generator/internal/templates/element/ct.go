@@ -0,0 +1,203 @@
+package element
+
+// ConstantTime contains the constant-time variants of Inverse, Exp, Sqrt and Legendre,
+// meant for secret inputs (see the package documentation).
+const ConstantTime = `
+
+var _bInverseExponent{{.ElementName}} *big.Int
+
+func init() {
+	// q - 2
+	_bInverseExponent{{.ElementName}} = Modulus()
+	_bInverseExponent{{.ElementName}}.Sub(_bInverseExponent{{.ElementName}}, big.NewInt(2))
+}
+
+// ctNonZero returns 1 if v != 0, 0 otherwise, without branching on v
+func ctNonZero(v uint64) int {
+	return int((v | -v) >> 63)
+}
+
+// InverseCT z = x⁻¹ (mod q) in constant time, computed as x^(q-2)
+//
+// if x == 0, sets and returns z = x
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.ExpCT(*x, _bInverseExponent{{.ElementName}})
+}
+
+// ExpCT z = xᵏ (mod q) in constant time
+//
+// The sequence of operations only depends on the number of words of k, such that
+// neither x nor the bits of k leak through timing or memory accesses. The sign of k is
+// not hidden: if k < 0, x is inverted with InverseCT.
+func (z *{{.ElementName}}) ExpCT(x {{.ElementName}}, k *big.Int) *{{.ElementName}} {
+	if k.Sign() == -1 {
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.InverseCT(&x)
+	}
+
+	// fixed window of 4 bits; table[i] = xⁱ
+	var table [16]{{.ElementName}}
+	table[0].SetOne()
+	table[1] = x
+	for i := 2; i < len(table); i++ {
+		table[i].mulCT(&table[i-1], &x)
+	}
+
+	// k.Bits() is the absolute value of k
+	words := k.Bits()
+	var res, t {{.ElementName}}
+	res.SetOne()
+	for i := len(words) - 1; i >= 0; i-- {
+		w := uint(words[i])
+		for j := bits.UintSize - 4; j >= 0; j -= 4 {
+			res.mulCT(&res, &res).
+				mulCT(&res, &res).
+				mulCT(&res, &res).
+				mulCT(&res, &res)
+
+			// t = table[(w >> j) & 0xf], reading all the entries
+			idx := uint64((w >> uint(j)) & 0xf)
+			t = table[0]
+			for l := 1; l < len(table); l++ {
+				t.Select(ctNonZero(uint64(l)^idx), &table[l], &t)
+			}
+			res.mulCT(&res, &t)
+		}
+	}
+
+	return z.Set(&res)
+}
+
+// LegendreCT returns the Legendre symbol of z (either +1, -1, or 0.) in constant time
+func (z *{{.ElementName}}) LegendreCT() int {
+	var l, one {{.ElementName}}
+	one.SetOne()
+	// z^((q-1)/2)
+	l.ExpCT(*z, _bLegendreExponent{{.ElementName}})
+
+	// 0 if l == 0, 1 if l == 1, -1 otherwise
+	notZero := ctNonZero(l.NotEqual(&{{.ElementName}}{}))
+	notOne := ctNonZero(l.NotEqual(&one))
+	return notZero - 2*(notZero&notOne)
+}
+
+// SqrtCT z = √x (mod q) in constant time
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil
+//
+// The running time doesn't depend on x, except for the final branch on
+// the existence of the square root. The root returned may differ from Sqrt's (z or -z).
+func (z *{{.ElementName}}) SqrtCT(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .SqrtQ3Mod4}}
+		// q ≡ 3 (mod 4)
+		// using  z ≡ ± x^((p+1)/4) (mod q)
+		var y, square {{.ElementName}}
+		y.ExpCT(*x, _bSqrtExponent{{.ElementName}})
+	{{- else if .SqrtAtkin}}
+		// q ≡ 5 (mod 8)
+		// see Sqrt; tx = 2x and y = αx(2xα² - 1), α = (2x)^((q-5)/8)
+		var one, two, alpha, y, tx, square {{.ElementName}}
+		one.SetOne()
+		two.SetUint64(2)
+		tx.mulCT(x, &two)
+		alpha.ExpCT(tx, _bSqrtExponent{{.ElementName}})
+		y.mulCT(&alpha, &alpha).
+			mulCT(&y, &tx).
+			subCT(&y, &one).
+			mulCT(&y, x).
+			mulCT(&y, &alpha)
+	{{- else if .SqrtTonelliShanks}}
+		// q ≡ 1 (mod 4)
+		// constant-time Tonelli-Shanks, see RFC 9380, appendix I.4
+		// with q - 1 = 2ᵉ⋅s, s odd
+		var y, b, t, tt, yt, square, one {{.ElementName}}
+		one.SetOne()
+
+		// y = x^((s-1)/2)
+		y.ExpCT(*x, _bSqrtExponent{{.ElementName}})
+
+		// t = xˢ, y = x^((s+1)/2)
+		t.mulCT(&y, &y).mulCT(&t, x)
+		y.mulCT(&y, x)
+
+		// c = nonResidue ^ s
+		var c = {{.ElementName}}{
+			{{- range $i := .SqrtG}}
+			{{$i}},{{end}}
+		}
+
+		for k := {{.SqrtE}}; k >= 2; k-- {
+			// b = t^(2^(k-2))
+			b = t
+			for j := 1; j <= k-2; j++ {
+				b.mulCT(&b, &b)
+			}
+
+			// if b != 1: y = y⋅c, t = t⋅c²
+			notOne := ctNonZero(b.NotEqual(&one))
+			yt.mulCT(&y, &c)
+			y.Select(notOne, &y, &yt)
+			c.mulCT(&c, &c)
+			tt.mulCT(&t, &c)
+			t.Select(notOne, &t, &tt)
+		}
+	{{- else}}
+		panic("not implemented")
+	{{- end}}
+	{{- if or .SqrtQ3Mod4 .SqrtAtkin .SqrtTonelliShanks}}
+
+		// ensure we found y such that y * y = x
+		square.mulCT(&y, &y)
+		if square.Equal(x) {
+			return z.Set(&y)
+		}
+		return nil
+	{{- end}}
+}
+
+// mulCT z = x * y (mod q)
+//
+// Unlike Mul, whose final reduction may branch on the result in its Go implementation,
+// mulCT never branches on the values of x and y.
+func (z *{{.ElementName}}) mulCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .F31}}
+	v := uint64(x[0]) * uint64(y[0])
+	m := uint32(v) * qInvNeg
+	r := uint32((v + uint64(m)*uint64(q)) >> 32)
+	// r < 2q < 2³², so r - q fits on 32 signed bits; add q back if it is negative
+	r -= q
+	z[0] = r + (q & uint32(int32(r)>>31))
+	{{- else}}
+	{{ template "mul_cios" dict "all" . "V1" "x" "V2" "y" "CT" true }}
+	{{- end}}
+	return z
+}
+
+{{- if .SqrtAtkin}}
+
+// subCT z = x - y (mod q), without branching on the values of x and y
+func (z *{{.ElementName}}) subCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .F31}}
+	// x - y ∈ (-q, q) fits on 32 signed bits; add q back if it is negative
+	r := x[0] - y[0]
+	z[0] = r + (q & uint32(int32(r)>>31))
+	{{- else}}
+	var b uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], {{if eq $i 0}}0{{else}}b{{end}})
+	{{- end}}
+	// if there is a borrow, add q
+	mask := -b
+	{{- if eq .NbWords 1}}
+	z[0] += q0 & mask
+	{{- else}}
+	var c uint64
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}], {{if eq $i $.NbWordsLastIndex}}_{{else}}c{{end}} = bits.Add64(z[{{$i}}], q{{$i}}&mask, {{if eq $i 0}}0{{else}}c{{end}})
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	return z
+}
+{{- end}}
+`
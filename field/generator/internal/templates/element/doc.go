@@ -8,7 +8,7 @@ const Doc = `
 // The modulus is hardcoded in all the operations.
 // 
 // Field elements are represented as an array, and assumed to be in Montgomery form in all methods:
-// 	type {{.ElementName}} [{{.NbWords}}]uint64
+// 	type {{.ElementName}} [{{.NbWords}}]{{$.Word.TypeLower}}
 //
 // Usage
 //
@@ -31,8 +31,17 @@ const Doc = `
 // 	q[base10] = {{.Modulus}}
 // 	q[base16] = 0x{{.ModulusHex}}
 //
+// Constant time
+//
+// Inverse, Exp, Sqrt and Legendre branch on their inputs and are meant for public values.
+// InverseCT, ExpCT, SqrtCT and LegendreCT are their constant-time counterparts, for secret values:
+// the sequence of operations and memory accesses doesn't depend on the input (nor on the bits of the exponent in ExpCT),
+// at the cost of a slower running time. The rule in this library is that a code path handling secrets (the signature
+// schemes) uses the CT variants for these operations; the other methods (Add, Mul, ...) make no such guarantee.
+// The harness in internal/dudect measures the CT variants; it is meant to be run locally.
+//
 // Warning
 //
-// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance, beyond the best effort of the CT variants above.
 package {{.PackageName}}
 `
@@ -0,0 +1,89 @@
+package element
+
+// Mul32 is the template of Mul and Square on 32-bit architectures.
+//
+// The limbs are split in 32-bit words and the CIOS algorithm runs on 2·NbWords words, such that
+// all products fit on a uint64 and no call to bits.Mul64 (which is emulated on these targets) is needed.
+// Since R = 2^(64·NbWords) = 2^(32·2·NbWords), the Montgomery form is the same as on 64-bit architectures.
+const Mul32 = `
+import "math/bits"
+
+{{- $n := mul 2 $.NbWords}}
+
+// qInvNeg32 = -q⁻¹ mod 2³²
+const qInvNeg32 = uint32(qInvNeg & 0xFFFFFFFF)
+
+// Mul z = x * y (mod q)
+{{- if $.NoCarry}}
+//
+// x and y must be less than q
+{{- end }}
+func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	mul32(z, x, y)
+	return z
+}
+
+// Square z = x * x (mod q)
+{{- if $.NoCarry}}
+//
+// x must be less than q
+{{- end }}
+func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
+	mul32(z, x, x)
+	return z
+}
+
+// mul32 sets z = x * y (mod q) using the CIOS Montgomery multiplication on 32-bit words.
+func mul32(z, x, y *{{.ElementName}}) {
+	var a, b [{{$n}}]uint32
+	{{- range $i := $.NbWordsIndexesFull}}
+	a[{{mul 2 $i}}], a[{{add (mul 2 $i) 1}}] = uint32(x[{{$i}}]), uint32(x[{{$i}}]>>32)
+	{{- end}}
+	{{- range $i := $.NbWordsIndexesFull}}
+	b[{{mul 2 $i}}], b[{{add (mul 2 $i) 1}}] = uint32(y[{{$i}}]), uint32(y[{{$i}}]>>32)
+	{{- end}}
+
+	// t holds the running result on {{add $n 2}} words
+	var t [{{add $n 2}}]uint32
+	var C uint64
+	var m uint32
+	{{- range $i := iterate 0 $n}}
+
+	// t = t + a * b[{{$i}}]
+	{{- range $j := iterate 0 $n}}
+	C = {{if ne $j 0}}C>>32 + {{end}}{{if ne $i 0}}uint64(t[{{$j}}]) + {{end}}uint64(a[{{$j}}])*uint64(b[{{$i}}])
+	t[{{$j}}] = uint32(C)
+	{{- end}}
+	C = C>>32{{if ne $i 0}} + uint64(t[{{$n}}]){{end}}
+	t[{{$n}}] = uint32(C)
+	t[{{add $n 1}}] = uint32(C >> 32)
+
+	// t = (t + m * q) / 2³², with m such that the lowest word cancels
+	m = t[0] * qInvNeg32
+	C = (uint64(t[0]) + uint64(m)*{{template "q32" 0}}) >> 32
+	{{- range $j := iterate 1 $n}}
+	C += uint64(t[{{$j}}]) + uint64(m)*{{template "q32" $j}}
+	t[{{sub $j 1}}] = uint32(C)
+	C >>= 32
+	{{- end}}
+	C += uint64(t[{{$n}}])
+	t[{{sub $n 1}}] = uint32(C)
+	t[{{$n}}] = t[{{add $n 1}}] + uint32(C>>32)
+	{{- end}}
+
+	// t < 2q, recombine the limbs and reduce
+	{{- range $i := $.NbWordsIndexesFull}}
+	z[{{$i}}] = uint64(t[{{mul 2 $i}}]) | uint64(t[{{add (mul 2 $i) 1}}])<<32
+	{{- end}}
+	if t[{{$n}}] != 0 || !z.smallerThanModulus() {
+		{{- if ne $.NbWords 1}}
+		var b uint64
+		{{- end}}
+		{{- range $i := $.NbWordsIndexesFull}}
+		z[{{$i}}], {{if eq $i $.NbWordsLastIndex}}_{{else}}b{{end}} = bits.Sub64(z[{{$i}}], q{{$i}}, {{if eq $i 0}}0{{else}}b{{end}})
+		{{- end}}
+	}
+}
+
+{{- define "q32"}}{{if eq (mod . 2) 0}}(q{{div . 2}} & 0xFFFFFFFF){{else}}(q{{div . 2}} >> 32){{end}}{{end}}
+`
@@ -70,6 +70,10 @@ const MulCIOS = `
 		 t[{{$.all.NbWords}}], _ = bits.Add64(0, D, C)
 	{{- end}}
 
+	{{ if $.CT}}
+	// t < 2q on {{add 1 $.all.NbWords}} words
+	{{ template "reduce_ct" dict "all" $.all "T" "t" "Hi" (printf "t[%d]" $.all.NbWords) }}
+	{{- else}}
 
 	if t[{{$.all.NbWords}}] != 0 {
 		// we need to reduce, we have a result on {{add 1 $.all.NbWords}} words
@@ -91,10 +95,21 @@ const MulCIOS = `
 	{{- range $i := $.all.NbWordsIndexesFull}}
 		z[{{$i}}] = t[{{$i}}]
 	{{- end}}
-
+	{{- end}}
 {{ end }}
 
 {{ define "mul_cios_one_limb" }}
+	{{- if $.all.F31}}
+	// q < 2³¹ and R = 2³², hence x * y + m * q < 2⁶² + 2⁶³ fits on a uint64;
+	// we compute the Montgomery reduction (textbook REDC) with 64-bit intermediate products
+	v := uint64({{$.V1}}[0]) * uint64({{$.V2}}[0])
+	m := uint32(v) * qInvNeg
+	r := uint32((v + uint64(m)*uint64(q)) >> 32)
+	if r >= q {
+		r -= q
+	}
+	z[0] = r
+	{{- else}}
 	// In fact, since the modulus R fits on one register, the CIOS algorithm gets reduced to standard REDC (textbook Montgomery reduction):
 	// hi, lo := x * y
 	// m := (lo * qInvNeg) mod R
@@ -121,6 +136,7 @@ const MulCIOS = `
 		r -= q 
 	}
 	z[0] = r 
+	{{- end}}
 {{ end }}
 `
 
@@ -0,0 +1,74 @@
+package element
+
+// MulSpecial is the CIOS multiplication for moduli of the special form q = 2ᵏ - c, with c < 2⁶⁴ (see config.SpecialForm).
+//
+// Since q = -c mod 2⁶⁴, the low word of m·c is t[0] in each reduction step, and
+// (t + m·q) / 2⁶⁴ = (t - m·c) / 2⁶⁴ + m·2ᵏ⁻⁶⁴ costs one word multiplication (instead of NbWords)
+// and a couple of carry chains.
+const MulSpecial = `
+{{ define "mul_special" }}
+	// q = 2^{{.all.SpecialForm.K}} - c, with c = {{printf "%#x" .all.SpecialForm.C}} ({{.all.SpecialForm.Name}}).
+	// We use the CIOS algorithm, where in each step the reduction
+	// t = (t + m·q) / 2⁶⁴
+	// is computed as
+	// t = (t - m·c) / 2⁶⁴ + m·2^{{sub .all.SpecialForm.K 64}}
+	// since q = -c mod 2⁶⁴, the low word of m·c is t[0] and only its high word is needed.
+	var t [{{add .all.NbWords 2}}]uint64
+	var m, hi, b, C uint64
+
+	{{- range $j := .all.NbWordsIndexesFull}}
+
+	// t = t + {{$.V1}} * {{$.V2}}[{{$j}}]
+	{{- if eq $j 0}}
+		C, t[0] = bits.Mul64({{$.V2}}[0], {{$.V1}}[0])
+		{{- range $i := $.all.NbWordsIndexesNoZero}}
+			C, t[{{$i}}] = madd1({{$.V2}}[0], {{$.V1}}[{{$i}}], C)
+		{{- end}}
+		t[{{$.all.NbWords}}] = C
+	{{- else}}
+		C, t[0] = madd1({{$.V2}}[{{$j}}], {{$.V1}}[0], t[0])
+		{{- range $i := $.all.NbWordsIndexesNoZero}}
+			C, t[{{$i}}] = madd2({{$.V2}}[{{$j}}], {{$.V1}}[{{$i}}], t[{{$i}}], C)
+		{{- end}}
+		t[{{$.all.NbWords}}], t[{{add $.all.NbWords 1}}] = bits.Add64(t[{{$.all.NbWords}}], C, 0)
+	{{- end}}
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+	hi, _ = bits.Mul64(m, {{printf "%#x" $.all.SpecialForm.C}})
+
+	// t = (t - m·c) / 2⁶⁴
+	t[0], b = bits.Sub64(t[1], hi, 0)
+	{{- range $i := iterate 2 (add $.all.NbWords 2)}}
+		t[{{sub $i 1}}], b = bits.Sub64(t[{{$i}}], 0, b)
+	{{- end}}
+
+	// t = t + m·2^{{sub $.all.SpecialForm.K 64}}
+	{{- $w := $.all.SpecialForm.Word}}
+	{{- $s := $.all.SpecialForm.Shift}}
+	{{- if eq $s 0}}
+		t[{{$w}}], C = bits.Add64(t[{{$w}}], m, 0)
+		{{- range $i := iterate (add $w 1) (add $.all.NbWords 1)}}
+			t[{{$i}}], C = bits.Add64(t[{{$i}}], 0, C)
+		{{- end}}
+	{{- else}}
+		t[{{$w}}], C = bits.Add64(t[{{$w}}], m<<{{$s}}, 0)
+		t[{{add $w 1}}], C = bits.Add64(t[{{add $w 1}}], m>>{{sub 64 $s}}, C)
+		{{- range $i := iterate (add $w 2) (add $.all.NbWords 1)}}
+			t[{{$i}}], C = bits.Add64(t[{{$i}}], 0, C)
+		{{- end}}
+	{{- end}}
+	{{- end}}
+
+	// t < 2q, on {{add .all.NbWords 1}} words
+	{{- range $i := .all.NbWordsIndexesFull}}
+		z[{{$i}}] = t[{{$i}}]
+	{{- end}}
+	if t[{{.all.NbWords}}] != 0 || !z.smallerThanModulus() {
+		z[0], b = bits.Sub64(z[0], q0, 0)
+		{{- range $i := .all.NbWordsIndexesNoZero}}
+			z[{{$i}}], b = bits.Sub64(z[{{$i}}], q{{$i}}, b)
+		{{- end}}
+	}
+{{ end }}
+`
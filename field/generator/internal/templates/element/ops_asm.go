@@ -3,8 +3,9 @@ package element
 // OpsAMD64 is included with AMD64 builds (regardless of architecture or if F.ASM is set)
 const OpsAMD64 = `
 
-{{if .ASM}}
+{{if .ASMMul}}
 
+{{- if .ASM}}
 //go:noescape
 func MulBy3(x *{{.ElementName}})
 
@@ -13,10 +14,12 @@ func MulBy5(x *{{.ElementName}})
 
 //go:noescape
 func MulBy13(x *{{.ElementName}})
+{{- end}}
 
 //go:noescape
 func mul(res,x,y *{{.ElementName}})
 
+{{- if .ASM}}
 //go:noescape
 func fromMont(res *{{.ElementName}})
 
@@ -28,6 +31,7 @@ func reduce(res *{{.ElementName}})
 //  b = a - b (mod q)
 //go:noescape
 func Butterfly(a, b *{{.ElementName}})
+{{- end}}
 
 
 
@@ -35,7 +39,12 @@ func Butterfly(a, b *{{.ElementName}})
 //
 // x and y must be less than q
 func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- if $.SpecialReduction}}
+	// the modulus has the special form 2^{{$.SpecialForm.K}} - {{printf "%#x" $.SpecialForm.C}}, see the pure Go implementation
+	// for the algorithm documentation.
+	{{- else}}
 	{{ mul_doc $.NoCarry }}
+	{{- end}}
 	mul(z, x, y)
 	return z
 }
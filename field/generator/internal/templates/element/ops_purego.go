@@ -2,8 +2,6 @@ package element
 
 const OpsNoAsm = `
 
-import "math/bits"
-
 {{ $mulConsts := list 3 5 13 }}
 {{- range $i := $mulConsts }}
 
@@ -50,7 +48,14 @@ func reduce(z *{{.ElementName}})  {
 	_reduceGeneric(z)
 }
 
+`
 
+// MulPurego is the template of Mul and Square on 64-bit words, used when no assembly is
+// available on 64-bit architectures
+const MulPurego = `
+{{- if not $.F31}}
+import "math/bits"
+{{- end}}
 
 // Mul z = x * y (mod q)
 {{- if $.NoCarry}}
@@ -58,7 +63,9 @@ func reduce(z *{{.ElementName}})  {
 // x and y must be less than q
 {{- end }}
 func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
-	{{- if eq $.NbWords 1}}
+	{{- if $.SpecialReduction}}
+		{{ template "mul_special" dict "all" . "V1" "x" "V2" "y" }}
+	{{- else if eq $.NbWords 1}}
 		{{ template "mul_cios_one_limb" dict "all" . "V1" "x" "V2" "y" }}
 	{{- else }}
 		{{ mul_doc $.NoCarry }}
@@ -79,7 +86,9 @@ func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
 {{- end }}
 func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
 	// see Mul for algorithm documentation
-	{{- if eq $.NbWords 1}}
+	{{- if $.SpecialReduction}}
+		{{ template "mul_special" dict "all" . "V1" "x" "V2" "x" }}
+	{{- else if eq $.NbWords 1}}
 		{{ template "mul_cios_one_limb" dict "all" . "V1" "x" "V2" "x" }}
 	{{- else }}
 		{{- if $.NoCarry}}
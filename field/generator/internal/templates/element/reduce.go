@@ -21,4 +21,23 @@ if !z.smallerThanModulus() {
 
 {{-  end }}
 
+{{ define "reduce_ct" -}}
+// if {{.T}} ⩾ q → z = {{.T}} - q, else z = {{.T}}; without branching on the value of {{.T}}
+{
+	var s [{{.all.NbWords}}]uint64
+	var b uint64
+	{{- range $i := .all.NbWordsIndexesFull}}
+	s[{{$i}}], b = bits.Sub64({{$.T}}[{{$i}}], q{{$i}}, {{if eq $i 0}}0{{else}}b{{end}})
+	{{- end}}
+	{{- if .Hi}}
+	_, b = bits.Sub64({{.Hi}}, 0, b)
+	{{- end}}
+	// mask = 2⁶⁴-1 if there is no borrow, 0 otherwise
+	mask := b - 1
+	{{- range $i := .all.NbWordsIndexesFull}}
+	z[{{$i}}] = {{$.T}}[{{$i}}] ^ (({{$.T}}[{{$i}}] ^ s[{{$i}}]) & mask)
+	{{- end}}
+}
+{{-  end }}
+
 `
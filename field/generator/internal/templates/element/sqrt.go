@@ -2,7 +2,6 @@ package element
 
 const Sqrt = `
 
-{{ if not .UseAddChain}}
 var (
 	_bLegendreExponent{{.ElementName}} *big.Int
 	_bSqrtExponent{{.ElementName}} *big.Int
@@ -20,8 +19,6 @@ func init() {
 	_bSqrtExponent{{.ElementName}}, _ = new(big.Int).SetString(sqrtExponent{{.ElementName}}, 16)
 }
 
-{{- end }}
-
 // Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
 func (z *{{.ElementName}}) Legendre() int {
 	var l {{.ElementName}}
@@ -207,6 +207,23 @@ func Benchmark{{toTitle .ElementName}}Mul(b *testing.B) {
 	}
 }
 
+{{- if .SpecialReduction}}
+
+// Benchmark{{toTitle .ElementName}}MulCIOS measures the textbook CIOS multiplication, used without the
+// reduction dedicated to the special form of q, for comparison with Benchmark{{toTitle .ElementName}}Mul
+func Benchmark{{toTitle .ElementName}}MulCIOS(b *testing.B) {
+	x := {{.ElementName}}{
+		{{- range $i := .RSquare}}
+		{{$i}},{{end}}
+	}
+	benchRes{{.ElementName}}.SetOne()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_mulGeneric(&benchRes{{.ElementName}}, &benchRes{{.ElementName}}, &x)
+	}
+}
+{{- end}}
+
 func Benchmark{{toTitle .ElementName}}Cmp(b *testing.B) {
 	x := {{.ElementName}}{
 		{{- range $i := .RSquare}}
@@ -1577,9 +1594,9 @@ func gen() gopter.Gen {
 
 		g.element = {{.ElementName}}{
 			{{- range $i := .NbWordsIndexesFull}}
-			genParams.NextUint64(),{{end}}
+			{{if $.F31}}uint32(genParams.NextUint64()){{else}}genParams.NextUint64(){{end}},{{end}}
 		}
-		if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^uint64(0) {
+		if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^{{$.Word.TypeLower}}(0) {
 			g.element[{{.NbWordsLastIndex}}] %= (q{{.ElementName}}[{{.NbWordsLastIndex}}] +1 )
 		}
 		
@@ -1587,9 +1604,9 @@ func gen() gopter.Gen {
 		for !g.element.smallerThanModulus() {
 			g.element = {{.ElementName}}{
 				{{- range $i := .NbWordsIndexesFull}}
-				genParams.NextUint64(),{{end}}
+				{{if $.F31}}uint32(genParams.NextUint64()){{else}}genParams.NextUint64(){{end}},{{end}}
 			}
-			if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^uint64(0) {
+			if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^{{$.Word.TypeLower}}(0) {
 				g.element[{{.NbWordsLastIndex}}] %= (q{{.ElementName}}[{{.NbWordsLastIndex}}] +1 )
 			}
 		}
@@ -1609,19 +1626,19 @@ func genFull() gopter.Gen {
 
 			g = {{.ElementName}}{
 				{{- range $i := .NbWordsIndexesFull}}
-				genParams.NextUint64(),{{end}}
+				{{if $.F31}}uint32(genParams.NextUint64()){{else}}genParams.NextUint64(){{end}},{{end}}
 			}
 
-			if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^uint64(0) {
+			if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^{{$.Word.TypeLower}}(0) {
 				g[{{.NbWordsLastIndex}}] %= (q{{.ElementName}}[{{.NbWordsLastIndex}}] +1 )
 			}
 
 			for !g.smallerThanModulus() {
 				g = {{.ElementName}}{
 					{{- range $i := .NbWordsIndexesFull}}
-					genParams.NextUint64(),{{end}}
+					{{if $.F31}}uint32(genParams.NextUint64()){{else}}genParams.NextUint64(){{end}},{{end}}
 				}
-				if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^uint64(0) {
+				if q{{.ElementName}}[{{.NbWordsLastIndex}}] != ^{{$.Word.TypeLower}}(0) {
 					g[{{.NbWordsLastIndex}}] %= (q{{.ElementName}}[{{.NbWordsLastIndex}}] +1 )
 				}
 			}
@@ -1630,12 +1647,12 @@ func genFull() gopter.Gen {
 		}
 		a := genRandomFq()
 
-		var carry uint64
+		var carry {{$.Word.TypeLower}}
 		{{- range $i := .NbWordsIndexesFull}}
 			{{- if eq $i $.NbWordsLastIndex}}
-			a[{{$i}}], _ = bits.Add64(a[{{$i}}], q{{$.ElementName}}[{{$i}}], carry)
+			a[{{$i}}], _ = bits.{{$.Word.Add}}(a[{{$i}}], q{{$.ElementName}}[{{$i}}], carry)
 			{{- else}}
-			a[{{$i}}], carry = bits.Add64(a[{{$i}}], q{{$.ElementName}}[{{$i}}], carry)
+			a[{{$i}}], carry = bits.{{$.Word.Add}}(a[{{$i}}], q{{$.ElementName}}[{{$i}}], carry)
 			{{- end}}
 		{{- end}}
 		
@@ -0,0 +1,127 @@
+package element
+
+// VectorOpsAMD64 is included with amd64 builds if the field has vector kernels in assembly (see ASMVector)
+const VectorOpsAMD64 = `
+
+// add sets vector[i] = a[i] + b[i]; the vectors must have the same length.
+func (vector Vector) add(a, b Vector) {
+	if len(vector) == 0 {
+		return
+	}
+	addVec(&vector[0], &a[0], &b[0], uint64(len(vector)))
+}
+
+// sub sets vector[i] = a[i] - b[i]; the vectors must have the same length.
+func (vector Vector) sub(a, b Vector) {
+	if len(vector) == 0 {
+		return
+	}
+	subVec(&vector[0], &a[0], &b[0], uint64(len(vector)))
+}
+
+// mul sets vector[i] = a[i] * b[i]; the vectors must have the same length.
+func (vector Vector) mul(a, b Vector) {
+	if len(vector) == 0 {
+		return
+	}
+	if !supportAdx {
+		for i := range vector {
+			vector[i].Mul(&a[i], &b[i])
+		}
+		return
+	}
+	mulVec(&vector[0], &a[0], &b[0], uint64(len(vector)))
+}
+
+// scalarMul sets vector[i] = a[i] * b; the vectors must have the same length.
+func (vector Vector) scalarMul(a Vector, b *{{.ElementName}}) {
+	if len(vector) == 0 {
+		return
+	}
+	if !supportAdx {
+		for i := range vector {
+			vector[i].Mul(&a[i], b)
+		}
+		return
+	}
+	scalarMulVec(&vector[0], &a[0], b, uint64(len(vector)))
+}
+
+// innerProduct returns the inner product of vector and other; the vectors must have the same length.
+func (vector Vector) innerProduct(other Vector) (res {{.ElementName}}) {
+	if len(vector) == 0 {
+		return
+	}
+	if !supportAdx {
+		var tmp {{.ElementName}}
+		for i := range vector {
+			tmp.Mul(&vector[i], &other[i])
+			res.Add(&res, &tmp)
+		}
+		return
+	}
+	innerProdVec(&res, &vector[0], &other[0], uint64(len(vector)))
+	return
+}
+
+// the kernels below process n > 0 elements; they are implemented in {{toLower .ElementName}}_ops_amd64.s
+// and, except addVec and subVec, require the ADX and BMI2 instructions.
+
+//go:noescape
+func addVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func subVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func mulVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func scalarMulVec(res, a, b *{{.ElementName}}, n uint64)
+
+// innerProdVec sets res = res + a[0]*b[0] + ... + a[n-1]*b[n-1]
+//go:noescape
+func innerProdVec(res, a, b *{{.ElementName}}, n uint64)
+`
+
+// VectorOpsPurego is the generic implementation of the VectorOpsAMD64 methods
+const VectorOpsPurego = `
+
+// add sets vector[i] = a[i] + b[i]; the vectors must have the same length.
+func (vector Vector) add(a, b Vector) {
+	for i := range vector {
+		vector[i].Add(&a[i], &b[i])
+	}
+}
+
+// sub sets vector[i] = a[i] - b[i]; the vectors must have the same length.
+func (vector Vector) sub(a, b Vector) {
+	for i := range vector {
+		vector[i].Sub(&a[i], &b[i])
+	}
+}
+
+// mul sets vector[i] = a[i] * b[i]; the vectors must have the same length.
+func (vector Vector) mul(a, b Vector) {
+	for i := range vector {
+		vector[i].Mul(&a[i], &b[i])
+	}
+}
+
+// scalarMul sets vector[i] = a[i] * b; the vectors must have the same length.
+func (vector Vector) scalarMul(a Vector, b *{{.ElementName}}) {
+	for i := range vector {
+		vector[i].Mul(&a[i], b)
+	}
+}
+
+// innerProduct returns the inner product of vector and other; the vectors must have the same length.
+func (vector Vector) innerProduct(other Vector) (res {{.ElementName}}) {
+	var tmp {{.ElementName}}
+	for i := range vector {
+		tmp.Mul(&vector[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
+`
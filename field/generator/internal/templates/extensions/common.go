@@ -0,0 +1,90 @@
+package extensions
+
+// Common holds the template blocks shared by E2 and E3
+const Common = `
+{{- define "setConstant"}}
+	{{- range $c := .C}}
+	{{$.V}}{{$c.Path}}.SetString("{{$c.Value}}")
+	{{- end}}
+{{- end}}
+
+{{- define "mulByNonResidue"}}
+	{{- if .all.NonResidueIsBaseVar}}
+	{{.V}}.MulByNonResidue(&{{.V}})
+	{{- else}}
+	{{.V}}.Mul(&{{.V}}, &nonResidue{{.all.Name}})
+	{{- end}}
+{{- end}}
+
+{{- define "exp"}}
+// Exp sets z=xᵏ in {{.Name}} and returns it
+func (z *{{.Name}}) Exp(x {{.Name}}, k *big.Int) *{{.Name}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+{{- end}}
+
+{{- define "batchInvert"}}
+// BatchInvert{{.Name}} returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvert{{.Name}}(a []{{.Name}}) []{{.Name}} {
+	res := make([]{{.Name}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator {{.Name}}
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+{{- end}}
+`
@@ -0,0 +1,16 @@
+package extensions
+
+const Doc = `
+// Package {{.PackageName}} provides binomial extensions of {{.FF}}.{{.ElementName}}:
+//
+{{- range .Extensions}}
+//	{{.Name}} = {{.BaseName}}[{{.Var}}]/({{.Var}}{{if eq .Degree 2}}²{{else}}³{{end}} - {{if .NonResidueIsInteger}}{{.NonResidue}}{{else}}({{.NonResidue}}){{end}})
+{{- end}}
+//
+// Coefficients of an extension element are {{.FF}}.{{.ElementName}}, in Montgomery form.
+//
+// Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package {{.PackageName}}
+`
@@ -0,0 +1,300 @@
+package extensions
+
+const E2 = `
+import (
+	"math/big"
+
+	"{{.FieldPackagePath}}"
+)
+
+// {{.Name}} is a degree two finite field extension of {{.BaseName}}
+//
+// {{.Name}} = A0 + A1·{{.Var}}, with {{.Var}}² = {{.NonResidue}}
+type {{.Name}} struct {
+	A0, A1 {{.BaseType}}
+}
+
+// nonResidue{{.Name}} is {{.Var}}² = {{.NonResidue}}
+var nonResidue{{.Name}} {{.BaseType}}
+{{- if not .BaseIsField}}
+
+// frobenius{{.Name}} is ({{.NonResidue}})^((p-1)/2), such that {{.Var}}^p = frobenius{{.Name}}·{{.Var}}
+var frobenius{{.Name}} {{.BaseType}}
+{{- end}}
+
+func init() {
+	{{- template "setConstant" dict "V" (print "nonResidue" .Name) "C" .NonResidueCoordinates}}
+	{{- if not .BaseIsField}}
+	{{- template "setConstant" dict "V" (print "frobenius" .Name) "C" (index .FrobeniusCoefficients 1)}}
+	{{- end}}
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{.Name}}) Equal(x *{{.Name}}) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *{{.Name}}) Cmp(x *{{.Name}}) int {
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+{{- if .BaseIsField}}
+
+// SetString sets a {{.Name}} element from strings
+func (z *{{.Name}}) SetString(s0, s1 string) *{{.Name}} {
+	z.A0.SetString(s0)
+	z.A1.SetString(s1)
+	return z
+}
+{{- end}}
+
+// SetZero sets an {{.Name}} elmt to zero
+func (z *{{.Name}}) SetZero() *{{.Name}} {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// Set sets an {{.Name}} from x
+func (z *{{.Name}}) Set(x *{{.Name}}) *{{.Name}} {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *{{.Name}}) SetOne() *{{.Name}} {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// SetRandom sets a0 and a1 to random values
+func (z *{{.Name}}) SetRandom() (*{{.Name}}, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *{{.Name}}) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *{{.Name}}) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// Add adds two elements of {{.Name}}
+func (z *{{.Name}}) Add(x, y *{{.Name}}) *{{.Name}} {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub two elements of {{.Name}}
+func (z *{{.Name}}) Sub(x, y *{{.Name}}) *{{.Name}} {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double doubles an {{.Name}} element
+func (z *{{.Name}}) Double(x *{{.Name}}) *{{.Name}} {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg negates an {{.Name}} element
+func (z *{{.Name}}) Neg(x *{{.Name}}) *{{.Name}} {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *{{.Name}}) String() string {
+	{{- if .BaseIsField}}
+	return z.A0.String() + "+" + z.A1.String() + "*{{.Var}}"
+	{{- else}}
+	return "(" + z.A0.String() + ")+(" + z.A1.String() + ")*{{.Var}}"
+	{{- end}}
+}
+
+// Mul sets z to the {{.Name}}-product of x,y, returns z
+func (z *{{.Name}}) Mul(x, y *{{.Name}}) *{{.Name}} {
+	var a, b, c {{.BaseType}}
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	{{- template "mulByNonResidue" dict "all" . "V" "c"}}
+	z.A0.Add(&b, &c)
+	return z
+}
+
+// Square sets z to the {{.Name}}-product of x,x returns z
+func (z *{{.Name}}) Square(x *{{.Name}}) *{{.Name}} {
+	var a, b {{.BaseType}}
+	a.Square(&x.A0)
+	b.Square(&x.A1)
+	{{- template "mulByNonResidue" dict "all" . "V" "b"}}
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&a, &b)
+	return z
+}
+
+// MulByElement multiplies an element in {{.Name}} by an element in {{.FF}}
+func (z *{{.Name}}) MulByElement(x *{{.Name}}, y *{{.FF}}.{{.ElementName}}) *{{.Name}} {
+	var yCopy {{.FF}}.{{.ElementName}}
+	yCopy.Set(y)
+	{{- if .BaseIsField}}
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	{{- else}}
+	z.A0.MulByElement(&x.A0, &yCopy)
+	z.A1.MulByElement(&x.A1, &yCopy)
+	{{- end}}
+	return z
+}
+
+// MulByNonResidue multiplies an element in {{.Name}} by {{.Var}}
+func (z *{{.Name}}) MulByNonResidue(x *{{.Name}}) *{{.Name}} {
+	var a {{.BaseType}}
+	a.Set(&x.A1)
+	{{- template "mulByNonResidue" dict "all" . "V" "a"}}
+	z.A1 = x.A0
+	z.A0 = a
+	return z
+}
+
+// Conjugate conjugates an element in {{.Name}}
+func (z *{{.Name}}) Conjugate(x *{{.Name}}) *{{.Name}} {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Frobenius sets z to x^p, where p is the modulus of {{.FF}}, and returns z
+{{- if .BaseIsField}}
+//
+// Since {{.Var}}² = {{.NonResidue}} is a quadratic non-residue, {{.Var}}^p = -{{.Var}} and the Frobenius map is the conjugation.
+func (z *{{.Name}}) Frobenius(x *{{.Name}}) *{{.Name}} {
+	return z.Conjugate(x)
+}
+{{- else}}
+func (z *{{.Name}}) Frobenius(x *{{.Name}}) *{{.Name}} {
+	z.A0.Frobenius(&x.A0)
+	z.A1.Frobenius(&x.A1).Mul(&z.A1, &frobenius{{.Name}})
+	return z
+}
+{{- end}}
+
+// Norm sets x to the norm of z over {{.BaseName}}, x = a0² - ({{.NonResidue}})·a1²
+func (z *{{.Name}}) Norm(x *{{.BaseType}}) {
+	var tmp {{.BaseType}}
+	x.Square(&z.A0)
+	tmp.Square(&z.A1)
+	{{- template "mulByNonResidue" dict "all" . "V" "tmp"}}
+	x.Sub(x, &tmp)
+}
+
+// Inverse sets z to the {{.Name}}-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *{{.Name}}) Inverse(x *{{.Name}}) *{{.Name}} {
+	var norm {{.BaseType}}
+	x.Norm(&norm)
+	norm.Inverse(&norm)
+	z.A0.Mul(&x.A0, &norm)
+	z.A1.Mul(&x.A1, &norm).Neg(&z.A1)
+	return z
+}
+
+// Div sets z = x / y and returns z
+func (z *{{.Name}}) Div(x, y *{{.Name}}) *{{.Name}} {
+	var r {{.Name}}
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+{{ template "exp" .}}
+
+// Legendre returns the Legendre symbol of z
+//
+// z is a square in {{.Name}} iff its norm is a square in {{.BaseName}}.
+func (z *{{.Name}}) Legendre() int {
+	var n {{.BaseType}}
+	z.Norm(&n)
+	return n.Legendre()
+}
+
+// Sqrt z = √x in {{.Name}}
+// if the square root doesn't exist (x is not a square in {{.Name}})
+// Sqrt leaves z unchanged and returns nil
+func (z *{{.Name}}) Sqrt(x *{{.Name}}) *{{.Name}} {
+	var a0, a1, delta {{.BaseType}}
+	var half {{.FF}}.{{.ElementName}}
+
+	if x.A1.IsZero() {
+		// x = a0 ∈ {{.BaseName}}: either a0 is a square in {{.BaseName}}, or a0/({{.NonResidue}}) is
+		if a0.Sqrt(&x.A0) != nil {
+			z.A0 = a0
+			z.A1.SetZero()
+			return z
+		}
+		a1.Inverse(&nonResidue{{.Name}}).Mul(&a1, &x.A0)
+		if a1.Sqrt(&a1) == nil {
+			return nil
+		}
+		z.A0.SetZero()
+		z.A1 = a1
+		return z
+	}
+
+	// x = (a0 + a1·{{.Var}})², with a0² + ({{.NonResidue}})·a1² = x.A0 and 2·a0·a1 = x.A1
+	// then a0² = (x.A0 ± √N(x)) / 2
+	x.Norm(&delta)
+	if delta.Sqrt(&delta) == nil {
+		return nil
+	}
+	half.SetUint64(2).Inverse(&half)
+
+	{{- if .BaseIsField}}
+	a0.Add(&x.A0, &delta).Mul(&a0, &half)
+	if a0.Legendre() == -1 {
+		a0.Sub(&x.A0, &delta).Mul(&a0, &half)
+	}
+	{{- else}}
+	a0.Add(&x.A0, &delta).MulByElement(&a0, &half)
+	if a0.Legendre() == -1 {
+		a0.Sub(&x.A0, &delta).MulByElement(&a0, &half)
+	}
+	{{- end}}
+	if a0.Sqrt(&a0) == nil {
+		return nil
+	}
+	a1.Double(&a0).Inverse(&a1).Mul(&a1, &x.A1)
+
+	z.A0 = a0
+	z.A1 = a1
+	return z
+}
+
+{{ template "batchInvert" .}}
+`
@@ -0,0 +1,402 @@
+package extensions
+
+const E3 = `
+import (
+	"math/big"
+
+	"{{.FieldPackagePath}}"
+)
+
+// {{.Name}} is a degree three finite field extension of {{.BaseName}}
+//
+// {{.Name}} = A0 + A1·{{.Var}} + A2·{{.Var}}², with {{.Var}}³ = {{.NonResidue}}
+type {{.Name}} struct {
+	A0, A1, A2 {{.BaseType}}
+}
+
+var (
+	// nonResidue{{.Name}} is {{.Var}}³ = {{.NonResidue}}
+	nonResidue{{.Name}} {{.BaseType}}
+
+	// frobenius{{.Name}}[i] = ({{.NonResidue}})^⌊i·p/3⌋, such that ({{.Var}}^i)^p = frobenius{{.Name}}[i]·{{.Var}}^(i·p mod 3)
+	frobenius{{.Name}} [3]{{.BaseType}}
+
+	// parameters of the Tonelli-Shanks square root: |{{.Name}}|-1 = 2^sqrtS{{.Name}} · t, with t odd
+	sqrtG{{.Name}}               {{.BaseType}} // a non-square of {{.BaseName}} raised to the power t
+	sqrtTMinusOneOver2{{.Name}} big.Int            // (t-1)/2
+)
+
+const sqrtS{{.Name}} = {{.SqrtS}}
+
+func init() {
+	{{- template "setConstant" dict "V" (print "nonResidue" .Name) "C" .NonResidueCoordinates}}
+	{{- range $i, $c := .FrobeniusCoefficients}}
+	{{- template "setConstant" dict "V" (print "frobenius" $.Name "[" $i "]") "C" $c}}
+	{{- end}}
+	{{- template "setConstant" dict "V" (print "sqrtG" .Name) "C" .SqrtG}}
+	sqrtTMinusOneOver2{{.Name}}.SetString("{{.SqrtTMinusOneOver2}}", 16)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{.Name}}) Equal(x *{{.Name}}) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *{{.Name}}) Cmp(x *{{.Name}}) int {
+	if a2 := z.A2.Cmp(&x.A2); a2 != 0 {
+		return a2
+	}
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+{{- if .BaseIsField}}
+// SetString sets a {{.Name}} element from strings
+func (z *{{.Name}}) SetString(s0, s1, s2 string) *{{.Name}} {
+	z.A0.SetString(s0)
+	z.A1.SetString(s1)
+	z.A2.SetString(s2)
+	return z
+}
+{{- end}}
+
+// SetZero sets an {{.Name}} elmt to zero
+func (z *{{.Name}}) SetZero() *{{.Name}} {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// Set sets an {{.Name}} from x
+func (z *{{.Name}}) Set(x *{{.Name}}) *{{.Name}} {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	z.A2 = x.A2
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *{{.Name}}) SetOne() *{{.Name}} {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// SetRandom sets a0, a1 and a2 to random values
+func (z *{{.Name}}) SetRandom() (*{{.Name}}, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *{{.Name}}) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *{{.Name}}) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Add adds two elements of {{.Name}}
+func (z *{{.Name}}) Add(x, y *{{.Name}}) *{{.Name}} {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub two elements of {{.Name}}
+func (z *{{.Name}}) Sub(x, y *{{.Name}}) *{{.Name}} {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double doubles an {{.Name}} element
+func (z *{{.Name}}) Double(x *{{.Name}}) *{{.Name}} {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg negates an {{.Name}} element
+func (z *{{.Name}}) Neg(x *{{.Name}}) *{{.Name}} {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *{{.Name}}) String() string {
+	{{- if .BaseIsField}}
+	return z.A0.String() + "+(" + z.A1.String() + ")*{{.Var}}+(" + z.A2.String() + ")*{{.Var}}**2"
+	{{- else}}
+	return "(" + z.A0.String() + ")+(" + z.A1.String() + ")*{{.Var}}+(" + z.A2.String() + ")*{{.Var}}**2"
+	{{- end}}
+}
+
+// Mul sets z to the {{.Name}}-product of x,y, returns z
+func (z *{{.Name}}) Mul(x, y *{{.Name}}) *{{.Name}} {
+	// Karatsuba, cf https://eprint.iacr.org/2006/471.pdf (section 4)
+	var t0, t1, t2, c0, c1, c2, tmp {{.BaseType}}
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	c0.Add(&x.A1, &x.A2)
+	tmp.Add(&y.A1, &y.A2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2)
+	{{- template "mulByNonResidue" dict "all" . "V" "c0"}}
+
+	c1.Add(&x.A0, &x.A1)
+	tmp.Add(&y.A0, &y.A1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	tmp.Set(&t2)
+	{{- template "mulByNonResidue" dict "all" . "V" "tmp"}}
+	c1.Add(&c1, &tmp)
+
+	c2.Add(&x.A0, &x.A2)
+	tmp.Add(&y.A0, &y.A2)
+	c2.Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2).Add(&c2, &t1)
+
+	z.A0.Add(&c0, &t0)
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// Square sets z to the {{.Name}}-product of x,x returns z
+func (z *{{.Name}}) Square(x *{{.Name}}) *{{.Name}} {
+	// c0 = a0² + 2·({{.NonResidue}})·a1·a2
+	// c1 = 2·a0·a1 + ({{.NonResidue}})·a2²
+	// c2 = a1² + 2·a0·a2
+	var c0, c1, c2, tmp {{.BaseType}}
+	c0.Square(&x.A0)
+	tmp.Mul(&x.A1, &x.A2).Double(&tmp)
+	{{- template "mulByNonResidue" dict "all" . "V" "tmp"}}
+	c0.Add(&c0, &tmp)
+
+	c1.Mul(&x.A0, &x.A1).Double(&c1)
+	tmp.Square(&x.A2)
+	{{- template "mulByNonResidue" dict "all" . "V" "tmp"}}
+	c1.Add(&c1, &tmp)
+
+	c2.Square(&x.A1)
+	tmp.Mul(&x.A0, &x.A2).Double(&tmp)
+	c2.Add(&c2, &tmp)
+
+	z.A0 = c0
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// MulByElement multiplies an element in {{.Name}} by an element in {{.FF}}
+func (z *{{.Name}}) MulByElement(x *{{.Name}}, y *{{.FF}}.{{.ElementName}}) *{{.Name}} {
+	var yCopy {{.FF}}.{{.ElementName}}
+	yCopy.Set(y)
+	{{- if .BaseIsField}}
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	{{- else}}
+	z.A0.MulByElement(&x.A0, &yCopy)
+	z.A1.MulByElement(&x.A1, &yCopy)
+	z.A2.MulByElement(&x.A2, &yCopy)
+	{{- end}}
+	return z
+}
+
+// mulByBase multiplies an element in {{.Name}} by an element in {{.BaseName}}
+func (z *{{.Name}}) mulByBase(x *{{.Name}}, y *{{.BaseType}}) *{{.Name}} {
+	var yCopy {{.BaseType}}
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	return z
+}
+
+// MulByNonResidue multiplies an element in {{.Name}} by {{.Var}}
+func (z *{{.Name}}) MulByNonResidue(x *{{.Name}}) *{{.Name}} {
+	var a {{.BaseType}}
+	a.Set(&x.A2)
+	{{- template "mulByNonResidue" dict "all" . "V" "a"}}
+	z.A2 = x.A1
+	z.A1 = x.A0
+	z.A0 = a
+	return z
+}
+
+// Frobenius sets z to x^p, where p is the modulus of {{.FF}}, and returns z
+func (z *{{.Name}}) Frobenius(x *{{.Name}}) *{{.Name}} {
+	var c0, c1, c2 {{.BaseType}}
+	{{- range $i, $t := .FrobeniusTargets}}
+	{{- if $.BaseIsField}}
+	{{- if eq $i 0}}
+	c{{$t}}.Set(&x.A{{$i}})
+	{{- else}}
+	c{{$t}}.Mul(&x.A{{$i}}, &frobenius{{$.Name}}[{{$i}}])
+	{{- end}}
+	{{- else}}
+	{{- if eq $i 0}}
+	c{{$t}}.Frobenius(&x.A{{$i}})
+	{{- else}}
+	c{{$t}}.Frobenius(&x.A{{$i}}).Mul(&c{{$t}}, &frobenius{{$.Name}}[{{$i}}])
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	z.A0 = c0
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// Norm sets x to the norm of z over {{.BaseName}}, x = z·z^Q·z^(Q²) where Q = |{{.BaseName}}|
+func (z *{{.Name}}) Norm(x *{{.BaseType}}) {
+	var c0, c1, c2 {{.BaseType}}
+	z.cofactors(&c0, &c1, &c2)
+	z.normFromCofactors(x, &c0, &c1, &c2)
+}
+
+// Inverse sets z to the {{.Name}}-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *{{.Name}}) Inverse(x *{{.Name}}) *{{.Name}} {
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	var c0, c1, c2, norm {{.BaseType}}
+	x.cofactors(&c0, &c1, &c2)
+	x.normFromCofactors(&norm, &c0, &c1, &c2)
+	norm.Inverse(&norm)
+
+	z.A0.Mul(&c0, &norm)
+	z.A1.Mul(&c1, &norm)
+	z.A2.Mul(&c2, &norm)
+	return z
+}
+
+// cofactors sets c0 + c1·{{.Var}} + c2·{{.Var}}² = z^Q·z^(Q²)
+func (z *{{.Name}}) cofactors(c0, c1, c2 *{{.BaseType}}) {
+	var tmp {{.BaseType}}
+
+	// c0 = a0² - ({{.NonResidue}})·a1·a2
+	c0.Square(&z.A0)
+	tmp.Mul(&z.A1, &z.A2)
+	{{- template "mulByNonResidue" dict "all" . "V" "tmp"}}
+	c0.Sub(c0, &tmp)
+
+	// c1 = ({{.NonResidue}})·a2² - a0·a1
+	tmp.Square(&z.A2)
+	{{- template "mulByNonResidue" dict "all" . "V" "tmp"}}
+	c1.Mul(&z.A0, &z.A1)
+	c1.Sub(&tmp, c1)
+
+	// c2 = a1² - a0·a2
+	c2.Square(&z.A1)
+	tmp.Mul(&z.A0, &z.A2)
+	c2.Sub(c2, &tmp)
+}
+
+// normFromCofactors sets x = a0·c0 + ({{.NonResidue}})·(a2·c1 + a1·c2)
+func (z *{{.Name}}) normFromCofactors(x, c0, c1, c2 *{{.BaseType}}) {
+	var t0, t1 {{.BaseType}}
+	t0.Mul(&z.A2, c1)
+	t1.Mul(&z.A1, c2)
+	t0.Add(&t0, &t1)
+	{{- template "mulByNonResidue" dict "all" . "V" "t0"}}
+	t1.Mul(&z.A0, c0)
+	x.Add(&t0, &t1)
+}
+
+// Div sets z = x / y and returns z
+func (z *{{.Name}}) Div(x, y *{{.Name}}) *{{.Name}} {
+	var r {{.Name}}
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+{{ template "exp" .}}
+
+// Legendre returns the Legendre symbol of z
+//
+// Since the extension has odd degree, z is a square in {{.Name}} iff its norm is a square in {{.BaseName}}.
+func (z *{{.Name}}) Legendre() int {
+	var n {{.BaseType}}
+	z.Norm(&n)
+	return n.Legendre()
+}
+
+// Sqrt z = √x in {{.Name}}
+// if the square root doesn't exist (x is not a square in {{.Name}})
+// Sqrt leaves z unchanged and returns nil
+func (z *{{.Name}}) Sqrt(x *{{.Name}}) *{{.Name}} {
+	// Tonelli-Shanks
+	switch x.Legendre() {
+	case -1:
+		return nil
+	case 0:
+		return z.SetZero()
+	}
+
+	var y, b, t, w {{.Name}}
+	var g {{.BaseType}}
+	g.Set(&sqrtG{{.Name}})
+	r := uint64(sqrtS{{.Name}})
+
+	// w = x^((t-1)/2), y = x^((t+1)/2), b = x^t
+	w.Exp(*x, &sqrtTMinusOneOver2{{.Name}})
+	y.Mul(x, &w)
+	b.Mul(&w, &y)
+
+	for {
+		var m uint64
+		t.Set(&b)
+
+		// for i in [1, r) check if t^(2^i) == 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		var gt {{.BaseType}}
+		gt.Set(&g)
+		for ge > 0 {
+			gt.Square(&gt)
+			ge--
+		}
+
+		g.Square(&gt)
+		y.mulByBase(&y, &gt)
+		b.mulByBase(&b, &g)
+		r = m
+	}
+}
+
+{{ template "batchInvert" .}}
+`
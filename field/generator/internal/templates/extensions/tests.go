@@ -0,0 +1,371 @@
+package extensions
+
+const Test = `
+import (
+	"math/big"
+	"testing"
+
+	"{{.FieldPackagePath}}"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+
+// Gen{{.Name}} generates an {{.Name}} elmt
+func Gen{{.Name}}() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var e {{.Name}}
+		if _, err := e.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&e, gopter.NoShrinker)
+	}
+}
+
+func Test{{.Name}}ReceiverIsOperand(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := Gen{{.Name}}()
+	genB := Gen{{.Name}}()
+
+	properties.Property("[{{.Name}}] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *{{.Name}}) bool {
+			var c, d {{.Name}}
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Having the receiver as operand (inverse) should output the same result", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Having the receiver as operand (mul by non residue) should output the same result", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			b.MulByNonResidue(a)
+			a.MulByNonResidue(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Having the receiver as operand (Frobenius) should output the same result", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{.Name}}Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := Gen{{.Name}}()
+	genB := Gen{{.Name}}()
+	genC := Gen{{.Name}}()
+
+	properties.Property("[{{.Name}}] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *{{.Name}}) bool {
+			var c {{.Name}}
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] mul should be distributive over add", prop.ForAll(
+		func(a, b, c *{{.Name}}) bool {
+			var l, r, tmp {{.Name}}
+			l.Add(b, c).Mul(&l, a)
+			r.Mul(a, b)
+			tmp.Mul(a, c)
+			r.Add(&r, &tmp)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[{{.Name}}] mul should be associative", prop.ForAll(
+		func(a, b, c *{{.Name}}) bool {
+			var l, r {{.Name}}
+			l.Mul(a, b).Mul(&l, c)
+			r.Mul(b, c).Mul(a, &r)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[{{.Name}}] square and mul should output the same result", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] a * a⁻¹ should equal 1", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			b.Inverse(a).Mul(&b, a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] div should be the inverse of mul", prop.ForAll(
+		func(a, b *{{.Name}}) bool {
+			var c {{.Name}}
+			c.Mul(a, b).Div(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] MulByNonResidue should be the multiplication by {{.Var}}", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b, u {{.Name}}
+			u.A1.SetOne()
+			b.MulByNonResidue(a)
+			u.Mul(&u, a)
+			return b.Equal(&u)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] MulByElement should be the multiplication by an embedded base field element", prop.ForAll(
+		func(a, b *{{.Name}}) bool {
+			var c, d {{.Name}}
+			e := b.A0{{.PrimePath}}
+			d.A0{{.PrimePath}} = e
+			c.MulByElement(a, &e)
+			d.Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] Frobenius should be the exponentiation by p", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Frobenius(a)
+			c.Exp(*a, {{.FF}}.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Norm should be the product of the conjugates", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var n {{.BaseType}}
+			a.Norm(&n)
+			var b, c {{.Name}}
+			b.Set(a)
+			c.Set(a)
+			{{- range $i := iterate 1 .Degree}}
+			{{- range $j := iterate 0 $.BaseDegree}}
+			b.Frobenius(&b)
+			{{- end}}
+			c.Mul(&c, &b)
+			{{- end}}
+			return c.A0.Equal(&n) && c.A1.IsZero(){{if eq .Degree 3}} && c.A2.IsZero(){{end}}
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Legendre on square should output 1", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Sqrt(x²) should be ±x", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b, c, minusA {{.Name}}
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			minusA.Neg(a)
+			return c.Equal(a) || c.Equal(&minusA)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b {{.Name}}
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil && b.IsZero()
+		},
+		genA,
+	))
+
+	{{- if eq .Degree 2}}
+
+	// every element of {{.BaseName}} is a square in {{.Name}}
+	properties.Property("[{{.Name}}] Sqrt of embedded base field elements should be correct", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b, c {{.Name}}
+			b.A0 = a.A0
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			c.Square(&c)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+	{{- end}}
+
+	properties.Property("[{{.Name}}] Exp should match repeated multiplication", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Exp(*a, big.NewInt(5))
+			c.Square(a).Square(&c).Mul(&c, a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Exp by a negative exponent should invert", prop.ForAll(
+		func(a *{{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Exp(*a, big.NewInt(-1))
+			c.Inverse(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] BatchInvert should output the same result as Inverse", prop.ForAll(
+		func(a, b *{{.Name}}) bool {
+			var zero {{.Name}}
+			res := BatchInvert{{.Name}}([]{{.Name}}{*a, zero, *b})
+			var ia, ib {{.Name}}
+			ia.Inverse(a)
+			ib.Inverse(b)
+			return res[0].Equal(&ia) && res[1].IsZero() && res[2].Equal(&ib)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func Benchmark{{.Name}}Mul(b *testing.B) {
+	var a, c {{.Name}}
+	_, _ = a.SetRandom()
+	_, _ = c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func Benchmark{{.Name}}Square(b *testing.B) {
+	var a {{.Name}}
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func Benchmark{{.Name}}Inverse(b *testing.B) {
+	var a {{.Name}}
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func Benchmark{{.Name}}Sqrt(b *testing.B) {
+	var a {{.Name}}
+	_, _ = a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
+`
+
+// TestConstants holds the parameters shared by the tests of all extensions
+const TestConstants = `
+const (
+	nbFuzzShort = 10
+	nbFuzz      = 50
+)
+`
@@ -0,0 +1,187 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package gf2
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// B1 is an element of T0 = GF(2), the base of the tower; its value is 0 or 1.
+type B1 uint8
+
+const (
+	BitsB1  = 1 // number of bits needed to represent a B1
+	BytesB1 = 1 // number of bytes needed to represent a B1
+)
+
+// SetZero z = 0
+func (z *B1) SetZero() *B1 {
+	*z = B1(0)
+	return z
+}
+
+// SetOne z = 1
+func (z *B1) SetOne() *B1 {
+	*z = 1
+	return z
+}
+
+// Set z = x and returns z
+func (z *B1) Set(x *B1) *B1 {
+	*z = *x
+	return z
+}
+
+// SetUint64 sets z to the element whose binary representation is the low bit of v, and returns z
+func (z *B1) SetUint64(v uint64) *B1 {
+	*z = B1(v & 1)
+	return z
+}
+
+// Equal returns z == x
+func (z *B1) Equal(x *B1) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *B1) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *B1) IsOne() bool {
+	return *z == 1
+}
+
+// Add z = x + y, the addition in characteristic 2 is a xor
+func (z *B1) Add(x, y *B1) *B1 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y, which is x + y in characteristic 2
+func (z *B1) Sub(x, y *B1) *B1 {
+	return z.Add(x, y)
+}
+
+// Neg z = -x, which is x in characteristic 2
+func (z *B1) Neg(x *B1) *B1 {
+	return z.Set(x)
+}
+
+// Double z = 2·x, which is 0 in characteristic 2
+func (z *B1) Double(x *B1) *B1 {
+	return z.SetZero()
+}
+
+// Mul z = x·y
+func (z *B1) Mul(x, y *B1) *B1 {
+	*z = *x & *y
+	return z
+}
+
+// Square z = x²
+func (z *B1) Square(x *B1) *B1 {
+	return z.Mul(x, x)
+}
+
+// Inverse z = x⁻¹
+//
+// if x == 0, sets and returns z = x
+func (z *B1) Inverse(x *B1) *B1 {
+	*z = *x
+	return z
+}
+
+// Div z = x·y⁻¹
+func (z *B1) Div(x, y *B1) *B1 {
+	var yInv B1
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *B1) Exp(x B1, k *big.Int) *B1 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// SetRandom sets z to a uniform random value, and returns z
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *B1) SetRandom() (*B1, error) {
+	var b [BytesB1]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	return z.SetBytes(b[:]), nil
+}
+
+// Bytes returns the big-endian encoding of the binary representation of z
+func (z *B1) Bytes() (res [BytesB1]byte) {
+	res[0] = byte(*z)
+	return
+}
+
+// SetBytes interprets e as the big-endian encoding of the binary representation of z, and returns z
+//
+// if len(e) > BytesB1, only the last BytesB1 bytes are used.
+func (z *B1) SetBytes(e []byte) *B1 {
+	if len(e) > BytesB1 {
+		e = e[len(e)-BytesB1:]
+	}
+	var b [BytesB1]byte
+	copy(b[BytesB1-len(e):], e)
+	*z = B1(b[0] & 1)
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z *B1) String() string {
+	return fmt.Sprintf("0x%x", uint8(*z))
+}
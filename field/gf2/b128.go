@@ -0,0 +1,202 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package gf2
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// B128 is an element of T7 = GF(2^128), in the canonical tower basis.
+//
+// B128 is z[0] + z[1]·X7, where z[0] and z[1] are elements of T6 (see B64).
+type B128 [2]uint64
+
+const (
+	BitsB128  = 128 // number of bits needed to represent a B128
+	BytesB128 = 16  // number of bytes needed to represent a B128
+)
+
+// SetZero z = 0
+func (z *B128) SetZero() *B128 {
+	*z = B128{}
+	return z
+}
+
+// SetOne z = 1
+func (z *B128) SetOne() *B128 {
+	z[0] = 1
+	z[1] = 0
+	return z
+}
+
+// Set z = x and returns z
+func (z *B128) Set(x *B128) *B128 {
+	*z = *x
+	return z
+}
+
+// SetUint64 sets z to the element whose binary representation is v, and returns z
+func (z *B128) SetUint64(v uint64) *B128 {
+	z[0] = v
+	z[1] = 0
+	return z
+}
+
+// Equal returns z == x
+func (z *B128) Equal(x *B128) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *B128) IsZero() bool {
+	return (z[0] | z[1]) == 0
+}
+
+// IsOne returns z == 1
+func (z *B128) IsOne() bool {
+	return z[0] == 1 && z[1] == 0
+}
+
+// Add z = x + y, the addition in characteristic 2 is a xor
+func (z *B128) Add(x, y *B128) *B128 {
+	z[0] = x[0] ^ y[0]
+	z[1] = x[1] ^ y[1]
+	return z
+}
+
+// Sub z = x - y, which is x + y in characteristic 2
+func (z *B128) Sub(x, y *B128) *B128 {
+	return z.Add(x, y)
+}
+
+// Neg z = -x, which is x in characteristic 2
+func (z *B128) Neg(x *B128) *B128 {
+	return z.Set(x)
+}
+
+// Double z = 2·x, which is 0 in characteristic 2
+func (z *B128) Double(x *B128) *B128 {
+	return z.SetZero()
+}
+
+// Mul z = x·y
+func (z *B128) Mul(x, y *B128) *B128 {
+	mul128(z, x, y)
+	return z
+}
+
+// Square z = x²
+func (z *B128) Square(x *B128) *B128 {
+	return z.Mul(x, x)
+}
+
+// Inverse z = x⁻¹
+//
+// if x == 0, sets and returns z = x
+func (z *B128) Inverse(x *B128) *B128 {
+	inv128(z, x)
+	return z
+}
+
+// Div z = x·y⁻¹
+func (z *B128) Div(x, y *B128) *B128 {
+	var yInv B128
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *B128) Exp(x B128, k *big.Int) *B128 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// SetRandom sets z to a uniform random value, and returns z
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *B128) SetRandom() (*B128, error) {
+	var b [BytesB128]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	return z.SetBytes(b[:]), nil
+}
+
+// Bytes returns the big-endian encoding of the binary representation of z
+func (z *B128) Bytes() (res [BytesB128]byte) {
+	for i := 0; i < 8; i++ {
+		res[7-i] = byte(z[1] >> (8 * i))
+		res[15-i] = byte(z[0] >> (8 * i))
+	}
+	return
+}
+
+// SetBytes interprets e as the big-endian encoding of the binary representation of z, and returns z
+//
+// if len(e) > BytesB128, only the last BytesB128 bytes are used.
+func (z *B128) SetBytes(e []byte) *B128 {
+	if len(e) > BytesB128 {
+		e = e[len(e)-BytesB128:]
+	}
+	var b [BytesB128]byte
+	copy(b[BytesB128-len(e):], e)
+	z[0], z[1] = 0, 0
+	for i := 0; i < 8; i++ {
+		z[1] = z[1]<<8 | uint64(b[i])
+		z[0] = z[0]<<8 | uint64(b[8+i])
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z *B128) String() string {
+	if z[1] == 0 {
+		return fmt.Sprintf("0x%x", z[0])
+	}
+	return fmt.Sprintf("0x%x%016x", z[1], z[0])
+}
@@ -0,0 +1,197 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package gf2
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// B16 is an element of T4 = GF(2^16), in the canonical tower basis.
+//
+// The bits of a B16 are its coordinates over GF(2) in the basis 1, X1, X2, X1·X2, X3, ...
+// In particular, an element of a smaller level of the tower converts to B16 by zero-extension.
+type B16 uint16
+
+const (
+	BitsB16  = 16 // number of bits needed to represent a B16
+	BytesB16 = 2  // number of bytes needed to represent a B16
+)
+
+// SetZero z = 0
+func (z *B16) SetZero() *B16 {
+	*z = B16(0)
+	return z
+}
+
+// SetOne z = 1
+func (z *B16) SetOne() *B16 {
+	*z = 1
+	return z
+}
+
+// Set z = x and returns z
+func (z *B16) Set(x *B16) *B16 {
+	*z = *x
+	return z
+}
+
+// SetUint64 sets z to the element whose binary representation is the 16 low bits of v, and returns z
+func (z *B16) SetUint64(v uint64) *B16 {
+	*z = B16(v)
+	return z
+}
+
+// Equal returns z == x
+func (z *B16) Equal(x *B16) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *B16) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *B16) IsOne() bool {
+	return *z == 1
+}
+
+// Add z = x + y, the addition in characteristic 2 is a xor
+func (z *B16) Add(x, y *B16) *B16 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y, which is x + y in characteristic 2
+func (z *B16) Sub(x, y *B16) *B16 {
+	return z.Add(x, y)
+}
+
+// Neg z = -x, which is x in characteristic 2
+func (z *B16) Neg(x *B16) *B16 {
+	return z.Set(x)
+}
+
+// Double z = 2·x, which is 0 in characteristic 2
+func (z *B16) Double(x *B16) *B16 {
+	return z.SetZero()
+}
+
+// Mul z = x·y
+func (z *B16) Mul(x, y *B16) *B16 {
+	*z = B16(mul16(uint16(*x), uint16(*y)))
+	return z
+}
+
+// Square z = x²
+func (z *B16) Square(x *B16) *B16 {
+	return z.Mul(x, x)
+}
+
+// Inverse z = x⁻¹
+//
+// if x == 0, sets and returns z = x
+func (z *B16) Inverse(x *B16) *B16 {
+	*z = B16(inv64(uint64(*x), 4))
+	return z
+}
+
+// Div z = x·y⁻¹
+func (z *B16) Div(x, y *B16) *B16 {
+	var yInv B16
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *B16) Exp(x B16, k *big.Int) *B16 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// SetRandom sets z to a uniform random value, and returns z
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *B16) SetRandom() (*B16, error) {
+	var b [BytesB16]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	return z.SetBytes(b[:]), nil
+}
+
+// Bytes returns the big-endian encoding of the binary representation of z
+func (z *B16) Bytes() (res [BytesB16]byte) {
+	v := *z
+	for i := BytesB16 - 1; i >= 0; i-- {
+		res[i] = byte(v)
+		v >>= 8
+	}
+	return
+}
+
+// SetBytes interprets e as the big-endian encoding of the binary representation of z, and returns z
+//
+// if len(e) > BytesB16, only the last BytesB16 bytes are used.
+func (z *B16) SetBytes(e []byte) *B16 {
+	if len(e) > BytesB16 {
+		e = e[len(e)-BytesB16:]
+	}
+	var b [BytesB16]byte
+	copy(b[BytesB16-len(e):], e)
+	*z = 0
+	for i := 0; i < BytesB16; i++ {
+		*z = *z<<8 | B16(b[i])
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z *B16) String() string {
+	return fmt.Sprintf("0x%x", uint16(*z))
+}
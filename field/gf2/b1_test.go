@@ -0,0 +1,231 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package gf2
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// genB1 generates a random B1
+func genB1() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var e B1
+		e.SetUint64(genParams.NextUint64())
+		return gopter.NewGenResult(e, gopter.NoShrinker)
+	}
+}
+
+func TestB1Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genB1()
+	genB := genB1()
+	genC := genB1()
+
+	properties.Property("[B1] add should be its own inverse", prop.ForAll(
+		func(a, b B1) bool {
+			var c, d B1
+			c.Add(&a, &b).Sub(&c, &b)
+			d.Add(&a, &a)
+			return c.Equal(&a) && d.IsZero()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[B1] mul should be commutative", prop.ForAll(
+		func(a, b B1) bool {
+			var l, r B1
+			l.Mul(&a, &b)
+			r.Mul(&b, &a)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[B1] mul should be associative", prop.ForAll(
+		func(a, b, c B1) bool {
+			var l, r B1
+			l.Mul(&a, &b).Mul(&l, &c)
+			r.Mul(&b, &c).Mul(&a, &r)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[B1] mul should be distributive over add", prop.ForAll(
+		func(a, b, c B1) bool {
+			var l, r, tmp B1
+			l.Add(&b, &c).Mul(&l, &a)
+			r.Mul(&a, &b)
+			tmp.Mul(&a, &c)
+			r.Add(&r, &tmp)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[B1] square and mul should output the same result", prop.ForAll(
+		func(a B1) bool {
+			var b, c B1
+			b.Mul(&a, &a)
+			c.Square(&a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[B1] a * a⁻¹ should equal 1", prop.ForAll(
+		func(a B1) bool {
+			var b B1
+			b.Inverse(&a).Mul(&b, &a)
+			return b.IsOne() || a.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[B1] div should be the inverse of mul", prop.ForAll(
+		func(a, b B1) bool {
+			var c B1
+			c.Mul(&a, &b).Div(&c, &b)
+			return c.Equal(&a) || b.IsZero()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[B1] a^(2^1) should equal a", prop.ForAll(
+		func(a B1) bool {
+			var b B1
+			b.Exp(a, new(big.Int).Lsh(big.NewInt(1), BitsB1))
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[B1] Exp by a negative exponent should invert", prop.ForAll(
+		func(a B1) bool {
+			var b, c B1
+			b.Exp(a, big.NewInt(-1))
+			c.Inverse(&a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[B1] Bytes and SetBytes should round trip", prop.ForAll(
+		func(a B1) bool {
+			var b B1
+			bytes := a.Bytes()
+			b.SetBytes(bytes[:])
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[B1] SetUint64 should match SetBytes", prop.ForAll(
+		func(v uint64) bool {
+			var a, b B1
+			var buf [8]byte
+			for i := 0; i < 8; i++ {
+				buf[i] = byte(v >> (56 - 8*i))
+			}
+			a.SetUint64(v)
+			b.SetBytes(buf[:])
+			return a.Equal(&b)
+		},
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}),
+	))
+
+	properties.Property("[B1] Having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b B1) bool {
+			var c, d, e B1
+			c.Mul(&a, &b)
+			d.Set(&a)
+			d.Mul(&d, &b)
+			e.Set(&b)
+			e.Mul(&a, &e)
+			if !c.Equal(&d) || !c.Equal(&e) {
+				return false
+			}
+			c.Inverse(&a)
+			d.Set(&a)
+			d.Inverse(&d)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestB1Inverse(t *testing.T) {
+	var a, b B1
+	if !b.Inverse(&a).IsZero() {
+		t.Fatal("inverse of 0 should be 0")
+	}
+	if !b.Inverse(a.SetOne()).IsOne() {
+		t.Fatal("inverse of 1 should be 1")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkB1Mul(b *testing.B) {
+	var x, y B1
+	_, _ = x.SetRandom()
+	_, _ = y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkB1Inverse(b *testing.B) {
+	var x B1
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+const (
+	nbFuzzShort = 20
+	nbFuzz      = 200
+)
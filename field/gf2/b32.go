@@ -0,0 +1,197 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package gf2
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// B32 is an element of T5 = GF(2^32), in the canonical tower basis.
+//
+// The bits of a B32 are its coordinates over GF(2) in the basis 1, X1, X2, X1·X2, X3, ...
+// In particular, an element of a smaller level of the tower converts to B32 by zero-extension.
+type B32 uint32
+
+const (
+	BitsB32  = 32 // number of bits needed to represent a B32
+	BytesB32 = 4  // number of bytes needed to represent a B32
+)
+
+// SetZero z = 0
+func (z *B32) SetZero() *B32 {
+	*z = B32(0)
+	return z
+}
+
+// SetOne z = 1
+func (z *B32) SetOne() *B32 {
+	*z = 1
+	return z
+}
+
+// Set z = x and returns z
+func (z *B32) Set(x *B32) *B32 {
+	*z = *x
+	return z
+}
+
+// SetUint64 sets z to the element whose binary representation is the 32 low bits of v, and returns z
+func (z *B32) SetUint64(v uint64) *B32 {
+	*z = B32(v)
+	return z
+}
+
+// Equal returns z == x
+func (z *B32) Equal(x *B32) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *B32) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *B32) IsOne() bool {
+	return *z == 1
+}
+
+// Add z = x + y, the addition in characteristic 2 is a xor
+func (z *B32) Add(x, y *B32) *B32 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y, which is x + y in characteristic 2
+func (z *B32) Sub(x, y *B32) *B32 {
+	return z.Add(x, y)
+}
+
+// Neg z = -x, which is x in characteristic 2
+func (z *B32) Neg(x *B32) *B32 {
+	return z.Set(x)
+}
+
+// Double z = 2·x, which is 0 in characteristic 2
+func (z *B32) Double(x *B32) *B32 {
+	return z.SetZero()
+}
+
+// Mul z = x·y
+func (z *B32) Mul(x, y *B32) *B32 {
+	*z = B32(mul64(uint64(*x), uint64(*y), 5))
+	return z
+}
+
+// Square z = x²
+func (z *B32) Square(x *B32) *B32 {
+	return z.Mul(x, x)
+}
+
+// Inverse z = x⁻¹
+//
+// if x == 0, sets and returns z = x
+func (z *B32) Inverse(x *B32) *B32 {
+	*z = B32(inv64(uint64(*x), 5))
+	return z
+}
+
+// Div z = x·y⁻¹
+func (z *B32) Div(x, y *B32) *B32 {
+	var yInv B32
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *B32) Exp(x B32, k *big.Int) *B32 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// SetRandom sets z to a uniform random value, and returns z
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *B32) SetRandom() (*B32, error) {
+	var b [BytesB32]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	return z.SetBytes(b[:]), nil
+}
+
+// Bytes returns the big-endian encoding of the binary representation of z
+func (z *B32) Bytes() (res [BytesB32]byte) {
+	v := *z
+	for i := BytesB32 - 1; i >= 0; i-- {
+		res[i] = byte(v)
+		v >>= 8
+	}
+	return
+}
+
+// SetBytes interprets e as the big-endian encoding of the binary representation of z, and returns z
+//
+// if len(e) > BytesB32, only the last BytesB32 bytes are used.
+func (z *B32) SetBytes(e []byte) *B32 {
+	if len(e) > BytesB32 {
+		e = e[len(e)-BytesB32:]
+	}
+	var b [BytesB32]byte
+	copy(b[BytesB32-len(e):], e)
+	*z = 0
+	for i := 0; i < BytesB32; i++ {
+		*z = *z<<8 | B32(b[i])
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z *B32) String() string {
+	return fmt.Sprintf("0x%x", uint32(*z))
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package gf2
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+// B8 is an element of T3 = GF(2^8), in the canonical tower basis.
+//
+// The bits of a B8 are its coordinates over GF(2) in the basis 1, X1, X2, X1·X2, X3, ...
+// In particular, an element of a smaller level of the tower converts to B8 by zero-extension.
+type B8 uint8
+
+const (
+	BitsB8  = 8 // number of bits needed to represent a B8
+	BytesB8 = 1 // number of bytes needed to represent a B8
+)
+
+// SetZero z = 0
+func (z *B8) SetZero() *B8 {
+	*z = B8(0)
+	return z
+}
+
+// SetOne z = 1
+func (z *B8) SetOne() *B8 {
+	*z = 1
+	return z
+}
+
+// Set z = x and returns z
+func (z *B8) Set(x *B8) *B8 {
+	*z = *x
+	return z
+}
+
+// SetUint64 sets z to the element whose binary representation is the 8 low bits of v, and returns z
+func (z *B8) SetUint64(v uint64) *B8 {
+	*z = B8(v)
+	return z
+}
+
+// Equal returns z == x
+func (z *B8) Equal(x *B8) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *B8) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *B8) IsOne() bool {
+	return *z == 1
+}
+
+// Add z = x + y, the addition in characteristic 2 is a xor
+func (z *B8) Add(x, y *B8) *B8 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y, which is x + y in characteristic 2
+func (z *B8) Sub(x, y *B8) *B8 {
+	return z.Add(x, y)
+}
+
+// Neg z = -x, which is x in characteristic 2
+func (z *B8) Neg(x *B8) *B8 {
+	return z.Set(x)
+}
+
+// Double z = 2·x, which is 0 in characteristic 2
+func (z *B8) Double(x *B8) *B8 {
+	return z.SetZero()
+}
+
+// Mul z = x·y
+func (z *B8) Mul(x, y *B8) *B8 {
+	*z = B8(mul8(uint8(*x), uint8(*y)))
+	return z
+}
+
+// Square z = x²
+func (z *B8) Square(x *B8) *B8 {
+	return z.Mul(x, x)
+}
+
+// Inverse z = x⁻¹
+//
+// if x == 0, sets and returns z = x
+func (z *B8) Inverse(x *B8) *B8 {
+	*z = B8(inv64(uint64(*x), 3))
+	return z
+}
+
+// Div z = x·y⁻¹
+func (z *B8) Div(x, y *B8) *B8 {
+	var yInv B8
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *B8) Exp(x B8, k *big.Int) *B8 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// SetRandom sets z to a uniform random value, and returns z
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *B8) SetRandom() (*B8, error) {
+	var b [BytesB8]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	return z.SetBytes(b[:]), nil
+}
+
+// Bytes returns the big-endian encoding of the binary representation of z
+func (z *B8) Bytes() (res [BytesB8]byte) {
+	res[0] = byte(*z)
+	return
+}
+
+// SetBytes interprets e as the big-endian encoding of the binary representation of z, and returns z
+//
+// if len(e) > BytesB8, only the last BytesB8 bytes are used.
+func (z *B8) SetBytes(e []byte) *B8 {
+	if len(e) > BytesB8 {
+		e = e[len(e)-BytesB8:]
+	}
+	var b [BytesB8]byte
+	copy(b[BytesB8-len(e):], e)
+	*z = B8(b[0])
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z *B8) String() string {
+	return fmt.Sprintf("0x%x", uint8(*z))
+}
@@ -0,0 +1,19 @@
+// Package gf2 provides the canonical tower of binary fields
+//
+//	GF(2) ⊂ GF(2⁸) ⊂ GF(2¹⁶) ⊂ GF(2³²) ⊂ GF(2⁶⁴) ⊂ GF(2¹²⁸)
+//
+// with Tᵢ = Tᵢ₋₁[Xᵢ]/(Xᵢ² + Xᵢ₋₁·Xᵢ + 1) and X₀ = 1. The levels T₀ and T₃ to T₇ are the types B1, B8, B16, B32, B64
+// and B128; the elements of T₁ and T₂ don't fill a byte, and are represented by the elements of B8 with zero high bits.
+//
+// Thanks to the tower basis, an element of a smaller level converts to a bigger one by zero-extension of its
+// binary representation, and additions are xors; vectors of elements are added on their packed representation.
+//
+// Multiplications in GF(2⁸) and GF(2¹⁶) use logarithm tables, and higher levels use Karatsuba multiplication
+// over the level below. On amd64 CPUs with the PCLMULQDQ instruction, GF(2⁶⁴) and GF(2¹²⁸) multiplications map
+// their operands to the polynomial basis of GF(2)[x]/(x¹²⁸ + x⁷ + x² + x + 1) instead, where a product is a few
+// carry-less multiplications; the purego build tag disables the assembly.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package gf2
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/gf2/internal/templates"
+)
+
+// level describes the level Tᵢ = GF(2^(2^i)) of the canonical binary tower
+type level struct {
+	Name  string // Go type of the elements
+	Level int    // i
+	Bits  int    // 2^i
+	Bytes int    // number of bytes of the encoding
+	Word  string // underlying unsigned integer, when the level fits in one
+	Wide  bool   // true if the level is stored on two uint64
+	Sub   string // Go type of the elements of the previous level with a type, if any
+}
+
+//go:generate go run main.go
+func main() {
+	// T₁ and T₂ have no dedicated type, as their elements don't fill a byte
+	levels := []level{{Name: "B1", Level: 0, Bits: 1, Bytes: 1, Word: "uint8"}}
+	for i := 3; i <= 7; i++ {
+		l := level{Level: i, Bits: 1 << i}
+		l.Name = fmt.Sprintf("B%d", l.Bits)
+		l.Bytes = l.Bits / 8
+		if l.Bits <= 64 {
+			l.Word = fmt.Sprintf("uint%d", l.Bits)
+		} else {
+			l.Wide = true
+		}
+		l.Sub = levels[len(levels)-1].Name
+		levels = append(levels, l)
+	}
+
+	const outputDir = "../"
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package("gf2"),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	for i, l := range levels {
+		name := strings.ToLower(l.Name)
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, name+".go"), []string{templates.Element}, l, bavardOpts...); err != nil {
+			panic(err)
+		}
+		testFiles := []string{templates.Test}
+		if i == 0 {
+			testFiles = append(testFiles, templates.TestConstants)
+		}
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, name+"_test.go"), testFiles, l, bavardOpts...); err != nil {
+			panic(err)
+		}
+	}
+
+	data := struct{ Levels []level }{levels}
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, "vector.go"), []string{templates.Vector}, data, bavardOpts...); err != nil {
+		panic(err)
+	}
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, "vector_test.go"), []string{templates.TestVector}, data, bavardOpts...); err != nil {
+		panic(err)
+	}
+
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated binary tower")
+}
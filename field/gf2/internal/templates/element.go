@@ -0,0 +1,264 @@
+package templates
+
+// Element is the template of a level of the binary tower
+const Element = `
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/pool"
+)
+
+{{- if eq .Level 0}}
+// {{.Name}} is an element of T0 = GF(2), the base of the tower; its value is 0 or 1.
+type {{.Name}} {{.Word}}
+{{- else}}
+// {{.Name}} is an element of T{{.Level}} = GF(2^{{.Bits}}), in the canonical tower basis.
+{{- if .Wide}}
+//
+// {{.Name}} is z[0] + z[1]·X{{.Level}}, where z[0] and z[1] are elements of T{{sub .Level 1}} (see B{{div .Bits 2}}).
+type {{.Name}} [2]uint64
+{{- else}}
+//
+// The bits of a {{.Name}} are its coordinates over GF(2) in the basis 1, X1, X2, X1·X2, X3, ...
+// In particular, an element of a smaller level of the tower converts to {{.Name}} by zero-extension.
+type {{.Name}} {{.Word}}
+{{- end}}
+{{- end}}
+
+const (
+	Bits{{.Name}}  = {{.Bits}} // number of bits needed to represent a {{.Name}}
+	Bytes{{.Name}} = {{.Bytes}} // number of bytes needed to represent a {{.Name}}
+)
+
+// SetZero z = 0
+func (z *{{.Name}}) SetZero() *{{.Name}} {
+	*z = {{.Name}}{{if .Wide}}{}{{else}}(0){{end}}
+	return z
+}
+
+// SetOne z = 1
+func (z *{{.Name}}) SetOne() *{{.Name}} {
+	{{- if .Wide}}
+	z[0] = 1
+	z[1] = 0
+	{{- else}}
+	*z = 1
+	{{- end}}
+	return z
+}
+
+// Set z = x and returns z
+func (z *{{.Name}}) Set(x *{{.Name}}) *{{.Name}} {
+	*z = *x
+	return z
+}
+
+// SetUint64 sets z to the element whose binary representation is {{if .Wide}}v{{else if eq .Level 0}}the low bit of v{{else}}the {{.Bits}} low bits of v{{end}}, and returns z
+func (z *{{.Name}}) SetUint64(v uint64) *{{.Name}} {
+	{{- if .Wide}}
+	z[0] = v
+	z[1] = 0
+	{{- else if eq .Level 0}}
+	*z = {{.Name}}(v & 1)
+	{{- else}}
+	*z = {{.Name}}(v)
+	{{- end}}
+	return z
+}
+
+// Equal returns z == x
+func (z *{{.Name}}) Equal(x *{{.Name}}) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *{{.Name}}) IsZero() bool {
+	{{- if .Wide}}
+	return (z[0] | z[1]) == 0
+	{{- else}}
+	return *z == 0
+	{{- end}}
+}
+
+// IsOne returns z == 1
+func (z *{{.Name}}) IsOne() bool {
+	{{- if .Wide}}
+	return z[0] == 1 && z[1] == 0
+	{{- else}}
+	return *z == 1
+	{{- end}}
+}
+
+// Add z = x + y, the addition in characteristic 2 is a xor
+func (z *{{.Name}}) Add(x, y *{{.Name}}) *{{.Name}} {
+	{{- if .Wide}}
+	z[0] = x[0] ^ y[0]
+	z[1] = x[1] ^ y[1]
+	{{- else}}
+	*z = *x ^ *y
+	{{- end}}
+	return z
+}
+
+// Sub z = x - y, which is x + y in characteristic 2
+func (z *{{.Name}}) Sub(x, y *{{.Name}}) *{{.Name}} {
+	return z.Add(x, y)
+}
+
+// Neg z = -x, which is x in characteristic 2
+func (z *{{.Name}}) Neg(x *{{.Name}}) *{{.Name}} {
+	return z.Set(x)
+}
+
+// Double z = 2·x, which is 0 in characteristic 2
+func (z *{{.Name}}) Double(x *{{.Name}}) *{{.Name}} {
+	return z.SetZero()
+}
+
+// Mul z = x·y
+func (z *{{.Name}}) Mul(x, y *{{.Name}}) *{{.Name}} {
+	{{- if .Wide}}
+	mul128(z, x, y)
+	{{- else if eq .Level 0}}
+	*z = *x & *y
+	{{- else if eq .Level 3}}
+	*z = {{.Name}}(mul8(uint8(*x), uint8(*y)))
+	{{- else if eq .Level 4}}
+	*z = {{.Name}}(mul16(uint16(*x), uint16(*y)))
+	{{- else}}
+	*z = {{.Name}}(mul64(uint64(*x), uint64(*y), {{.Level}}))
+	{{- end}}
+	return z
+}
+
+// Square z = x²
+func (z *{{.Name}}) Square(x *{{.Name}}) *{{.Name}} {
+	return z.Mul(x, x)
+}
+
+// Inverse z = x⁻¹
+//
+// if x == 0, sets and returns z = x
+func (z *{{.Name}}) Inverse(x *{{.Name}}) *{{.Name}} {
+	{{- if .Wide}}
+	inv128(z, x)
+	{{- else if eq .Level 0}}
+	*z = *x
+	{{- else}}
+	*z = {{.Name}}(inv64(uint64(*x), {{.Level}}))
+	{{- end}}
+	return z
+}
+
+// Div z = x·y⁻¹
+func (z *{{.Name}}) Div(x, y *{{.Name}}) *{{.Name}} {
+	var yInv {{.Name}}
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *{{.Name}}) Exp(x {{.Name}}, k *big.Int) *{{.Name}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = pool.BigInt.Get()
+		defer pool.BigInt.Put(e)
+		e.Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// SetRandom sets z to a uniform random value, and returns z
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *{{.Name}}) SetRandom() (*{{.Name}}, error) {
+	var b [Bytes{{.Name}}]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	return z.SetBytes(b[:]), nil
+}
+
+// Bytes returns the big-endian encoding of the binary representation of z
+func (z *{{.Name}}) Bytes() (res [Bytes{{.Name}}]byte) {
+	{{- if .Wide}}
+	for i := 0; i < 8; i++ {
+		res[7-i] = byte(z[1] >> (8 * i))
+		res[15-i] = byte(z[0] >> (8 * i))
+	}
+	{{- else if le .Bits 8}}
+	res[0] = byte(*z)
+	{{- else}}
+	v := *z
+	for i := Bytes{{.Name}} - 1; i >= 0; i-- {
+		res[i] = byte(v)
+		v >>= 8
+	}
+	{{- end}}
+	return
+}
+
+// SetBytes interprets e as the big-endian encoding of the binary representation of z, and returns z
+//
+// if len(e) > Bytes{{.Name}}, only the last Bytes{{.Name}} bytes are used.
+func (z *{{.Name}}) SetBytes(e []byte) *{{.Name}} {
+	if len(e) > Bytes{{.Name}} {
+		e = e[len(e)-Bytes{{.Name}}:]
+	}
+	var b [Bytes{{.Name}}]byte
+	copy(b[Bytes{{.Name}}-len(e):], e)
+	{{- if .Wide}}
+	z[0], z[1] = 0, 0
+	for i := 0; i < 8; i++ {
+		z[1] = z[1]<<8 | uint64(b[i])
+		z[0] = z[0]<<8 | uint64(b[8+i])
+	}
+	{{- else if eq .Level 0}}
+	*z = {{.Name}}(b[0] & 1)
+	{{- else if eq .Bits 8}}
+	*z = {{.Name}}(b[0])
+	{{- else}}
+	*z = 0
+	for i := 0; i < Bytes{{.Name}}; i++ {
+		*z = *z<<8 | {{.Name}}(b[i])
+	}
+	{{- end}}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z *{{.Name}}) String() string {
+	{{- if .Wide}}
+	if z[1] == 0 {
+		return fmt.Sprintf("0x%x", z[0])
+	}
+	return fmt.Sprintf("0x%x%016x", z[1], z[0])
+	{{- else}}
+	return fmt.Sprintf("0x%x", {{.Word}}(*z))
+	{{- end}}
+}
+`
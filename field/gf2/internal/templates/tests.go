@@ -0,0 +1,349 @@
+package templates
+
+// Test is the template of the tests of a level of the binary tower
+const Test = `
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// gen{{.Name}} generates a random {{.Name}}
+func gen{{.Name}}() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var e {{.Name}}
+		{{- if .Wide}}
+		e[0] = genParams.NextUint64()
+		e[1] = genParams.NextUint64()
+		{{- else}}
+		e.SetUint64(genParams.NextUint64())
+		{{- end}}
+		return gopter.NewGenResult(e, gopter.NoShrinker)
+	}
+}
+
+func Test{{.Name}}Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen{{.Name}}()
+	genB := gen{{.Name}}()
+	genC := gen{{.Name}}()
+
+	properties.Property("[{{.Name}}] add should be its own inverse", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var c, d {{.Name}}
+			c.Add(&a, &b).Sub(&c, &b)
+			d.Add(&a, &a)
+			return c.Equal(&a) && d.IsZero()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] mul should be commutative", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var l, r {{.Name}}
+			l.Mul(&a, &b)
+			r.Mul(&b, &a)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] mul should be associative", prop.ForAll(
+		func(a, b, c {{.Name}}) bool {
+			var l, r {{.Name}}
+			l.Mul(&a, &b).Mul(&l, &c)
+			r.Mul(&b, &c).Mul(&a, &r)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[{{.Name}}] mul should be distributive over add", prop.ForAll(
+		func(a, b, c {{.Name}}) bool {
+			var l, r, tmp {{.Name}}
+			l.Add(&b, &c).Mul(&l, &a)
+			r.Mul(&a, &b)
+			tmp.Mul(&a, &c)
+			r.Add(&r, &tmp)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[{{.Name}}] square and mul should output the same result", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Mul(&a, &a)
+			c.Square(&a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] a * a⁻¹ should equal 1", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b {{.Name}}
+			b.Inverse(&a).Mul(&b, &a)
+			return b.IsOne() || a.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] div should be the inverse of mul", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var c {{.Name}}
+			c.Mul(&a, &b).Div(&c, &b)
+			return c.Equal(&a) || b.IsZero()
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{.Name}}] a^(2^{{.Bits}}) should equal a", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b {{.Name}}
+			b.Exp(a, new(big.Int).Lsh(big.NewInt(1), Bits{{.Name}}))
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Exp by a negative exponent should invert", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b, c {{.Name}}
+			b.Exp(a, big.NewInt(-1))
+			c.Inverse(&a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] Bytes and SetBytes should round trip", prop.ForAll(
+		func(a {{.Name}}) bool {
+			var b {{.Name}}
+			bytes := a.Bytes()
+			b.SetBytes(bytes[:])
+			return b.Equal(&a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{.Name}}] SetUint64 should match SetBytes", prop.ForAll(
+		func(v uint64) bool {
+			var a, b {{.Name}}
+			var buf [8]byte
+			for i := 0; i < 8; i++ {
+				buf[i] = byte(v >> (56 - 8*i))
+			}
+			a.SetUint64(v)
+			b.SetBytes(buf[:])
+			return a.Equal(&b)
+		},
+		gopter.Gen(func(genParams *gopter.GenParameters) *gopter.GenResult {
+			return gopter.NewGenResult(genParams.NextUint64(), gopter.NoShrinker)
+		}),
+	))
+	{{- if .Sub}}
+
+	properties.Property("[{{.Name}}] mul of {{.Sub}} elements should match the {{.Sub}} mul", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			{{- if .Wide}}
+			x, y := B64(a[0]), B64(b[0])
+			c, d := {{.Name}}{a[0], 0}, {{.Name}}{b[0], 0}
+			c.Mul(&c, &d)
+			x.Mul(&x, &y)
+			return c == {{.Name}}{uint64(x), 0}
+			{{- else}}
+			{{- if eq .Sub "B1"}}
+			x, y := B1(a&1), B1(b&1)
+			{{- else}}
+			x, y := {{.Sub}}(a), {{.Sub}}(b)
+			{{- end}}
+			c, d := {{.Name}}(x), {{.Name}}(y)
+			c.Mul(&c, &d)
+			x.Mul(&x, &y)
+			return c == {{.Name}}(x)
+			{{- end}}
+		},
+		genA,
+		genB,
+	))
+	{{- end}}
+
+	properties.Property("[{{.Name}}] Having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b {{.Name}}) bool {
+			var c, d, e {{.Name}}
+			c.Mul(&a, &b)
+			d.Set(&a)
+			d.Mul(&d, &b)
+			e.Set(&b)
+			e.Mul(&a, &e)
+			if !c.Equal(&d) || !c.Equal(&e) {
+				return false
+			}
+			c.Inverse(&a)
+			d.Set(&a)
+			d.Inverse(&d)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{.Name}}Inverse(t *testing.T) {
+	var a, b {{.Name}}
+	if !b.Inverse(&a).IsZero() {
+		t.Fatal("inverse of 0 should be 0")
+	}
+	if !b.Inverse(a.SetOne()).IsOne() {
+		t.Fatal("inverse of 1 should be 1")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func Benchmark{{.Name}}Mul(b *testing.B) {
+	var x, y {{.Name}}
+	_, _ = x.SetRandom()
+	_, _ = y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func Benchmark{{.Name}}Inverse(b *testing.B) {
+	var x {{.Name}}
+	_, _ = x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+`
+
+// TestConstants holds the parameters shared by the tests of all levels
+const TestConstants = `
+const (
+	nbFuzzShort = 20
+	nbFuzz      = 200
+)
+`
+
+// TestVector is the template of the tests of the vectors of elements of the binary tower
+const TestVector = `
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+{{- range .Levels}}
+
+func TestVector{{.Name}}Ops(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{0, 1, 7, 1<<13 + 3} {
+		a, b, c := make(Vector{{.Name}}, size), make(Vector{{.Name}}, size), make(Vector{{.Name}}, size)
+		for i := 0; i < size; i++ {
+			a[i].SetRandom()
+			b[i].SetRandom()
+		}
+		var s {{.Name}}
+		s.SetRandom()
+		k := big.NewInt(-5)
+
+		var expected, tmp {{.Name}}
+
+		c.Add(a, b)
+		for i := 0; i < size; i++ {
+			expected.Add(&a[i], &b[i])
+			assert.True(c[i].Equal(&expected), "Add")
+		}
+
+		c.Sub(a, b)
+		for i := 0; i < size; i++ {
+			expected.Sub(&a[i], &b[i])
+			assert.True(c[i].Equal(&expected), "Sub")
+		}
+
+		c.Mul(a, b)
+		for i := 0; i < size; i++ {
+			expected.Mul(&a[i], &b[i])
+			assert.True(c[i].Equal(&expected), "Mul")
+		}
+
+		c.ScalarMul(a, &s)
+		for i := 0; i < size; i++ {
+			expected.Mul(&a[i], &s)
+			assert.True(c[i].Equal(&expected), "ScalarMul")
+		}
+
+		c.Exp(a, k)
+		for i := 0; i < size; i++ {
+			expected.Exp(a[i], k)
+			assert.True(c[i].Equal(&expected), "Exp")
+		}
+
+		expected.SetZero()
+		for i := 0; i < size; i++ {
+			expected.Add(&expected, &a[i])
+		}
+		sum := a.Sum()
+		assert.True(sum.Equal(&expected), "Sum")
+
+		expected.SetZero()
+		for i := 0; i < size; i++ {
+			tmp.Mul(&a[i], &b[i])
+			expected.Add(&expected, &tmp)
+		}
+		ip := a.InnerProduct(b)
+		assert.True(ip.Equal(&expected), "InnerProduct")
+
+		// in place operations
+		c = make(Vector{{.Name}}, size)
+		copy(c, a)
+		c.Add(c, b)
+		c.Sub(c, b)
+		assert.True(reflect.DeepEqual(a, c), "in place Add / Sub")
+	}
+}
+
+func TestVector{{.Name}}OpsPanic(t *testing.T) {
+	assert := require.New(t)
+
+	a, b := make(Vector{{.Name}}, 3), make(Vector{{.Name}}, 4)
+	var s {{.Name}}
+
+	assert.Panics(func() { a.Add(a, b) })
+	assert.Panics(func() { a.Sub(a, b) })
+	assert.Panics(func() { a.Mul(a, b) })
+	assert.Panics(func() { b.ScalarMul(a, &s) })
+	assert.Panics(func() { b.Exp(a, big.NewInt(2)) })
+	assert.Panics(func() { a.InnerProduct(b) })
+}
+{{- end}}
+`
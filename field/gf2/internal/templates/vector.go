@@ -0,0 +1,127 @@
+package templates
+
+// Vector is the template of the vectors of elements of the binary tower
+const Vector = `
+import (
+	"crypto/subtle"
+	"math/big"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+{{- range .Levels}}
+
+// Vector{{.Name}} represents a slice of {{.Name}}.
+//
+// Add and Sub operate on the packed binary representation of the vector, several
+// elements at a time.
+type Vector{{.Name}} []{{.Name}}
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector{{.Name}}) Add(a, b Vector{{.Name}}) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	subtle.XORBytes((*vector).bytes(), a.bytes(), b.bytes())
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector{{.Name}}) Sub(a, b Vector{{.Name}}) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	subtle.XORBytes((*vector).bytes(), a.bytes(), b.bytes())
+}
+
+// Mul multiplies two vectors element-wise (Hadamard product) and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector{{.Name}}) Mul(a, b Vector{{.Name}}) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			(*vector)[i].Mul(&a[i], &b[i])
+		}
+	}, vectorNbTasks(len(a)))
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector{{.Name}}) ScalarMul(a Vector{{.Name}}, b *{{.Name}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			(*vector)[i].Mul(&a[i], b)
+		}
+	}, vectorNbTasks(len(a)))
+}
+
+// Exp raises each element of a to the power k and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector{{.Name}}) Exp(a Vector{{.Name}}, k *big.Int) {
+	if len(a) != len(*vector) {
+		panic("vector.Exp: vectors don't have the same length")
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			(*vector)[i].Exp(a[i], k)
+		}
+	}, vectorNbTasks(len(a)))
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector Vector{{.Name}}) Sum() (res {{.Name}}) {
+	for i := 0; i < len(vector); i++ {
+		res.Add(&res, &vector[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector Vector{{.Name}}) InnerProduct(other Vector{{.Name}}) (res {{.Name}}) {
+	if len(vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var lock sync.Mutex
+	parallel.Execute(len(vector), func(start, end int) {
+		var partial, tmp {{.Name}}
+		for i := start; i < end; i++ {
+			tmp.Mul(&vector[i], &other[i])
+			partial.Add(&partial, &tmp)
+		}
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	}, vectorNbTasks(len(vector)))
+	return
+}
+
+// bytes returns the packed binary representation of the vector, sharing its memory
+func (vector Vector{{.Name}}) bytes() []byte {
+	if len(vector) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(&vector[0])), len(vector)*Bytes{{.Name}})
+}
+{{- end}}
+
+// vectorNbTasks returns the number of go routines used by the vector
+// arithmetic operations on n elements; small vectors are processed serially.
+func vectorNbTasks(n int) int {
+	const minElementsPerTask = 1 << 12
+	nbTasks := n / minElementsPerTask
+	if nbTasks > runtime.NumCPU() {
+		nbTasks = runtime.NumCPU()
+	}
+	return nbTasks
+}
+`
@@ -0,0 +1,9 @@
+// Package ntt provides an in-place additive number theoretic transform over the binary tower field gf2.B128.
+//
+// Binary fields have no large multiplicative subgroups of smooth order, so the transform evaluates
+// polynomials on affine subspaces of B128 instead, following Lin, Chung and Han
+// ("Novel Polynomial Basis and Its Application to Reed-Solomon Erasure Codes", FOCS 2014).
+//
+// The polynomials are represented in the novel polynomial basis Xⱼ(x) = ∏ Ŵᵢ(x)^jᵢ, where jᵢ is the i-th bit of j
+// and Ŵᵢ is the normalized vanishing polynomial of span(β₀, …, βᵢ₋₁).
+package ntt
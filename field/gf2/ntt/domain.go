@@ -0,0 +1,85 @@
+package ntt
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/gf2"
+)
+
+// Domain is an affine subspace of B128 with a power of 2 cardinality
+//
+//	S = Shift + span(β₀, …, βₖ₋₁)
+//
+// where βᵢ is the element whose binary representation is 2ⁱ. The point of index j of the domain
+// is Shift + j, where j is seen as an element of B128 through its binary representation.
+type Domain struct {
+	Cardinality uint64
+	Shift       gf2.B128
+
+	// twiddles[i][u] = Ŵᵢ(Shift + u·2ⁱ⁺¹), where Ŵᵢ = Wᵢ / Wᵢ(βᵢ) and Wᵢ is the vanishing polynomial of span(β₀, …, βᵢ₋₁)
+	twiddles [][]gf2.B128
+}
+
+// NewDomain returns an affine subspace with a power of 2 cardinality
+// cardinality >= m
+// shift: when specified, it's the element by which the linear subspace is shifted.
+func NewDomain(m uint64, opts ...DomainOption) *Domain {
+	opt := domainOptions(opts...)
+	domain := &Domain{}
+	domain.Cardinality = ecc.NextPowerOfTwo(m)
+	domain.Shift = opt.shift
+	domain.preComputeTwiddles()
+	return domain
+}
+
+// Twiddles returns the twiddles of the domain; twiddles[i][u] = Ŵᵢ(Shift + u·2ⁱ⁺¹)
+func (d *Domain) Twiddles() [][]gf2.B128 {
+	return d.twiddles
+}
+
+func (d *Domain) preComputeTwiddles() {
+	k := bits.TrailingZeros64(d.Cardinality)
+	d.twiddles = make([][]gf2.B128, k)
+	if k == 0 {
+		return
+	}
+
+	// w[l] = Wᵢ(βₗ) for i ≤ l < k, and wShift = Wᵢ(Shift), computed with
+	// W₀(x) = x and Wᵢ₊₁(x) = Wᵢ(x)·(Wᵢ(x) + Wᵢ(βᵢ))
+	w := make([]gf2.B128, k)
+	for l := range w {
+		w[l] = basis(l)
+	}
+	wShift := d.Shift
+
+	var tmp, norm gf2.B128
+	for i := 0; i < k; i++ {
+		// Ŵᵢ is linear, so Ŵᵢ(Shift + Σ uⱼ·βᵢ₊₁₊ⱼ) = Ŵᵢ(Shift) + Σ uⱼ·Ŵᵢ(βᵢ₊₁₊ⱼ)
+		norm.Inverse(&w[i])
+		t := make([]gf2.B128, 1<<(k-1-i))
+		t[0].Mul(&wShift, &norm)
+		for j := 0; j < k-1-i; j++ {
+			var wHat gf2.B128
+			wHat.Mul(&w[i+1+j], &norm)
+			for u := 0; u < 1<<j; u++ {
+				t[u+1<<j].Add(&t[u], &wHat)
+			}
+		}
+		d.twiddles[i] = t
+
+		// Wᵢ → Wᵢ₊₁
+		for l := i + 1; l < k; l++ {
+			tmp.Add(&w[l], &w[i])
+			w[l].Mul(&w[l], &tmp)
+		}
+		tmp.Add(&wShift, &w[i])
+		wShift.Mul(&wShift, &tmp)
+	}
+}
+
+// basis returns βₗ
+func basis(l int) (b gf2.B128) {
+	b[l/64] = 1 << (l % 64)
+	return
+}
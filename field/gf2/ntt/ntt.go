@@ -0,0 +1,71 @@
+package ntt
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/field/gf2"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// parallelize threshold for a single layer of butterflies
+const butterflyThreshold = 1 << 10
+
+// NTT evaluates the polynomial whose coefficients in the novel polynomial basis are a,
+// on the points of the domain, and stores the result in a: a[j] ← P(Shift + j).
+//
+// len(a) must be equal to the cardinality of the domain.
+func (domain *Domain) NTT(a []gf2.B128, opts ...Option) {
+	if uint64(len(a)) != domain.Cardinality {
+		panic("ntt: len(a) must be equal to the domain cardinality")
+	}
+	opt := nttOptions(opts...)
+	k := bits.TrailingZeros64(domain.Cardinality)
+	for i := k - 1; i >= 0; i-- {
+		layer(a, domain.twiddles[i], i, opt.nbTasks, false)
+	}
+}
+
+// NTTInverse computes the coefficients in the novel polynomial basis of the polynomial
+// of degree < len(a) such that P(Shift + j) = a[j], and stores them in a.
+//
+// len(a) must be equal to the cardinality of the domain.
+func (domain *Domain) NTTInverse(a []gf2.B128, opts ...Option) {
+	if uint64(len(a)) != domain.Cardinality {
+		panic("ntt: len(a) must be equal to the domain cardinality")
+	}
+	opt := nttOptions(opts...)
+	k := bits.TrailingZeros64(domain.Cardinality)
+	for i := 0; i < k; i++ {
+		layer(a, domain.twiddles[i], i, opt.nbTasks, true)
+	}
+}
+
+// layer applies the butterflies of the i-th layer of the transform,
+// on pairs (a[j], a[j + 2ⁱ]) in blocks of size 2ⁱ⁺¹:
+//
+//	forward: a[j] += t·a[j + 2ⁱ]; a[j + 2ⁱ] += a[j]
+//	inverse: a[j + 2ⁱ] += a[j]; a[j] += t·a[j + 2ⁱ]
+func layer(a []gf2.B128, twiddles []gf2.B128, i, nbTasks int, inverse bool) {
+	half := len(a) / 2
+	if half < butterflyThreshold {
+		nbTasks = 1
+	}
+	mask := 1<<i - 1
+	parallel.Execute(half, func(start, end int) {
+		var tmp gf2.B128
+		for p := start; p < end; p++ {
+			u := p >> i
+			j := u<<(i+1) | p&mask
+			k := j | 1<<i
+			if inverse {
+				a[k].Add(&a[k], &a[j])
+				tmp.Mul(&twiddles[u], &a[k])
+				a[j].Add(&a[j], &tmp)
+			} else {
+				tmp.Mul(&twiddles[u], &a[k])
+				a[j].Add(&a[j], &tmp)
+				a[k].Add(&a[k], &a[j])
+			}
+		}
+	}, nbTasks)
+}
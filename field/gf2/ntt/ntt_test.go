@@ -0,0 +1,110 @@
+package ntt
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/gf2"
+)
+
+// vanishing returns Ŵᵢ(x), computed as a product over span(β₀, …, βᵢ₋₁)
+func vanishing(i int, x gf2.B128) gf2.B128 {
+	var res, resBeta, tmp gf2.B128
+	res.SetOne()
+	resBeta.SetOne()
+	beta := basis(i)
+	for u := uint64(0); u < 1<<i; u++ {
+		var s gf2.B128
+		s.SetUint64(u)
+		tmp.Add(&x, &s)
+		res.Mul(&res, &tmp)
+		tmp.Add(&beta, &s)
+		resBeta.Mul(&resBeta, &tmp)
+	}
+	return *res.Div(&res, &resBeta)
+}
+
+// evaluate returns Σⱼ coefficients[j]·Xⱼ(x)
+func evaluate(coefficients []gf2.B128, x gf2.B128) gf2.B128 {
+	var res, xj, tmp gf2.B128
+	for j := range coefficients {
+		xj.SetOne()
+		for i := 0; j>>i != 0; i++ {
+			if (j>>i)&1 == 1 {
+				w := vanishing(i, x)
+				xj.Mul(&xj, &w)
+			}
+		}
+		tmp.Mul(&coefficients[j], &xj)
+		res.Add(&res, &tmp)
+	}
+	return res
+}
+
+func TestNTT(t *testing.T) {
+	var shift gf2.B128
+	shift.SetRandom()
+
+	for _, opts := range [][]DomainOption{nil, {WithShift(shift)}} {
+		for _, size := range []uint64{1, 2, 4, 8, 32} {
+			domain := NewDomain(size, opts...)
+			coefficients := make([]gf2.B128, size)
+			for i := range coefficients {
+				coefficients[i].SetRandom()
+			}
+			a := make([]gf2.B128, size)
+			copy(a, coefficients)
+			domain.NTT(a)
+
+			for j := range a {
+				var x gf2.B128
+				x.SetUint64(uint64(j)).Add(&x, &domain.Shift)
+				expected := evaluate(coefficients, x)
+				if !a[j].Equal(&expected) {
+					t.Fatalf("size %d: wrong evaluation at index %d", size, j)
+				}
+			}
+
+			domain.NTTInverse(a)
+			for j := range a {
+				if !a[j].Equal(&coefficients[j]) {
+					t.Fatalf("size %d: NTTInverse(NTT(a)) != a", size)
+				}
+			}
+		}
+	}
+}
+
+func TestNTTInverse(t *testing.T) {
+	const size = 1 << 12
+	var shift gf2.B128
+	shift.SetRandom()
+	domain := NewDomain(size, WithShift(shift))
+
+	a := make([]gf2.B128, size)
+	for i := range a {
+		a[i].SetRandom()
+	}
+	b := make([]gf2.B128, size)
+	copy(b, a)
+
+	domain.NTTInverse(b)
+	domain.NTT(b, WithNbTasks(3))
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			t.Fatal("NTT(NTTInverse(a)) != a")
+		}
+	}
+}
+
+func BenchmarkNTT(b *testing.B) {
+	const logSize = 16
+	domain := NewDomain(1 << logSize)
+	a := make([]gf2.B128, 1<<logSize)
+	for i := range a {
+		a[i].SetRandom()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		domain.NTT(a)
+	}
+}
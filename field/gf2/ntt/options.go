@@ -0,0 +1,67 @@
+package ntt
+
+import (
+	"runtime"
+
+	"github.com/consensys/gnark-crypto/field/gf2"
+)
+
+// Option defines option for altering the behavior of NTT methods.
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type Option func(*nttConfig)
+
+type nttConfig struct {
+	nbTasks int
+}
+
+// WithNbTasks sets the max number of task (go routine) to spawn. Must be between 1 and 512.
+func WithNbTasks(nbTasks int) Option {
+	if nbTasks < 1 {
+		nbTasks = 1
+	} else if nbTasks > 512 {
+		nbTasks = 512
+	}
+	return func(opt *nttConfig) {
+		opt.nbTasks = nbTasks
+	}
+}
+
+// default options
+func nttOptions(opts ...Option) nttConfig {
+	// apply options
+	opt := nttConfig{
+		nbTasks: runtime.NumCPU(),
+	}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
+
+// DomainOption defines option for altering the definition of the NTT domain
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type DomainOption func(*domainConfig)
+
+type domainConfig struct {
+	shift gf2.B128
+}
+
+// WithShift sets the shift of the domain, which is then the affine subspace shift + span(β₀, …, βₖ₋₁).
+// Default is 0.
+func WithShift(shift gf2.B128) DomainOption {
+	return func(opt *domainConfig) {
+		opt.shift = shift
+	}
+}
+
+// default options
+func domainOptions(opts ...DomainOption) domainConfig {
+	// apply options
+	opt := domainConfig{}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
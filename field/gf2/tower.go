@@ -0,0 +1,157 @@
+package gf2
+
+// The canonical (Wiedemann) tower is defined recursively by
+//
+//	T₀ = GF(2)
+//	Tᵢ = Tᵢ₋₁[Xᵢ]/(Xᵢ² + Xᵢ₋₁·Xᵢ + 1), with X₀ = 1
+//
+// An element of Tᵢ is stored on 2ⁱ bits as a = a₀ + a₁·Xᵢ, where a₀ (low half) and a₁ (high half)
+// are elements of Tᵢ₋₁. In particular, Tⱼ ⊂ Tᵢ for j < i is the set of elements of Tᵢ whose high
+// bits are zero, and the embedding is a plain zero-extension.
+//
+// Multiplication in T₃ and T₄ uses logarithm tables; higher levels use Karatsuba over the level below,
+// except T₆ and T₇ when the CPU has a carry-less multiplication (see tower_amd64.go).
+
+const (
+	// generator8 generates the multiplicative group of T₃
+	generator8 = 0x13
+	// generator16 generates the multiplicative group of T₄
+	generator16 = 0x102
+)
+
+var (
+	log8  [1 << 8]uint16
+	exp8  [2 * 255]uint8
+	log16 [1 << 16]uint32
+	exp16 [2 * 65535]uint16
+)
+
+func init() {
+	x := uint64(1)
+	for i := 0; i < 255; i++ {
+		exp8[i] = uint8(x)
+		exp8[i+255] = uint8(x)
+		log8[x] = uint16(i)
+		x = mulBits(x, generator8, 3)
+	}
+
+	x = 1
+	for i := 0; i < 65535; i++ {
+		exp16[i] = uint16(x)
+		exp16[i+65535] = uint16(x)
+		log16[x] = uint32(i)
+		x = karatsuba(x, generator16, 4)
+	}
+}
+
+// mulBits multiplies a and b in Tᵢ bit by bit; it is only used to build the T₃ tables.
+func mulBits(a, b uint64, level int) uint64 {
+	if level == 0 {
+		return a & b
+	}
+	h := uint(1) << (level - 1)
+	mask := uint64(1)<<h - 1
+	a0, a1 := a&mask, a>>h
+	b0, b1 := b&mask, b>>h
+	z0 := mulBits(a0, b0, level-1)
+	z2 := mulBits(a1, b1, level-1)
+	z1 := mulBits(a0^a1, b0^b1, level-1) ^ z0 ^ z2
+	return (z0 ^ z2) | (z1^mulByX(z2, level-1))<<h
+}
+
+// mulByX multiplies a ∈ Tᵢ by Xᵢ:
+//
+//	(a₀ + a₁·Xᵢ)·Xᵢ = a₁ + (a₀ + a₁·Xᵢ₋₁)·Xᵢ
+func mulByX(a uint64, level int) uint64 {
+	if level == 0 {
+		return a
+	}
+	h := uint(1) << (level - 1)
+	mask := uint64(1)<<h - 1
+	a0, a1 := a&mask, a>>h
+	return a1 | (a0^mulByX(a1, level-1))<<h
+}
+
+func mul8(a, b uint8) uint8 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return exp8[log8[a]+log8[b]]
+}
+
+func mul16(a, b uint16) uint16 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return exp16[log16[a]+log16[b]]
+}
+
+// mul64 multiplies a and b in Tᵢ, for 3 ≤ i ≤ 6
+func mul64(a, b uint64, level int) uint64 {
+	switch level {
+	case 3:
+		return uint64(mul8(uint8(a), uint8(b)))
+	case 4:
+		return uint64(mul16(uint16(a), uint16(b)))
+	case 6:
+		return mulT6(a, b)
+	default:
+		return karatsuba(a, b, level)
+	}
+}
+
+// karatsuba multiplies a and b in Tᵢ with three multiplications in Tᵢ₋₁:
+//
+//	(a₀ + a₁·Xᵢ)·(b₀ + b₁·Xᵢ) = (a₀b₀ + a₁b₁) + ((a₀+a₁)(b₀+b₁) - a₀b₀ - a₁b₁ + a₁b₁·Xᵢ₋₁)·Xᵢ
+func karatsuba(a, b uint64, level int) uint64 {
+	h := uint(1) << (level - 1)
+	mask := uint64(1)<<h - 1
+	a0, a1 := a&mask, a>>h
+	b0, b1 := b&mask, b>>h
+	z0 := mul64(a0, b0, level-1)
+	z2 := mul64(a1, b1, level-1)
+	z1 := mul64(a0^a1, b0^b1, level-1) ^ z0 ^ z2
+	return (z0 ^ z2) | (z1^mulByX(z2, level-1))<<h
+}
+
+// inv64 inverts a in Tᵢ, for 3 ≤ i ≤ 6; 0 is mapped to 0.
+//
+// The conjugate of a = a₀ + a₁·Xᵢ is ā = (a₀ + a₁·Xᵢ₋₁) + a₁·Xᵢ, and a·ā = a₀(a₀ + a₁·Xᵢ₋₁) + a₁² ∈ Tᵢ₋₁.
+func inv64(a uint64, level int) uint64 {
+	switch level {
+	case 3:
+		if a == 0 {
+			return 0
+		}
+		return uint64(exp8[255-log8[a]])
+	case 4:
+		if a == 0 {
+			return 0
+		}
+		return uint64(exp16[65535-log16[a]])
+	}
+	h := uint(1) << (level - 1)
+	mask := uint64(1)<<h - 1
+	a0, a1 := a&mask, a>>h
+	c0 := a0 ^ mulByX(a1, level-1)
+	n := mul64(a0, c0, level-1) ^ mul64(a1, a1, level-1)
+	n = inv64(n, level-1)
+	return mul64(c0, n, level-1) | mul64(a1, n, level-1)<<h
+}
+
+// mul128Generic sets z = x·y in T₇
+func mul128Generic(z, x, y *B128) {
+	z0 := mul64(x[0], y[0], 6)
+	z2 := mul64(x[1], y[1], 6)
+	z1 := mul64(x[0]^x[1], y[0]^y[1], 6) ^ z0 ^ z2
+	z[0] = z0 ^ z2
+	z[1] = z1 ^ mulByX(z2, 6)
+}
+
+// inv128 sets z = x⁻¹ in T₇; 0 is mapped to 0
+func inv128(z, x *B128) {
+	c0 := x[0] ^ mulByX(x[1], 6)
+	n := mul64(x[0], c0, 6) ^ mul64(x[1], x[1], 6)
+	n = inv64(n, 6)
+	z[0], z[1] = mul64(c0, n, 6), mul64(x[1], n, 6)
+}
@@ -0,0 +1,207 @@
+//go:build !purego
+// +build !purego
+
+package gf2
+
+import (
+	"math/bits"
+
+	"golang.org/x/sys/cpu"
+)
+
+// T₇ is isomorphic to the field P = GF(2)[x]/(x¹²⁸ + x⁷ + x² + x + 1), where a product is a carry-less
+// multiplication followed by a cheap reduction. When the CPU supports PCLMULQDQ, the multiplications in T₆
+// and T₇ map their operands to P, multiply there, and map the result back; both changes of basis are
+// linear maps over GF(2), applied one byte at a time with the tables below.
+//
+// The isomorphism sends Xᵢ to a root yᵢ ∈ P of Y² + yᵢ₋₁·Y + 1, with y₀ = 1; T₆ is sent to the subfield
+// of order 2⁶⁴ of P, so the same tables serve both levels.
+
+// supportCLMUL is set if the CPU has the carry-less multiplication instruction
+var supportCLMUL = cpu.X86.HasPCLMULQDQ
+
+var (
+	// toPoly[i][v] is the image in P of the element of T₇ whose byte i is v, and the other ones zero
+	toPoly [16][256][2]uint64
+	// fromPoly[i][v] is the element of T₇ whose image in P has byte i equal to v, and the other ones zero
+	fromPoly [16][256][2]uint64
+)
+
+func init() {
+	if supportCLMUL {
+		initPolyBasis()
+	}
+}
+
+// mulPoly sets z = x·y in P; it is implemented in tower_amd64.s.
+//
+//go:noescape
+func mulPoly(z, x, y *[2]uint64)
+
+// mulT6 multiplies a and b in T₆
+func mulT6(a, b uint64) uint64 {
+	if !supportCLMUL {
+		return karatsuba(a, b, 6)
+	}
+	x, y := toPolyBasis(a, 0), toPolyBasis(b, 0)
+	mulPoly(&x, &x, &y)
+	r := fromPolyBasis(&x)
+	return r[0]
+}
+
+// mul128 sets z = x·y in T₇
+func mul128(z, x, y *B128) {
+	if !supportCLMUL {
+		mul128Generic(z, x, y)
+		return
+	}
+	a, b := toPolyBasis(x[0], x[1]), toPolyBasis(y[0], y[1])
+	mulPoly(&a, &a, &b)
+	*z = fromPolyBasis(&a)
+}
+
+// toPolyBasis returns the image in P of the element x0 + x1·X₇ of T₇
+func toPolyBasis(x0, x1 uint64) (r [2]uint64) {
+	for i := 0; i < 8; i++ {
+		t := &toPoly[i][byte(x0>>(8*i))]
+		r[0] ^= t[0]
+		r[1] ^= t[1]
+	}
+	if x1 == 0 {
+		return
+	}
+	for i := 0; i < 8; i++ {
+		t := &toPoly[8+i][byte(x1>>(8*i))]
+		r[0] ^= t[0]
+		r[1] ^= t[1]
+	}
+	return
+}
+
+// fromPolyBasis returns the element of T₇ whose image in P is x
+func fromPolyBasis(x *[2]uint64) (r B128) {
+	for i := 0; i < 16; i++ {
+		t := &fromPoly[i][byte(x[i/8]>>(8*(i%8)))]
+		r[0] ^= t[0]
+		r[1] ^= t[1]
+	}
+	return
+}
+
+// initPolyBasis computes the isomorphism between T₇ and P, and fills the conversion tables
+func initPolyBasis() {
+	// y[i] is the image of Xᵢ; with y = c·z, the equation y² + c·y + 1 = 0 becomes z² + z = c⁻²
+	var y [8][2]uint64
+	y[0][0] = 1
+	var squarePlusId gf2Basis
+	for i := 0; i < 128; i++ {
+		var e, s [2]uint64
+		e[i/64] = 1 << (i % 64)
+		mulPoly(&s, &e, &e)
+		s[i/64] ^= 1 << (i % 64)
+		squarePlusId.insert(s, e)
+	}
+	for i := 1; i < 8; i++ {
+		var d [2]uint64
+		mulPoly(&d, &y[i-1], &y[i-1])
+		d = invPoly(d)
+		z, ok := squarePlusId.solve(d)
+		if !ok {
+			panic("gf2: no root for the defining polynomial of the tower")
+		}
+		mulPoly(&y[i], &y[i-1], &z)
+	}
+
+	// the image of the element of T₇ whose binary representation is 2ᵏ is the product of the yⱼ,
+	// for the bits j-1 set in k
+	var images [128][2]uint64
+	images[0][0] = 1
+	var basis gf2Basis
+	for k := 0; k < 128; k++ {
+		if k > 0 {
+			j := bits.Len(uint(k)) - 1
+			mulPoly(&images[k], &images[k-1<<j], &y[j+1])
+		}
+		var e [2]uint64
+		e[k/64] = 1 << (k % 64)
+		basis.insert(images[k], e)
+	}
+
+	var preImages [128][2]uint64
+	for k := 0; k < 128; k++ {
+		var e [2]uint64
+		e[k/64] = 1 << (k % 64)
+		var ok bool
+		if preImages[k], ok = basis.solve(e); !ok {
+			panic("gf2: the change of basis is not invertible")
+		}
+	}
+
+	fillTables(&toPoly, &images)
+	fillTables(&fromPoly, &preImages)
+}
+
+// fillTables sets tables[i][v] to the xor of the columns[8i+b] for the bits b set in v
+func fillTables(tables *[16][256][2]uint64, columns *[128][2]uint64) {
+	for i := 0; i < 16; i++ {
+		for v := 1; v < 256; v++ {
+			b := bits.TrailingZeros(uint(v))
+			prev := &tables[i][v&(v-1)]
+			tables[i][v][0] = prev[0] ^ columns[8*i+b][0]
+			tables[i][v][1] = prev[1] ^ columns[8*i+b][1]
+		}
+	}
+}
+
+// invPoly returns x⁻¹ = x^(2¹²⁸-2) in P
+func invPoly(x [2]uint64) [2]uint64 {
+	r := [2]uint64{1, 0}
+	for i := 0; i < 127; i++ {
+		mulPoly(&r, &r, &r)
+		mulPoly(&r, &r, &x)
+	}
+	mulPoly(&r, &r, &r)
+	return r
+}
+
+// gf2Basis is a basis of a subspace of GF(2)¹²⁸ in echelon form, where each vector remembers the
+// combination of the inserted vectors it is made of
+type gf2Basis struct {
+	vectors [128][2]uint64 // vectors[b] has b as most significant bit, or is zero
+	combos  [128][2]uint64
+}
+
+// insert adds v to the basis, with the label combo
+func (basis *gf2Basis) insert(v, combo [2]uint64) {
+	for b := 127; b >= 0; b-- {
+		if v[b/64]>>(b%64)&1 == 0 {
+			continue
+		}
+		if basis.vectors[b] == ([2]uint64{}) {
+			basis.vectors[b], basis.combos[b] = v, combo
+			return
+		}
+		xor(&v, &basis.vectors[b])
+		xor(&combo, &basis.combos[b])
+	}
+}
+
+// solve returns the xor of the labels of inserted vectors that sum to v, if any
+func (basis *gf2Basis) solve(v [2]uint64) (combo [2]uint64, ok bool) {
+	for b := 127; b >= 0; b-- {
+		if v[b/64]>>(b%64)&1 == 0 {
+			continue
+		}
+		if basis.vectors[b] == ([2]uint64{}) {
+			return combo, false
+		}
+		xor(&v, &basis.vectors[b])
+		xor(&combo, &basis.combos[b])
+	}
+	return combo, true
+}
+
+func xor(z, x *[2]uint64) {
+	z[0] ^= x[0]
+	z[1] ^= x[1]
+}
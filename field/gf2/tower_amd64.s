@@ -0,0 +1,54 @@
+// +build !purego
+
+#include "textflag.h"
+
+// mulPoly(z, x, y *[2]uint64) sets z = x·y in GF(2)[x]/(x¹²⁸ + x⁷ + x² + x + 1)
+//
+// The 256-bit carry-less product w = w₃:w₂:w₁:w₀ is computed with Karatsuba, then reduced with
+// x¹²⁸ = r = x⁷ + x² + x + 1: w₃·r is added at the offset of w₁, then w₂·r at the offset of w₀.
+TEXT ·mulPoly(SB), NOSPLIT, $0-24
+	MOVQ x+8(FP), AX
+	MOVQ y+16(FP), BX
+	MOVOU (AX), X0
+	MOVOU (BX), X1
+
+	// X2 = x₀·y₀, X3 = x₁·y₁
+	MOVOU     X0, X2
+	PCLMULQDQ $0x00, X1, X2
+	MOVOU     X0, X3
+	PCLMULQDQ $0x11, X1, X3
+
+	// X4 = (x₀ + x₁)·(y₀ + y₁) + x₀·y₀ + x₁·y₁
+	PSHUFD    $0x4e, X0, X4
+	PXOR      X0, X4
+	PSHUFD    $0x4e, X1, X5
+	PXOR      X1, X5
+	PCLMULQDQ $0x00, X5, X4
+	PXOR      X2, X4
+	PXOR      X3, X4
+
+	// X2 = w₁:w₀, X3 = w₃:w₂
+	MOVOU  X4, X5
+	PSLLDQ $8, X5
+	PSRLDQ $8, X4
+	PXOR   X5, X2
+	PXOR   X4, X3
+
+	// w₂:w₁ += w₃·r
+	MOVQ      $0x87, CX
+	MOVQ      CX, X6
+	MOVOU     X3, X7
+	PCLMULQDQ $0x01, X6, X7
+	MOVOU     X7, X5
+	PSLLDQ    $8, X5
+	PSRLDQ    $8, X7
+	PXOR      X5, X2
+	PXOR      X7, X3
+
+	// w₁:w₀ += w₂·r
+	PCLMULQDQ $0x00, X6, X3
+	PXOR      X3, X2
+
+	MOVQ  z+0(FP), AX
+	MOVOU X2, (AX)
+	RET
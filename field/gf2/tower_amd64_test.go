@@ -0,0 +1,59 @@
+//go:build !purego
+// +build !purego
+
+package gf2
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTowerCLMUL(t *testing.T) {
+	if !supportCLMUL {
+		t.Skip("no carry-less multiplication")
+	}
+	r := rand.New(rand.NewSource(0)) //#nosec G404 weak rng is fine here
+
+	// mulPoly against a bit by bit multiplication in P
+	mulPolyBits := func(x, y [2]uint64) (z [2]uint64) {
+		for i := 127; i >= 0; i-- {
+			// z = z·x + y_i·x
+			carry := z[1] >> 63
+			z[1] = z[1]<<1 | z[0]>>63
+			z[0] = z[0]<<1 ^ carry*0x87
+			if y[i/64]>>(i%64)&1 == 1 {
+				xor(&z, &x)
+			}
+		}
+		return
+	}
+	for i := 0; i < 1000; i++ {
+		x := [2]uint64{r.Uint64(), r.Uint64()}
+		y := [2]uint64{r.Uint64(), r.Uint64()}
+		if i == 0 {
+			x, y = [2]uint64{^uint64(0), ^uint64(0)}, [2]uint64{^uint64(0), ^uint64(0)}
+		}
+		var z [2]uint64
+		mulPoly(&z, &x, &y)
+		if z != mulPolyBits(x, y) {
+			t.Fatalf("mulPoly(%x, %x) is wrong", x, y)
+		}
+	}
+
+	// the multiplications through P against the Karatsuba ones
+	for i := 0; i < 1000; i++ {
+		x, y := B128{r.Uint64(), r.Uint64()}, B128{r.Uint64(), r.Uint64()}
+		if i == 0 {
+			x, y = B128{^uint64(0), ^uint64(0)}, B128{^uint64(0), ^uint64(0)}
+		}
+		var z, expected B128
+		mul128(&z, &x, &y)
+		mul128Generic(&expected, &x, &y)
+		if z != expected {
+			t.Fatalf("mul128(%x, %x) doesn't match the Karatsuba multiplication", x, y)
+		}
+		if mulT6(x[0], y[0]) != karatsuba(x[0], y[0], 6) {
+			t.Fatalf("mulT6(%x, %x) doesn't match the Karatsuba multiplication", x[0], y[0])
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !amd64 || purego
+// +build !amd64 purego
+
+package gf2
+
+// mulT6 multiplies a and b in T₆
+func mulT6(a, b uint64) uint64 {
+	return karatsuba(a, b, 6)
+}
+
+// mul128 sets z = x·y in T₇
+func mul128(z, x, y *B128) {
+	mul128Generic(z, x, y)
+}
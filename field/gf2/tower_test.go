@@ -0,0 +1,56 @@
+package gf2
+
+import "testing"
+
+func TestTowerTables(t *testing.T) {
+	// the generators must generate the whole multiplicative groups, or the logarithm tables are not bijective
+	for a := uint64(1); a < 1<<8; a++ {
+		if uint64(exp8[log8[a]]) != a {
+			t.Fatalf("T₃ logarithm table is not bijective at %#x", a)
+		}
+	}
+	for a := uint64(1); a < 1<<16; a++ {
+		if uint64(exp16[log16[a]]) != a {
+			t.Fatalf("T₄ logarithm table is not bijective at %#x", a)
+		}
+	}
+
+	// the tables must match the definition of the tower
+	for a := uint64(0); a < 1<<8; a++ {
+		for b := uint64(0); b < 1<<8; b++ {
+			if uint64(mul8(uint8(a), uint8(b))) != mulBits(a, b, 3) {
+				t.Fatalf("T₃ mul table is wrong at %#x·%#x", a, b)
+			}
+		}
+	}
+	for a := uint64(0); a < 1<<16; a += 97 {
+		for b := uint64(0); b < 1<<16; b += 89 {
+			if uint64(mul16(uint16(a), uint16(b))) != mulBits(a, b, 4) {
+				t.Fatalf("T₄ mul table is wrong at %#x·%#x", a, b)
+			}
+		}
+	}
+}
+
+func TestTowerGenerator(t *testing.T) {
+	// Xᵢ² + Xᵢ₋₁·Xᵢ + 1 = 0
+	for level := 1; level <= 6; level++ {
+		x := uint64(1) << (1 << (level - 1))
+		xPrev := uint64(1)
+		if level > 1 {
+			xPrev = uint64(1) << (1 << (level - 2))
+		}
+		if mulBits(x, x, level)^mulBits(xPrev, x, level)^1 != 0 {
+			t.Fatalf("X%d is not a root of its defining polynomial", level)
+		}
+	}
+	var x, xPrev, r B128
+	x[1] = 1
+	xPrev[0] = 1 << 32
+	r.Square(&x)
+	xPrev.Mul(&xPrev, &x)
+	r.Add(&r, &xPrev)
+	if !r.IsOne() {
+		t.Fatal("X7 is not a root of its defining polynomial")
+	}
+}
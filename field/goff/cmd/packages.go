@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
+	"github.com/consensys/gnark-crypto/internal/generator/fft"
+	fri "github.com/consensys/gnark-crypto/internal/generator/fri/template"
+	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+const parallelImportPath = "github.com/consensys/gnark-crypto/internal/parallel"
+
+const (
+	// minTwoAdicityFFT is the 2-adicity needed by the tests of the generated fft package
+	minTwoAdicityFFT = 10
+	// minTwoAdicityFRI is the 2-adicity needed by the tests of the generated fri package
+	minTwoAdicityFRI = 16
+)
+
+// templateDirs maps the template directories of internal/generator to their embedded copies
+var templateDirs = map[string]func() (fs.FS, error){
+	"fft/template":              func() (fs.FS, error) { return fs.Sub(fft.Templates, "template") },
+	"polynomial/template":       func() (fs.FS, error) { return fs.Sub(polynomial.Templates, "template") },
+	"crypto/hash/mimc/template": func() (fs.FS, error) { return fs.Sub(mimc.Templates, "template") },
+	"fri/template":              func() (fs.FS, error) { return fri.Templates, nil },
+}
+
+// generatePackages generates the fft, polynomial, mimc and fri packages requested by the flags,
+// next to the field package F in outputDir, whose import path is importPath.
+//
+// The fft package uses the package parallel of gnark-crypto, which is internal: it is copied in the
+// internal/parallel sub-directory of outputDir.
+func generatePackages(F *field.FieldConfig, outputDir, importPath string) error {
+	if !fFFT && !fPolynomial && !fMiMC {
+		return nil
+	}
+	if F.ElementName != "Element" {
+		return errors.New("the fft, polynomial, mimc and fri packages require --element Element")
+	}
+
+	var fftConf *config.FFT
+	if fFFT {
+		var err error
+		if fftConf, err = config.NewFFT(F.ModulusBig, fFFTGenerator); err != nil {
+			return err
+		}
+		minTwoAdicity := uint64(minTwoAdicityFFT)
+		if fFRI {
+			minTwoAdicity = minTwoAdicityFRI
+		}
+		if fftConf.LogTwoOrderMaxTwoAdicSubgroup < minTwoAdicity || fftConf.LogTwoOrderMaxTwoAdicSubgroup > 63 {
+			return fmt.Errorf("the 2-adicity of the field is %d, the fft package needs between %d and 63", fftConf.LogTwoOrderMaxTwoAdicSubgroup, minTwoAdicity)
+		}
+	}
+
+	outputDir, err := filepath.Abs(outputDir)
+	if err != nil {
+		return err
+	}
+
+	// the templates are embedded in the generators; bavard reads them from a temporary directory
+	tmpDir, err := os.MkdirTemp("", "goff")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	for dir, sub := range templateDirs {
+		fsys, err := sub()
+		if err != nil {
+			return err
+		}
+		if err := extract(fsys, templateDir(tmpDir, dir)); err != nil {
+			return err
+		}
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	if fFFT {
+		conf := fft.Config{
+			FF:               F.PackageName,
+			FieldPackagePath: importPath,
+			FFT:              *fftConf,
+		}
+		if err := fft.GenerateFromDir(conf, filepath.Join(outputDir, "fft"), templateDir(tmpDir, "fft/template"), bgen); err != nil {
+			return err
+		}
+		if err := copyParallel(outputDir, importPath); err != nil {
+			return err
+		}
+	}
+
+	if fPolynomial {
+		conf := config.FieldDependency{
+			FieldPackagePath: importPath,
+			FieldPackageName: F.PackageName,
+			ElementType:      F.PackageName + ".Element",
+		}
+		if err := polynomial.GenerateFromDir(conf, filepath.Join(outputDir, "polynomial"), templateDir(tmpDir, "polynomial/template"), true, bgen); err != nil {
+			return err
+		}
+	}
+
+	if fMiMC {
+		conf, err := mimc.NewFieldConfig(F.PackageName, importPath, F.ModulusBig)
+		if err != nil {
+			return err
+		}
+		if err := mimc.GenerateFromDir(conf, filepath.Join(outputDir, "mimc"), templateDir(tmpDir, "crypto/hash/mimc/template"), bgen); err != nil {
+			return err
+		}
+	}
+
+	if fFRI {
+		conf := fri.Config{
+			FF:               F.PackageName,
+			FieldPackagePath: importPath,
+		}
+		if err := fri.GenerateFromDir(conf, filepath.Join(outputDir, "fri"), templateDir(tmpDir, "fri/template"), bgen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// templateDir returns the directory in which the templates of dir, a key of templateDirs, are extracted
+func templateDir(tmpDir, dir string) string {
+	return filepath.Join(tmpDir, filepath.FromSlash(dir))
+}
+
+// copyParallel writes the package parallel in outputDir/internal/parallel, and makes the fft package
+// import it instead of the one of gnark-crypto
+func copyParallel(outputDir, importPath string) error {
+	dir := filepath.Join(outputDir, "internal", "parallel")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "execute.go"), parallel.Source, 0o644); err != nil {
+		return err
+	}
+	return replaceImport(filepath.Join(outputDir, "fft"), parallelImportPath, importPath+"/internal/parallel")
+}
+
+// replaceImport replaces the import path old by new in the Go files of dir, and formats them
+func replaceImport(dir, old, new string) error {
+	oldQuoted, newQuoted := []byte(fmt.Sprintf("%q", old)), []byte(fmt.Sprintf("%q", new))
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+	for _, path := range files {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !bytes.Contains(src, oldQuoted) {
+			continue
+		}
+		if src, err = format.Source(bytes.ReplaceAll(src, oldQuoted, newQuoted)); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extract copies the files of fsys in dir
+func extract(fsys fs.FS, dir string) error {
+	return fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, filepath.FromSlash(path))
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		b, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, b, 0o600)
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGenerateExternalModule checks that the output of goff builds in a module other than gnark-crypto
+func TestGenerateExternalModule(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the build of the generated packages in short mode")
+	}
+
+	root, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goSum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a module with the requirements of gnark-crypto, which is replaced by this tree
+	dir := t.TempDir()
+	mod := strings.Replace(string(goMod), "module github.com/consensys/gnark-crypto", "module example.com/goff", 1)
+	mod += "\nrequire github.com/consensys/gnark-crypto v0.0.0\n\nreplace github.com/consensys/gnark-crypto => " + root + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(mod), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// bn254 fr, with all the sub-packages
+	setFlags(t, func() {
+		fModulus = "21888242871839275222246405745257275088548364400416034343698204186575808495617"
+		fOutputDir = filepath.Join(dir, "fr")
+		fPackageName = "fr"
+		fElementName = "Element"
+		fImportPath = "example.com/goff/fr"
+		fExtensions = []string{"E2:2:5"}
+		fFFT, fPolynomial, fMiMC, fFRI = true, true, true, true
+	})
+	if err := parseFlags(rootCmd); err != nil {
+		t.Fatal(err)
+	}
+	if err := generate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{{"build", "./..."}, {"vet", "./..."}} {
+		cmd := exec.Command("go", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("go %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+	}
+}
+
+// setFlags sets the flags with set, and restores them at the end of the test
+func setFlags(t *testing.T, set func()) {
+	modulus, outputDir, packageName, elementName, importPath := fModulus, fOutputDir, fPackageName, fElementName, fImportPath
+	extensions, specialReduction := fExtensions, fSpecialReduction
+	fftFlag, fftGenerator, polynomialFlag, mimcFlag, friFlag := fFFT, fFFTGenerator, fPolynomial, fMiMC, fFRI
+	t.Cleanup(func() {
+		fModulus, fOutputDir, fPackageName, fElementName, fImportPath = modulus, outputDir, packageName, elementName, importPath
+		fExtensions, fSpecialReduction = extensions, specialReduction
+		fFFT, fFFTGenerator, fPolynomial, fMiMC, fFRI = fftFlag, fftGenerator, polynomialFlag, mimcFlag, friFlag
+	})
+	set()
+}
@@ -17,9 +17,10 @@ package cmd
 
 import (
 	"fmt"
-	"math/bits"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/consensys/gnark-crypto/field/generator"
@@ -40,6 +41,16 @@ var (
 	fOutputDir   string
 	fPackageName string
 	fElementName string
+	fExtensions  []string
+	fImportPath  string
+
+	fSpecialReduction bool
+
+	fFFT          bool
+	fFFTGenerator uint64
+	fPolynomial   bool
+	fMiMC         bool
+	fFRI          bool
 )
 
 func init() {
@@ -48,9 +59,15 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&fModulus, "modulus", "m", "", "field modulus (base 10)")
 	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path to create output files")
 	rootCmd.PersistentFlags().StringVarP(&fPackageName, "package", "p", "", "package name in generated files")
-	if bits.UintSize != 64 {
-		panic("goff only supports 64bits architectures")
-	}
+	rootCmd.PersistentFlags().StringArrayVarP(&fExtensions, "extension", "x", nil, "extension to generate in the extensions sub-package, as NAME:DEGREE:β[:BASE] (repeatable, see below)")
+	rootCmd.PersistentFlags().BoolVar(&fSpecialReduction, "special-reduction", false, "use the reduction dedicated to moduli of the form 2ᵏ - c with c < 2⁶⁴ (pseudo-Mersenne, Solinas, Goldilocks-like)")
+	rootCmd.PersistentFlags().StringVar(&fImportPath, "import", "", "import path of the generated package, needed by the extensions and the sub-packages below (default: resolved with go list)")
+	rootCmd.PersistentFlags().BoolVar(&fFFT, "fft", false, "generate the fft sub-package; the field must have a 2-adicity of at least 10")
+	rootCmd.PersistentFlags().Uint64Var(&fFFTGenerator, "fft-generator", 0, "generator of the multiplicative group used by the fft package (default: the smallest one, found by factoring the modulus - 1)")
+	rootCmd.PersistentFlags().BoolVar(&fPolynomial, "polynomial", false, "generate the polynomial sub-package")
+	rootCmd.PersistentFlags().BoolVar(&fMiMC, "mimc", false, "generate the mimc sub-package")
+	rootCmd.PersistentFlags().BoolVar(&fFRI, "fri", false, "generate the fri sub-package; implies --fft, and needs a 2-adicity of at least 16")
+	rootCmd.SetUsageTemplate(rootCmd.UsageTemplate() + extensionUsage)
 }
 
 func cmdGenerate(cmd *cobra.Command, args []string) {
@@ -66,15 +83,116 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 	}
 
 	// generate code
-	F, err := field.NewFieldConfig(fPackageName, fElementName, fModulus, false)
-	if err != nil {
+	if err := generate(); err != nil {
 		fmt.Printf("\n%s\n", err.Error())
 		os.Exit(-1)
 	}
+}
+
+// generate generates the field, and the extensions and packages requested by the flags
+func generate() error {
+	F, err := field.NewFieldConfig(fPackageName, fElementName, fModulus, false)
+	if err != nil {
+		return err
+	}
+	if fSpecialReduction {
+		if err := F.EnableSpecialReduction(); err != nil {
+			return err
+		}
+	}
+	exts, err := parseExtensions(F, fExtensions)
+	if err != nil {
+		return err
+	}
 	if err := generator.GenerateFF(F, fOutputDir); err != nil {
-		fmt.Printf("\n%s\n", err.Error())
-		os.Exit(-1)
+		return err
+	}
+
+	if len(exts) == 0 && !fFFT && !fPolynomial && !fMiMC {
+		return nil
+	}
+	importPath := fImportPath
+	if importPath == "" {
+		if importPath, err = resolveImportPath(fOutputDir); err != nil {
+			return fmt.Errorf("can't resolve the import path of %s, use --import: %w", fOutputDir, err)
+		}
+	}
+	if len(exts) != 0 {
+		if err := generator.GenerateExtensions(F, importPath, filepath.Join(fOutputDir, "extensions"), exts...); err != nil {
+			return err
+		}
 	}
+	return generatePackages(F, fOutputDir, importPath)
+}
+
+const extensionUsage = `
+Extensions:
+  Each --extension flag describes a binomial extension L = B[v]/(vⁿ - β), generated in <output>/extensions:
+    NAME    name of the generated type
+    DEGREE  n, 2 or 3
+    β       the non-residue, as comma separated base 10 coordinates over the prime field
+    BASE    name of a previously listed extension; the base is the prime field when omitted
+  For instance, the bn254 tower Fp2 = Fp[u]/(u²+1), Fp6 = Fp2[v]/(v³-(9+u)), Fp12 = Fp6[w]/(w²-v) is
+    -x E2:2:-1 -x E6:3:9,1:E2 -x E12:2:0,0,1,0,0,0:E6
+`
+
+// parseExtensions parses the --extension flags, and checks that they describe a tower over F
+// before anything is generated
+func parseExtensions(F *field.FieldConfig, specs []string) ([]field.BinomialExtension, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+	exts := make([]field.BinomialExtension, len(specs))
+	for i, s := range specs {
+		var err error
+		if exts[i], err = parseExtension(s); err != nil {
+			return nil, err
+		}
+	}
+	if err := generator.ValidateExtensions(F, exts...); err != nil {
+		return nil, err
+	}
+	return exts, nil
+}
+
+// parseExtension parses an extension given as NAME:DEGREE:β[:BASE]
+func parseExtension(s string) (field.BinomialExtension, error) {
+	var ext field.BinomialExtension
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return ext, fmt.Errorf("invalid extension %q: expected NAME:DEGREE:β[:BASE]", s)
+	}
+	ext.Name = strings.TrimSpace(parts[0])
+	if ext.Name == "" {
+		return ext, fmt.Errorf("invalid extension %q: empty name", s)
+	}
+	degree, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return ext, fmt.Errorf("invalid extension %q: can't parse degree: %w", s, err)
+	}
+	ext.Degree = degree
+	for _, c := range strings.Split(parts[2], ",") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			return ext, fmt.Errorf("invalid extension %q: empty coordinate in β", s)
+		}
+		ext.NonResidue = append(ext.NonResidue, c)
+	}
+	if len(parts) == 4 {
+		ext.Base = strings.TrimSpace(parts[3])
+	}
+	return ext, nil
+}
+
+// resolveImportPath returns the import path of the package in dir
+func resolveImportPath(dir string) (string, error) {
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", ".")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
 }
 
 func parseFlags(cmd *cobra.Command) error {
@@ -88,6 +206,9 @@ func parseFlags(cmd *cobra.Command) error {
 	// clean inputs
 	fOutputDir = filepath.Clean(fOutputDir)
 	fPackageName = strings.ToLower(fPackageName)
+	if fFRI {
+		fFFT = true
+	}
 
 	return nil
 }
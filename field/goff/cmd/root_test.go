@@ -0,0 +1,93 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// bn254 fp: -1 is a non-square, and 9+u a non-cube in Fp²
+const bn254Modulus = "21888242871839275222246405745257275088696311157297823662689037894645226208583"
+
+func TestParseExtension(t *testing.T) {
+	t.Parallel()
+
+	ext, err := parseExtension(" E6 : 3 : 9, 1 : E2 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := field.BinomialExtension{Name: "E6", Degree: 3, NonResidue: []string{"9", "1"}, Base: "E2"}
+	if !reflect.DeepEqual(ext, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, ext)
+	}
+
+	F, err := field.NewFieldConfig("fp", "Element", bn254Modulus, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exts, err := parseExtensions(F, []string{"E2:2:-1", "E6:3:9,1:E2", "E12:2:0,0,1,0,0,0:E6"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exts) != 3 || exts[2].Base != "E6" {
+		t.Fatalf("wrong extensions %+v", exts)
+	}
+}
+
+func TestParseExtensionErrors(t *testing.T) {
+	t.Parallel()
+
+	F, err := field.NewFieldConfig("fp", "Element", bn254Modulus, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		specs []string
+		err   string
+	}{
+		{"missing β", []string{"E2:2"}, "expected NAME:DEGREE:β[:BASE]"},
+		{"too many fields", []string{"E4:2:0,1:E2:E1"}, "expected NAME:DEGREE:β[:BASE]"},
+		{"empty name", []string{":2:-1"}, "empty name"},
+		{"unparsable degree", []string{"E2:two:-1"}, "can't parse degree"},
+		{"empty coordinate", []string{"E4:2:0,,1:E2"}, "empty coordinate"},
+		{"unparsable coordinate", []string{"E2:2:i"}, "can't parse coordinate"},
+		{"too many coordinates", []string{"E2:2:1,1"}, "coordinates"},
+		{"unknown base", []string{"E4:2:0,1:E2"}, "unknown base"},
+		{"base listed after", []string{"E4:2:0,1:E2", "E2:2:-1"}, "unknown base"},
+		{"duplicate name", []string{"E2:2:-1", "E2:2:-1"}, "duplicate"},
+		{"zero β", []string{"E2:2:0"}, "non-zero"},
+		{"square β", []string{"E2:2:4"}, "not irreducible"},
+		{"cube β", []string{"E3:3:8"}, "not irreducible"},
+		{"square β in the base", []string{"E2:2:-1", "E4:2:-1:E2"}, "not irreducible"},
+		{"degree 1", []string{"E1:1:-1"}, "unsupported extension degree"},
+		{"degree 4", []string{"E4:4:-1"}, "unsupported extension degree"},
+		{"negative degree", []string{"E2:-2:-1"}, "unsupported extension degree"},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parseExtensions(F, tc.specs)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,17 @@
 //
 //	goff -m 0xffffffff00000001 -o ./goldilocks/ -p goldilocks -e Element
 //
+// goff can also generate towers of quadratic and cubic extensions of the field in the extensions sub-package,
+// with Mul, Square, Inverse, Sqrt, Frobenius and (for quadratic extensions) Conjugate.
+// For instance, the sextic extension Fp6 = Fp2[v]/(v³ - (9+u)) over Fp2 = Fp[u]/(u² + 1) of bn254 is generated with:
+//
+//	goff -m 21888242871839275222246405745257275088696311157297823662689037894645226208583 -o ./fp/ -p fp -e Element -x E2:2:-1 -x E6:3:9,1:E2
+//
+// The --fft, --polynomial, --mimc and --fri flags generate the corresponding sub-packages, as found in ecc/*/fr, for
+// the field. The fft and fri packages need a field of large enough 2-adicity:
+//
+//	goff -m 0xffffffff00000001 -o ./goldilocks/ -p goldilocks -e Element --fft --polynomial --mimc --fri
+//
 // # Warning
 //
 // The generated code has not been audited for all moduli (only bn254 and bls12-381) and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
@@ -0,0 +1,343 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E2 is a degree two finite field extension of goldilocks
+//
+// E2 = A0 + A1·u, with u² = 7
+type E2 struct {
+	A0, A1 goldilocks.Element
+}
+
+// nonResidueE2 is u² = 7
+var nonResidueE2 goldilocks.Element
+
+func init() {
+	nonResidueE2.SetString("7")
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E2) Cmp(x *E2) int {
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// SetString sets a E2 element from strings
+func (z *E2) SetString(s0, s1 string) *E2 {
+	z.A0.SetString(s0)
+	z.A1.SetString(s1)
+	return z
+}
+
+// SetZero sets an E2 elmt to zero
+func (z *E2) SetZero() *E2 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// Set sets an E2 from x
+func (z *E2) Set(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E2) SetOne() *E2 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// SetRandom sets a0 and a1 to random values
+func (z *E2) SetRandom() (*E2, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// Add adds two elements of E2
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub two elements of E2
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double doubles an E2 element
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg negates an E2 element
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E2) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+}
+
+// Mul sets z to the E2-product of x,y, returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	var a, b, c goldilocks.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	c.Mul(&c, &nonResidueE2)
+	z.A0.Add(&b, &c)
+	return z
+}
+
+// Square sets z to the E2-product of x,x returns z
+func (z *E2) Square(x *E2) *E2 {
+	var a, b goldilocks.Element
+	a.Square(&x.A0)
+	b.Square(&x.A1)
+	b.Mul(&b, &nonResidueE2)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&a, &b)
+	return z
+}
+
+// MulByElement multiplies an element in E2 by an element in goldilocks
+func (z *E2) MulByElement(x *E2, y *goldilocks.Element) *E2 {
+	var yCopy goldilocks.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	return z
+}
+
+// MulByNonResidue multiplies an element in E2 by u
+func (z *E2) MulByNonResidue(x *E2) *E2 {
+	var a goldilocks.Element
+	a.Set(&x.A1)
+	a.Mul(&a, &nonResidueE2)
+	z.A1 = x.A0
+	z.A0 = a
+	return z
+}
+
+// Conjugate conjugates an element in E2
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Frobenius sets z to x^p, where p is the modulus of goldilocks, and returns z
+//
+// Since u² = 7 is a quadratic non-residue, u^p = -u and the Frobenius map is the conjugation.
+func (z *E2) Frobenius(x *E2) *E2 {
+	return z.Conjugate(x)
+}
+
+// Norm sets x to the norm of z over goldilocks, x = a0² - (7)·a1²
+func (z *E2) Norm(x *goldilocks.Element) {
+	var tmp goldilocks.Element
+	x.Square(&z.A0)
+	tmp.Square(&z.A1)
+	tmp.Mul(&tmp, &nonResidueE2)
+	x.Sub(x, &tmp)
+}
+
+// Inverse sets z to the E2-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) Inverse(x *E2) *E2 {
+	var norm goldilocks.Element
+	x.Norm(&norm)
+	norm.Inverse(&norm)
+	z.A0.Mul(&x.A0, &norm)
+	z.A1.Mul(&x.A1, &norm).Neg(&z.A1)
+	return z
+}
+
+// Div sets z = x / y and returns z
+func (z *E2) Div(x, y *E2) *E2 {
+	var r E2
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z=xᵏ in E2 and returns it
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z
+//
+// z is a square in E2 iff its norm is a square in goldilocks.
+func (z *E2) Legendre() int {
+	var n goldilocks.Element
+	z.Norm(&n)
+	return n.Legendre()
+}
+
+// Sqrt z = √x in E2
+// if the square root doesn't exist (x is not a square in E2)
+// Sqrt leaves z unchanged and returns nil
+func (z *E2) Sqrt(x *E2) *E2 {
+	var a0, a1, delta goldilocks.Element
+	var half goldilocks.Element
+
+	if x.A1.IsZero() {
+		// x = a0 ∈ goldilocks: either a0 is a square in goldilocks, or a0/(7) is
+		if a0.Sqrt(&x.A0) != nil {
+			z.A0 = a0
+			z.A1.SetZero()
+			return z
+		}
+		a1.Inverse(&nonResidueE2).Mul(&a1, &x.A0)
+		if a1.Sqrt(&a1) == nil {
+			return nil
+		}
+		z.A0.SetZero()
+		z.A1 = a1
+		return z
+	}
+
+	// x = (a0 + a1·u)², with a0² + (7)·a1² = x.A0 and 2·a0·a1 = x.A1
+	// then a0² = (x.A0 ± √N(x)) / 2
+	x.Norm(&delta)
+	if delta.Sqrt(&delta) == nil {
+		return nil
+	}
+	half.SetUint64(2).Inverse(&half)
+	a0.Add(&x.A0, &delta).Mul(&a0, &half)
+	if a0.Legendre() == -1 {
+		a0.Sub(&x.A0, &delta).Mul(&a0, &half)
+	}
+	if a0.Sqrt(&a0) == nil {
+		return nil
+	}
+	a1.Double(&a0).Inverse(&a1).Mul(&a1, &x.A1)
+
+	z.A0 = a0
+	z.A1 = a1
+	return z
+}
+
+// BatchInvertE2 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE2(a []E2) []E2 {
+	res := make([]E2, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E2
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
@@ -0,0 +1,456 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E3 is a degree three finite field extension of goldilocks
+//
+// E3 = A0 + A1·u + A2·u², with u³ = 7
+type E3 struct {
+	A0, A1, A2 goldilocks.Element
+}
+
+var (
+	// nonResidueE3 is u³ = 7
+	nonResidueE3 goldilocks.Element
+
+	// frobeniusE3[i] = (7)^⌊i·p/3⌋, such that (u^i)^p = frobeniusE3[i]·u^(i·p mod 3)
+	frobeniusE3 [3]goldilocks.Element
+
+	// parameters of the Tonelli-Shanks square root: |E3|-1 = 2^sqrtSE3 · t, with t odd
+	sqrtGE3              goldilocks.Element // a non-square of goldilocks raised to the power t
+	sqrtTMinusOneOver2E3 big.Int            // (t-1)/2
+)
+
+const sqrtSE3 = 32
+
+func init() {
+	nonResidueE3.SetString("7")
+	frobeniusE3[0].SetString("1")
+	frobeniusE3[1].SetString("18446744065119617025")
+	frobeniusE3[2].SetString("4294967295")
+	sqrtGE3.SetString("3607031617444012685")
+	sqrtTMinusOneOver2E3.SetString("7ffffffe80000002fffffffc80000002fffffffe", 16)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E3) Cmp(x *E3) int {
+	if a2 := z.A2.Cmp(&x.A2); a2 != 0 {
+		return a2
+	}
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// SetString sets a E3 element from strings
+func (z *E3) SetString(s0, s1, s2 string) *E3 {
+	z.A0.SetString(s0)
+	z.A1.SetString(s1)
+	z.A2.SetString(s2)
+	return z
+}
+
+// SetZero sets an E3 elmt to zero
+func (z *E3) SetZero() *E3 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// Set sets an E3 from x
+func (z *E3) Set(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	z.A2 = x.A2
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E3) SetOne() *E3 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// SetRandom sets a0, a1 and a2 to random values
+func (z *E3) SetRandom() (*E3, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Add adds two elements of E3
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub two elements of E3
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double doubles an E3 element
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg negates an E3 element
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E3) String() string {
+	return z.A0.String() + "+(" + z.A1.String() + ")*u+(" + z.A2.String() + ")*u**2"
+}
+
+// Mul sets z to the E3-product of x,y, returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Karatsuba, cf https://eprint.iacr.org/2006/471.pdf (section 4)
+	var t0, t1, t2, c0, c1, c2, tmp goldilocks.Element
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	c0.Add(&x.A1, &x.A2)
+	tmp.Add(&y.A1, &y.A2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2)
+	c0.Mul(&c0, &nonResidueE3)
+
+	c1.Add(&x.A0, &x.A1)
+	tmp.Add(&y.A0, &y.A1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	tmp.Set(&t2)
+	tmp.Mul(&tmp, &nonResidueE3)
+	c1.Add(&c1, &tmp)
+
+	c2.Add(&x.A0, &x.A2)
+	tmp.Add(&y.A0, &y.A2)
+	c2.Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2).Add(&c2, &t1)
+
+	z.A0.Add(&c0, &t0)
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// Square sets z to the E3-product of x,x returns z
+func (z *E3) Square(x *E3) *E3 {
+	// c0 = a0² + 2·(7)·a1·a2
+	// c1 = 2·a0·a1 + (7)·a2²
+	// c2 = a1² + 2·a0·a2
+	var c0, c1, c2, tmp goldilocks.Element
+	c0.Square(&x.A0)
+	tmp.Mul(&x.A1, &x.A2).Double(&tmp)
+	tmp.Mul(&tmp, &nonResidueE3)
+	c0.Add(&c0, &tmp)
+
+	c1.Mul(&x.A0, &x.A1).Double(&c1)
+	tmp.Square(&x.A2)
+	tmp.Mul(&tmp, &nonResidueE3)
+	c1.Add(&c1, &tmp)
+
+	c2.Square(&x.A1)
+	tmp.Mul(&x.A0, &x.A2).Double(&tmp)
+	c2.Add(&c2, &tmp)
+
+	z.A0 = c0
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// MulByElement multiplies an element in E3 by an element in goldilocks
+func (z *E3) MulByElement(x *E3, y *goldilocks.Element) *E3 {
+	var yCopy goldilocks.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	return z
+}
+
+// mulByBase multiplies an element in E3 by an element in goldilocks
+func (z *E3) mulByBase(x *E3, y *goldilocks.Element) *E3 {
+	var yCopy goldilocks.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	return z
+}
+
+// MulByNonResidue multiplies an element in E3 by u
+func (z *E3) MulByNonResidue(x *E3) *E3 {
+	var a goldilocks.Element
+	a.Set(&x.A2)
+	a.Mul(&a, &nonResidueE3)
+	z.A2 = x.A1
+	z.A1 = x.A0
+	z.A0 = a
+	return z
+}
+
+// Frobenius sets z to x^p, where p is the modulus of goldilocks, and returns z
+func (z *E3) Frobenius(x *E3) *E3 {
+	var c0, c1, c2 goldilocks.Element
+	c0.Set(&x.A0)
+	c1.Mul(&x.A1, &frobeniusE3[1])
+	c2.Mul(&x.A2, &frobeniusE3[2])
+	z.A0 = c0
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// Norm sets x to the norm of z over goldilocks, x = z·z^Q·z^(Q²) where Q = |goldilocks|
+func (z *E3) Norm(x *goldilocks.Element) {
+	var c0, c1, c2 goldilocks.Element
+	z.cofactors(&c0, &c1, &c2)
+	z.normFromCofactors(x, &c0, &c1, &c2)
+}
+
+// Inverse sets z to the E3-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E3) Inverse(x *E3) *E3 {
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	var c0, c1, c2, norm goldilocks.Element
+	x.cofactors(&c0, &c1, &c2)
+	x.normFromCofactors(&norm, &c0, &c1, &c2)
+	norm.Inverse(&norm)
+
+	z.A0.Mul(&c0, &norm)
+	z.A1.Mul(&c1, &norm)
+	z.A2.Mul(&c2, &norm)
+	return z
+}
+
+// cofactors sets c0 + c1·u + c2·u² = z^Q·z^(Q²)
+func (z *E3) cofactors(c0, c1, c2 *goldilocks.Element) {
+	var tmp goldilocks.Element
+
+	// c0 = a0² - (7)·a1·a2
+	c0.Square(&z.A0)
+	tmp.Mul(&z.A1, &z.A2)
+	tmp.Mul(&tmp, &nonResidueE3)
+	c0.Sub(c0, &tmp)
+
+	// c1 = (7)·a2² - a0·a1
+	tmp.Square(&z.A2)
+	tmp.Mul(&tmp, &nonResidueE3)
+	c1.Mul(&z.A0, &z.A1)
+	c1.Sub(&tmp, c1)
+
+	// c2 = a1² - a0·a2
+	c2.Square(&z.A1)
+	tmp.Mul(&z.A0, &z.A2)
+	c2.Sub(c2, &tmp)
+}
+
+// normFromCofactors sets x = a0·c0 + (7)·(a2·c1 + a1·c2)
+func (z *E3) normFromCofactors(x, c0, c1, c2 *goldilocks.Element) {
+	var t0, t1 goldilocks.Element
+	t0.Mul(&z.A2, c1)
+	t1.Mul(&z.A1, c2)
+	t0.Add(&t0, &t1)
+	t0.Mul(&t0, &nonResidueE3)
+	t1.Mul(&z.A0, c0)
+	x.Add(&t0, &t1)
+}
+
+// Div sets z = x / y and returns z
+func (z *E3) Div(x, y *E3) *E3 {
+	var r E3
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z=xᵏ in E3 and returns it
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)ᵏ
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z
+//
+// Since the extension has odd degree, z is a square in E3 iff its norm is a square in goldilocks.
+func (z *E3) Legendre() int {
+	var n goldilocks.Element
+	z.Norm(&n)
+	return n.Legendre()
+}
+
+// Sqrt z = √x in E3
+// if the square root doesn't exist (x is not a square in E3)
+// Sqrt leaves z unchanged and returns nil
+func (z *E3) Sqrt(x *E3) *E3 {
+	// Tonelli-Shanks
+	switch x.Legendre() {
+	case -1:
+		return nil
+	case 0:
+		return z.SetZero()
+	}
+
+	var y, b, t, w E3
+	var g goldilocks.Element
+	g.Set(&sqrtGE3)
+	r := uint64(sqrtSE3)
+
+	// w = x^((t-1)/2), y = x^((t+1)/2), b = x^t
+	w.Exp(*x, &sqrtTMinusOneOver2E3)
+	y.Mul(x, &w)
+	b.Mul(&w, &y)
+
+	for {
+		var m uint64
+		t.Set(&b)
+
+		// for i in [1, r) check if t^(2^i) == 1
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+
+		if m == 0 {
+			return z.Set(&y)
+		}
+		// t = g^(2^(r-m-1))
+		ge := int(r - m - 1)
+		var gt goldilocks.Element
+		gt.Set(&g)
+		for ge > 0 {
+			gt.Square(&gt)
+			ge--
+		}
+
+		g.Square(&gt)
+		y.mulByBase(&y, &gt)
+		b.mulByBase(&b, &g)
+		r = m
+	}
+}
+
+// BatchInvertE3 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E3
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
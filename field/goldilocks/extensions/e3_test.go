@@ -0,0 +1,357 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// GenE3 generates an E3 elmt
+func GenE3() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var e E3
+		if _, err := e.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&e, gopter.NoShrinker)
+	}
+}
+
+func TestE3ReceiverIsOperand(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+
+	properties.Property("[E3] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (inverse) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (mul by non residue) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.MulByNonResidue(a)
+			a.MulByNonResidue(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Having the receiver as operand (Frobenius) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE3Ops(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+	genC := GenE3()
+
+	properties.Property("[E3] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] mul should be distributive over add", prop.ForAll(
+		func(a, b, c *E3) bool {
+			var l, r, tmp E3
+			l.Add(b, c).Mul(&l, a)
+			r.Mul(a, b)
+			tmp.Mul(a, c)
+			r.Add(&r, &tmp)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E3] mul should be associative", prop.ForAll(
+		func(a, b, c *E3) bool {
+			var l, r E3
+			l.Mul(a, b).Mul(&l, c)
+			r.Mul(b, c).Mul(a, &r)
+			return l.Equal(&r)
+		},
+		genA,
+		genB,
+		genC,
+	))
+
+	properties.Property("[E3] square and mul should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] a * a⁻¹ should equal 1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a).Mul(&b, a)
+			return b.IsOne()
+		},
+		genA,
+	))
+
+	properties.Property("[E3] div should be the inverse of mul", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Mul(a, b).Div(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] MulByNonResidue should be the multiplication by u", prop.ForAll(
+		func(a *E3) bool {
+			var b, u E3
+			u.A1.SetOne()
+			b.MulByNonResidue(a)
+			u.Mul(&u, a)
+			return b.Equal(&u)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] MulByElement should be the multiplication by an embedded base field element", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			e := b.A0
+			d.A0 = e
+			c.MulByElement(a, &e)
+			d.Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] Frobenius should be the exponentiation by p", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Frobenius(a)
+			c.Exp(*a, goldilocks.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Norm should be the product of the conjugates", prop.ForAll(
+		func(a *E3) bool {
+			var n goldilocks.Element
+			a.Norm(&n)
+			var b, c E3
+			b.Set(a)
+			c.Set(a)
+			b.Frobenius(&b)
+			c.Mul(&c, &b)
+			b.Frobenius(&b)
+			c.Mul(&c, &b)
+			return c.A0.Equal(&n) && c.A1.IsZero() && c.A2.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Legendre on square should output 1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Sqrt(x²) should be ±x", prop.ForAll(
+		func(a *E3) bool {
+			var b, c, minusA E3
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			minusA.Neg(a)
+			return c.Equal(a) || c.Equal(&minusA)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil && b.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Exp should match repeated multiplication", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Exp(*a, big.NewInt(5))
+			c.Square(a).Square(&c).Mul(&c, a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Exp by a negative exponent should invert", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Exp(*a, big.NewInt(-1))
+			c.Inverse(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] BatchInvert should output the same result as Inverse", prop.ForAll(
+		func(a, b *E3) bool {
+			var zero E3
+			res := BatchInvertE3([]E3{*a, zero, *b})
+			var ia, ib E3
+			ia.Inverse(a)
+			ib.Inverse(b)
+			return res[0].Equal(&ia) && res[1].IsZero() && res[2].Equal(&ib)
+		},
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE3Mul(b *testing.B) {
+	var a, c E3
+	_, _ = a.SetRandom()
+	_, _ = c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE3Square(b *testing.B) {
+	var a E3
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE3Inverse(b *testing.B) {
+	var a E3
+	_, _ = a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE3Sqrt(b *testing.B) {
+	var a E3
+	_, _ = a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
@@ -18,4 +18,12 @@ func main() {
 		panic(err)
 	}
 	fmt.Println("successfully generated goldilocks field")
+
+	// 7 generates the multiplicative group, hence is neither a square nor a cube
+	e2 := config.BinomialExtension{Name: "E2", Degree: 2, NonResidue: []string{"7"}}
+	e3 := config.BinomialExtension{Name: "E3", Degree: 3, NonResidue: []string{"7"}}
+	if err := generator.GenerateExtensions(goldilocks, "github.com/consensys/gnark-crypto/field/goldilocks", "../extensions", e2, e3); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated goldilocks extensions")
 }
@@ -3,14 +3,21 @@ package hash
 import (
 	"crypto/sha256"
 	"errors"
+	"hash"
 )
 
 // ExpandMsgXmd expands msg to a slice of lenInBytes bytes.
 // https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5
 // https://tools.ietf.org/html/rfc8017#section-4.1 (I2OSP/O2ISP)
 func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
+	return ExpandMsgXmdWithHash(sha256.New(), msg, dst, lenInBytes)
+}
+
+// ExpandMsgXmdWithHash expands msg to a slice of lenInBytes bytes, as ExpandMsgXmd,
+// using h instead of SHA-256 (e.g. SHA-512 for the suites of
+// https://www.rfc-editor.org/rfc/rfc9380.html#section-8.8).
+func ExpandMsgXmdWithHash(h hash.Hash, msg, dst []byte, lenInBytes int) ([]byte, error) {
 
-	h := sha256.New()
 	ell := (lenInBytes + h.Size() - 1) / h.Size() // ceil(len_in_bytes / b_in_bytes)
 	if ell > 255 {
 		return nil, errors.New("invalid lenInBytes")
@@ -59,7 +66,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	b1 := h.Sum(nil)
 
 	res := make([]byte, lenInBytes)
-	copy(res[:h.Size()], b1)
+	copy(res, b1)
 
 	for i := 2; i <= ell; i++ {
 		// b_i = H(strxor(b₀, b_(i - 1)) ∥ I2OSP(i, 1) ∥ DST_prime)
@@ -2,6 +2,7 @@ package hash
 
 import (
 	"bytes"
+	"crypto/sha512"
 	"encoding/hex"
 	"testing"
 )
@@ -130,3 +131,37 @@ func TestExpandMsgXmd(t *testing.T) {
 		}
 	}
 }
+
+// Test vectors from https://www.rfc-editor.org/rfc/rfc9380.html#section-k.3
+func TestExpandMsgXmdSHA512(t *testing.T) {
+	dst := "QUUX-V01-CS02-with-expander-SHA512-256"
+
+	testCases := []expandMsgXmdTestCase{
+		{
+			"",
+			0x20,
+			"6b9a7312411d92f921c6f68ca0b6380730a1a4d982c507211a90964c394179ba",
+		},
+		{
+			"abc",
+			0x20,
+			"0da749f12fbe5483eb066a5f595055679b976e93abe9be6f0f6318bce7aca8dc",
+		},
+		{
+			"abcdef0123456789",
+			0x20,
+			"087e45a86e2939ee8b91100af1583c4938e0f5fc6c9db4b107b83346bc967f58",
+		},
+	}
+
+	for _, testCase := range testCases {
+		uniformBytes, err := ExpandMsgXmdWithHash(sha512.New(), []byte(testCase.msg), []byte(dst), testCase.lenInBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if hex.EncodeToString(uniformBytes) != testCase.uniformBytesHex {
+			t.Errorf("expected \"%s\" got \"%x\"", testCase.uniformBytesHex, uniformBytes)
+		}
+	}
+}
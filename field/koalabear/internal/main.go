@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x7f000001" // 2³¹ - 2²⁴ + 1
+	koalabear, err := config.NewFieldConfig("koalabear", "Element", modulus, true)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(koalabear, "../"); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated koalabear field")
+
+	// E4 = E2[v]/(v² - u) is isomorphic to koalabear[X]/(X⁴ - 3), with v = X and u = X²
+	e2 := config.BinomialExtension{Name: "E2", Degree: 2, NonResidue: []string{"3"}}
+	e4 := config.BinomialExtension{Name: "E4", Base: "E2", Degree: 2, NonResidue: []string{"0", "1"}}
+	if err := generator.GenerateExtensions(koalabear, "github.com/consensys/gnark-crypto/field/koalabear", "../extensions", e2, e4); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated koalabear extensions")
+}
@@ -0,0 +1,126 @@
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// CirclePoint is a point of the circle curve X² + Y² = 1 over mersenne31.
+//
+// The points form a cyclic group of order 2³¹, written additively:
+//
+//	(x₀, y₀) + (x₁, y₁) = (x₀x₁ - y₀y₁, x₀y₁ + x₁y₀)
+//
+// The identity is (1, 0) and the opposite of (x, y) is its conjugate (x, -y).
+type CirclePoint struct {
+	X, Y mersenne31.Element
+}
+
+// circleGenerator generates the circle group
+var circleGenerator = CirclePoint{
+	X: mersenne31.NewElement(2),
+	Y: mersenne31.NewElement(1268011823),
+}
+
+// CircleGenerator returns a generator of the subgroup of order 2^logOrder of the circle group.
+// It panics if logOrder > 31.
+func CircleGenerator(logOrder int) CirclePoint {
+	if logOrder < 0 || logOrder > 31 {
+		panic("fft: the circle group has order 2³¹")
+	}
+	g := circleGenerator
+	for i := logOrder; i < 31; i++ {
+		g.Double(&g)
+	}
+	return g
+}
+
+// SetIdentity sets p to (1, 0) and returns p
+func (p *CirclePoint) SetIdentity() *CirclePoint {
+	p.X.SetOne()
+	p.Y.SetZero()
+	return p
+}
+
+// Set sets p to q and returns p
+func (p *CirclePoint) Set(q *CirclePoint) *CirclePoint {
+	*p = *q
+	return p
+}
+
+// Equal returns true if p and q are the same point
+func (p *CirclePoint) Equal(q *CirclePoint) bool {
+	return p.X.Equal(&q.X) && p.Y.Equal(&q.Y)
+}
+
+// IsOnCircle returns true if X² + Y² = 1
+func (p *CirclePoint) IsOnCircle() bool {
+	var x2, y2 mersenne31.Element
+	x2.Square(&p.X)
+	y2.Square(&p.Y)
+	return x2.Add(&x2, &y2).IsOne()
+}
+
+// Add sets p = a + b and returns p
+func (p *CirclePoint) Add(a, b *CirclePoint) *CirclePoint {
+	var x, y, t mersenne31.Element
+	x.Mul(&a.X, &b.X)
+	t.Mul(&a.Y, &b.Y)
+	x.Sub(&x, &t)
+	y.Mul(&a.X, &b.Y)
+	t.Mul(&a.Y, &b.X)
+	y.Add(&y, &t)
+	p.X = x
+	p.Y = y
+	return p
+}
+
+// Sub sets p = a - b and returns p
+func (p *CirclePoint) Sub(a, b *CirclePoint) *CirclePoint {
+	var nb CirclePoint
+	nb.Neg(b)
+	return p.Add(a, &nb)
+}
+
+// Double sets p = 2·a and returns p
+//
+// The x-coordinate of 2·a is π(a.X) = 2·a.X² - 1.
+func (p *CirclePoint) Double(a *CirclePoint) *CirclePoint {
+	var y mersenne31.Element
+	y.Mul(&a.X, &a.Y).Double(&y)
+	pi(&p.X, &a.X)
+	p.Y = y
+	return p
+}
+
+// Neg sets p to the conjugate of a, (a.X, -a.Y), and returns p
+func (p *CirclePoint) Neg(a *CirclePoint) *CirclePoint {
+	p.X = a.X
+	p.Y.Neg(&a.Y)
+	return p
+}
+
+// ScalarMul sets p = k·a and returns p
+func (p *CirclePoint) ScalarMul(a *CirclePoint, k uint64) *CirclePoint {
+	var res, base CirclePoint
+	res.SetIdentity()
+	base.Set(a)
+	for ; k != 0; k >>= 1 {
+		if k&1 == 1 {
+			res.Add(&res, &base)
+		}
+		base.Double(&base)
+	}
+	return p.Set(&res)
+}
+
+// String returns the coordinates of p
+func (p *CirclePoint) String() string {
+	return "(" + p.X.String() + ", " + p.Y.String() + ")"
+}
+
+// pi sets z = 2x² - 1
+func pi(z, x *mersenne31.Element) {
+	var one mersenne31.Element
+	one.SetOne()
+	z.Square(x).Double(z).Sub(z, &one)
+}
@@ -0,0 +1,9 @@
+// Package fft provides the circle fast Fourier transform over mersenne31.
+//
+// The multiplicative group of mersenne31 has a 2-adicity of 1, so the usual radix-2 transform doesn't apply.
+// Instead, the circle curve X² + Y² = 1 has p + 1 = 2³¹ points, and the transform evaluates polynomials on
+// twin cosets of its subgroups, following Haböck, Levit and Papini ("Circle STARKs", 2024).
+//
+// Polynomials are represented in the basis bⱼ(x, y) = y^j₀ · x^j₁ · π(x)^j₂ · π(π(x))^j₃ ⋯, where jᵢ is the i-th bit of j
+// and π(x) = 2x² - 1 is the x-coordinate of the doubling map.
+package fft
@@ -0,0 +1,127 @@
+package fft
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// Domain is a twin coset of the circle group with a power of 2 cardinality 2ⁿ
+//
+//	D = (Q + Gₙ₋₁) ∪ (-Q + Gₙ₋₁)
+//
+// where Gₙ₋₁ is the subgroup of order 2ⁿ⁻¹. In natural order, the point of index i < 2ⁿ⁻¹ is
+// Q + i·g, where g generates Gₙ₋₁, and the point of index 2ⁿ⁻¹ + i is its conjugate.
+type Domain struct {
+	Cardinality uint64
+	Shift       CirclePoint // Q
+
+	// twiddles[0][t] is the y-coordinate of the point of index reverse(t) of the half coset Q + Gₙ₋₁,
+	// twiddles[k][t] for k ≥ 1 is the x-coordinate of the point of index reverse(t) of the half coset of the domain doubled k-1 times.
+	twiddles    [][]mersenne31.Element
+	twiddlesInv [][]mersenne31.Element
+
+	cardinalityInv mersenne31.Element
+}
+
+// NewDomain returns a twin coset of the circle group with a power of 2 cardinality
+// cardinality >= m
+// shift: when specified, it's the point Q of the twin coset.
+//
+// It panics if the cardinality is larger than 2³⁰ or if the twin coset is degenerate.
+func NewDomain(m uint64, opts ...DomainOption) *Domain {
+	opt := domainOptions(opts...)
+	domain := &Domain{}
+	domain.Cardinality = ecc.NextPowerOfTwo(m)
+	if domain.Cardinality < 2 {
+		domain.Cardinality = 2
+	}
+	n := bits.TrailingZeros64(domain.Cardinality)
+	if n > 30 {
+		panic("fft: circle domains have at most 2³⁰ points")
+	}
+	domain.Shift = CircleGenerator(n + 1)
+	if opt.shift != nil {
+		domain.Shift.Set(opt.shift)
+	}
+	// the two halves of the twin coset are disjoint iff 2Q ∉ Gₙ₋₁
+	var check CirclePoint
+	check.ScalarMul(&domain.Shift, domain.Cardinality)
+	if !domain.Shift.IsOnCircle() || (check.X.IsOne() && check.Y.IsZero()) {
+		panic("fft: degenerate twin coset")
+	}
+	domain.cardinalityInv.SetUint64(domain.Cardinality).Inverse(&domain.cardinalityInv)
+	domain.preComputeTwiddles()
+	return domain
+}
+
+// Point returns the point of index i of the domain, in natural order
+func (d *Domain) Point(i uint64) CirclePoint {
+	half := d.Cardinality / 2
+	var p, g CirclePoint
+	g = CircleGenerator(bits.TrailingZeros64(half))
+	p.ScalarMul(&g, i%half).Add(&p, &d.Shift)
+	if i >= half {
+		p.Neg(&p)
+	}
+	return p
+}
+
+// Twiddles returns the twiddles of the domain, see Domain
+func (d *Domain) Twiddles() [][]mersenne31.Element {
+	return d.twiddles
+}
+
+func (d *Domain) preComputeTwiddles() {
+	n := bits.TrailingZeros64(d.Cardinality)
+	half := d.Cardinality / 2
+
+	// points of the half coset Q + Gₙ₋₁
+	points := make([]CirclePoint, half)
+	g := CircleGenerator(n - 1)
+	points[0] = d.Shift
+	for i := uint64(1); i < half; i++ {
+		points[i].Add(&points[i-1], &g)
+	}
+
+	d.twiddles = make([][]mersenne31.Element, n)
+	d.twiddlesInv = make([][]mersenne31.Element, n)
+
+	d.twiddles[0] = make([]mersenne31.Element, half)
+	for t := range d.twiddles[0] {
+		d.twiddles[0][t] = points[reverse(uint64(t), half)].Y
+	}
+
+	// the x-coordinates of the first half of the half coset, are the ones of the points
+	// of the second half up to the sign; doubling maps them to the half coset of the next domain.
+	xs := make([]mersenne31.Element, half)
+	for i := range xs {
+		xs[i] = points[i].X
+	}
+	for k := 1; k < n; k++ {
+		size := d.Cardinality >> (k + 1)
+		d.twiddles[k] = make([]mersenne31.Element, size)
+		for t := range d.twiddles[k] {
+			d.twiddles[k][t] = xs[reverse(uint64(t), size)]
+		}
+		xs = xs[:size]
+		for i := range xs {
+			pi(&xs[i], &xs[i])
+		}
+	}
+
+	for k := range d.twiddles {
+		for i := range d.twiddles[k] {
+			if d.twiddles[k][i].IsZero() {
+				panic("fft: degenerate twin coset")
+			}
+		}
+		d.twiddlesInv[k] = mersenne31.BatchInvert(d.twiddles[k])
+	}
+}
+
+// reverse returns the bit reversal of i < size, where size is a power of 2
+func reverse(i, size uint64) uint64 {
+	return bits.Reverse64(i) >> (64 - bits.TrailingZeros64(size))
+}
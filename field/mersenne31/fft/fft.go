@@ -0,0 +1,96 @@
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// parallelize threshold for a single layer of butterflies
+const butterflyThreshold = 1 << 10
+
+// FFT evaluates the polynomial whose coefficients in the circle basis bⱼ are a, on the points of the domain,
+// and stores the result in a, in bit-reversed order: a[reverse(i)] ← P(domain.Point(i)).
+//
+// len(a) must be equal to the cardinality of the domain; BitReverse returns the evaluations to natural order.
+func (domain *Domain) FFT(a []mersenne31.Element, opts ...Option) {
+	if uint64(len(a)) != domain.Cardinality {
+		panic("fft: len(a) must be equal to the domain cardinality")
+	}
+	opt := fftOptions(opts...)
+	for k := len(domain.twiddles) - 1; k >= 0; k-- {
+		layer(a, domain.twiddles[k], k, opt.nbTasks, false)
+	}
+}
+
+// FFTInverse computes the coefficients in the circle basis bⱼ of the polynomial of degree < len(a)
+// whose evaluations on the domain, in bit-reversed order, are a, and stores them in a.
+//
+// len(a) must be equal to the cardinality of the domain.
+func (domain *Domain) FFTInverse(a []mersenne31.Element, opts ...Option) {
+	if uint64(len(a)) != domain.Cardinality {
+		panic("fft: len(a) must be equal to the domain cardinality")
+	}
+	opt := fftOptions(opts...)
+	for k := 0; k < len(domain.twiddlesInv); k++ {
+		layer(a, domain.twiddlesInv[k], k, opt.nbTasks, true)
+	}
+	nbTasks := opt.nbTasks
+	if len(a) < butterflyThreshold {
+		nbTasks = 1
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].Mul(&a[i], &domain.cardinalityInv)
+		}
+	}, nbTasks)
+}
+
+// LowDegreeExtension returns the evaluations on target of the polynomial whose evaluations on the domain are given,
+// both in bit-reversed order.
+//
+// len(evaluations) must be equal to the cardinality of the domain, and
+// target can be any twin coset whose cardinality is at least the one of the domain, in particular
+// the canonic coset of a larger size, or a twin coset disjoint from the domain.
+func (domain *Domain) LowDegreeExtension(evaluations []mersenne31.Element, target *Domain, opts ...Option) []mersenne31.Element {
+	if uint64(len(evaluations)) != domain.Cardinality {
+		panic("fft: len(evaluations) must be equal to the domain cardinality")
+	}
+	if target.Cardinality < domain.Cardinality {
+		panic("fft: the target domain must be at least as large as the domain")
+	}
+	res := make([]mersenne31.Element, target.Cardinality)
+	copy(res, evaluations)
+	domain.FFTInverse(res[:domain.Cardinality], opts...)
+	target.FFT(res, opts...)
+	return res
+}
+
+// layer applies the butterflies of the k-th layer of the transform, on pairs (a[j], a[j + 2ᵏ]) in blocks
+// of size 2ᵏ⁺¹, where t is the twiddle of the block:
+//
+//	forward: (a[j], a[j + 2ᵏ]) ← (a[j] + t·a[j + 2ᵏ], a[j] - t·a[j + 2ᵏ])
+//	inverse: (a[j], a[j + 2ᵏ]) ← (a[j] + a[j + 2ᵏ], (a[j] - a[j + 2ᵏ])·t⁻¹)
+func layer(a []mersenne31.Element, twiddles []mersenne31.Element, k, nbTasks int, inverse bool) {
+	half := len(a) / 2
+	if half < butterflyThreshold {
+		nbTasks = 1
+	}
+	mask := 1<<k - 1
+	parallel.Execute(half, func(start, end int) {
+		var tmp mersenne31.Element
+		for p := start; p < end; p++ {
+			t := p >> k
+			i := t<<(k+1) | p&mask
+			j := i | 1<<k
+			if inverse {
+				tmp.Sub(&a[i], &a[j])
+				a[i].Add(&a[i], &a[j])
+				a[j].Mul(&tmp, &twiddles[t])
+			} else {
+				tmp.Mul(&a[j], &twiddles[t])
+				a[j].Sub(&a[i], &tmp)
+				a[i].Add(&a[i], &tmp)
+			}
+		}
+	}, nbTasks)
+}
@@ -0,0 +1,174 @@
+package fft
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// evaluate returns Σⱼ coefficients[j]·bⱼ(p)
+func evaluate(coefficients []mersenne31.Element, p CirclePoint) mersenne31.Element {
+	var res, bj, tmp mersenne31.Element
+	for j := range coefficients {
+		bj.SetOne()
+		x := p.X
+		if j&1 == 1 {
+			bj.Mul(&bj, &p.Y)
+		}
+		for i := 1; j>>i != 0; i++ {
+			if (j>>i)&1 == 1 {
+				bj.Mul(&bj, &x)
+			}
+			pi(&x, &x)
+		}
+		tmp.Mul(&coefficients[j], &bj)
+		res.Add(&res, &tmp)
+	}
+	return res
+}
+
+func randomVector(size uint64) []mersenne31.Element {
+	v := make([]mersenne31.Element, size)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
+
+func TestCirclePoint(t *testing.T) {
+	g := CircleGenerator(31)
+	if !g.IsOnCircle() {
+		t.Fatal("generator is not on the circle")
+	}
+	var p, q CirclePoint
+	p.ScalarMul(&g, 1<<30)
+	if p.X.IsOne() || !p.Y.IsZero() {
+		t.Fatal("generator doesn't have order 2³¹")
+	}
+	p.Double(&p)
+	if !p.X.IsOne() || !p.Y.IsZero() {
+		t.Fatal("generator doesn't have order 2³¹")
+	}
+
+	p.ScalarMul(&g, 12345)
+	q.ScalarMul(&g, 678)
+	p.Add(&p, &q).Sub(&p, &q)
+	q.ScalarMul(&g, 12345)
+	if !p.Equal(&q) || !p.IsOnCircle() {
+		t.Fatal("a + b - b != a")
+	}
+}
+
+func TestFFT(t *testing.T) {
+	var shift CirclePoint
+	shift.ScalarMul(&circleGenerator, 7)
+
+	for _, opts := range [][]DomainOption{nil, {WithShift(shift)}} {
+		for _, size := range []uint64{2, 4, 8, 64} {
+			domain := NewDomain(size, opts...)
+			coefficients := randomVector(size)
+			a := make([]mersenne31.Element, size)
+			copy(a, coefficients)
+			domain.FFT(a)
+
+			for i := uint64(0); i < size; i++ {
+				p := domain.Point(i)
+				if !p.IsOnCircle() {
+					t.Fatal("domain point is not on the circle")
+				}
+				expected := evaluate(coefficients, p)
+				if !a[reverse(i, size)].Equal(&expected) {
+					t.Fatalf("size %d: wrong evaluation at index %d", size, i)
+				}
+			}
+
+			domain.FFTInverse(a)
+			for i := range a {
+				if !a[i].Equal(&coefficients[i]) {
+					t.Fatalf("size %d: FFTInverse(FFT(a)) != a", size)
+				}
+			}
+		}
+	}
+}
+
+func TestFFTInverse(t *testing.T) {
+	const size = 1 << 12
+	domain := NewDomain(size)
+	a := randomVector(size)
+	b := make([]mersenne31.Element, size)
+	copy(b, a)
+
+	domain.FFTInverse(b)
+	domain.FFT(b, WithNbTasks(3))
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			t.Fatal("FFT(FFTInverse(a)) != a")
+		}
+	}
+
+	// natural order
+	BitReverse(b)
+	for i := uint64(0); i < size; i += 511 {
+		if !b[i].Equal(&a[reverse(i, size)]) {
+			t.Fatal("BitReverse should return the evaluations to natural order")
+		}
+	}
+}
+
+func TestLowDegreeExtension(t *testing.T) {
+	const size = 16
+	domain := NewDomain(size)
+	coefficients := randomVector(size)
+	evaluations := make([]mersenne31.Element, size)
+	copy(evaluations, coefficients)
+	domain.FFT(evaluations)
+
+	var shift CirclePoint
+	shift.ScalarMul(&circleGenerator, 3)
+	targets := []*Domain{NewDomain(4 * size), NewDomain(size, WithShift(shift))}
+	for _, target := range targets {
+		lde := domain.LowDegreeExtension(evaluations, target)
+		for i := uint64(0); i < target.Cardinality; i++ {
+			expected := evaluate(coefficients, target.Point(i))
+			if !lde[reverse(i, target.Cardinality)].Equal(&expected) {
+				t.Fatal("wrong low degree extension")
+			}
+		}
+	}
+}
+
+func TestLowDegreeExtensionSize(t *testing.T) {
+	const size = 16
+	domain := NewDomain(size)
+	target := NewDomain(4 * size)
+	for _, n := range []uint64{size - 1, size + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("%d evaluations on a domain of size %d should panic", n, size)
+				}
+			}()
+			domain.LowDegreeExtension(randomVector(n), target)
+		}()
+	}
+}
+
+func TestDegenerateDomain(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("a twin coset with 2Q ∈ Gₙ₋₁ should panic")
+		}
+	}()
+	NewDomain(8, WithShift(CircleGenerator(3)))
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const logSize = 20
+	domain := NewDomain(1 << logSize)
+	a := randomVector(1 << logSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		domain.FFT(a)
+	}
+}
@@ -0,0 +1,65 @@
+package fft
+
+import (
+	"runtime"
+)
+
+// Option defines option for altering the behavior of FFT methods.
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type Option func(*fftConfig)
+
+type fftConfig struct {
+	nbTasks int
+}
+
+// WithNbTasks sets the max number of task (go routine) to spawn. Must be between 1 and 512.
+func WithNbTasks(nbTasks int) Option {
+	if nbTasks < 1 {
+		nbTasks = 1
+	} else if nbTasks > 512 {
+		nbTasks = 512
+	}
+	return func(opt *fftConfig) {
+		opt.nbTasks = nbTasks
+	}
+}
+
+// default options
+func fftOptions(opts ...Option) fftConfig {
+	// apply options
+	opt := fftConfig{
+		nbTasks: runtime.NumCPU(),
+	}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
+
+// DomainOption defines option for altering the definition of the circle domain
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type DomainOption func(*domainConfig)
+
+type domainConfig struct {
+	shift *CirclePoint
+}
+
+// WithShift sets the point Q of the twin coset (Q + Gₙ₋₁) ∪ (-Q + Gₙ₋₁).
+// Default is a generator of Gₙ₊₁, for which the domain is the canonic coset Q + Gₙ.
+func WithShift(shift CirclePoint) DomainOption {
+	return func(opt *domainConfig) {
+		opt.shift = new(CirclePoint).Set(&shift)
+	}
+}
+
+// default options
+func domainOptions(opts ...DomainOption) domainConfig {
+	// apply options
+	opt := domainConfig{}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
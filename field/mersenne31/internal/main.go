@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x7fffffff" // 2³¹ - 1
+	mersenne31, err := config.NewFieldConfig("mersenne31", "Element", modulus, true)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(mersenne31, "../"); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated mersenne31 field")
+
+	// E2 is the complex extension CM31 = M31[u]/(u² + 1) and E4 = E2[v]/(v² - (2 + u)) is QM31
+	e2 := config.BinomialExtension{Name: "E2", Degree: 2, NonResidue: []string{"-1"}}
+	e4 := config.BinomialExtension{Name: "E4", Base: "E2", Degree: 2, NonResidue: []string{"2", "1"}}
+	if err := generator.GenerateExtensions(mersenne31, "github.com/consensys/gnark-crypto/field/mersenne31", "../extensions", e2, e4); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated mersenne31 extensions")
+}
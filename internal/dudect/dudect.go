@@ -0,0 +1,131 @@
+// Package dudect is a small timing leakage detector, after "dude, is my code constant time?"
+// (Reparaz, Balasch, Verbauwhede, https://eprint.iacr.org/2016/1123).
+//
+// The function under test runs on inputs of two classes (typically a fixed input and random inputs),
+// in a random order, and Welch's t-test is applied on the two distributions of running times,
+// optionally cropped at several percentiles to remove the measurement noise. A large |t| means
+// the running time depends on the input class.
+//
+// The measurements are noisy and machine dependent; this is meant to be run locally
+// (see the dudect build tag in the tests), not in CI.
+package dudect
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"time"
+)
+
+// Threshold is the |t| above which a leak is reported, as in the reference implementation
+const Threshold = 4.5
+
+// Result of a test
+type Result struct {
+	T            float64 // largest |t| among the cropped and non cropped tests
+	Measurements int     // number of measurements per test
+}
+
+// Leaks returns true if the running time depends on the input class
+func (r Result) Leaks() bool {
+	return r.T > Threshold
+}
+
+// Test measures n executions of run(i), where the input i was set up beforehand by
+// prepare(i, class), with class 0 or 1 chosen at random.
+//
+// Each measurement times repeat consecutive calls to run(i), to reduce the relative
+// cost of the clock.
+func Test(n, repeat int, prepare func(i, class int), run func(i int)) Result {
+	if repeat < 1 {
+		repeat = 1
+	}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano())) //#nosec G404 weak rng is fine here
+
+	classes := make([]int, n)
+	for i := range classes {
+		classes[i] = rng.Intn(2)
+		prepare(i, classes[i])
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	runtime.GC()
+
+	durations := make([]float64, n)
+	for i := range durations {
+		start := time.Now()
+		for j := 0; j < repeat; j++ {
+			run(i)
+		}
+		durations[i] = float64(time.Since(start))
+	}
+
+	return Result{
+		T:            tStatistic(durations, classes),
+		Measurements: n,
+	}
+}
+
+// tStatistic returns the largest |t| of Welch's t-test on the measurements, without
+// cropping and cropped at a few percentiles of the distribution
+func tStatistic(durations []float64, classes []int) float64 {
+	sorted := make([]float64, len(durations))
+	copy(sorted, durations)
+	sort.Float64s(sorted)
+
+	// the reference implementation uses 1 - 0.5^(10(i+1)/100) for 100 percentiles
+	const nbCrops = 10
+	thresholds := []float64{math.Inf(1)}
+	for i := 0; i < nbCrops; i++ {
+		p := 1 - math.Pow(0.5, 10*float64(i+1)/nbCrops)
+		thresholds = append(thresholds, sorted[int(p*float64(len(sorted)-1))])
+	}
+
+	var tMax float64
+	for _, threshold := range thresholds {
+		var w [2]welford
+		for i, d := range durations {
+			if d <= threshold {
+				w[classes[i]].push(d)
+			}
+		}
+		if t := math.Abs(welch(&w[0], &w[1])); t > tMax {
+			tMax = t
+		}
+	}
+	return tMax
+}
+
+// welford is an online mean and variance accumulator
+type welford struct {
+	n        float64
+	mean, m2 float64
+}
+
+func (w *welford) push(x float64) {
+	w.n++
+	delta := x - w.mean
+	w.mean += delta / w.n
+	w.m2 += delta * (x - w.mean)
+}
+
+func (w *welford) variance() float64 {
+	if w.n < 2 {
+		return 0
+	}
+	return w.m2 / (w.n - 1)
+}
+
+// welch returns Welch's t statistic of the two samples
+func welch(a, b *welford) float64 {
+	if a.n < 2 || b.n < 2 {
+		return 0
+	}
+	den := math.Sqrt(a.variance()/a.n + b.variance()/b.n)
+	if den == 0 {
+		return 0
+	}
+	return (a.mean - b.mean) / den
+}
@@ -0,0 +1,31 @@
+package dudect
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestWelch(t *testing.T) {
+	rng := rand.New(rand.NewSource(42)) //#nosec G404 weak rng is fine here
+
+	const n = 10000
+	durations := make([]float64, n)
+	classes := make([]int, n)
+
+	// same distribution
+	for i := range durations {
+		classes[i] = rng.Intn(2)
+		durations[i] = 1000 + rng.NormFloat64()*10
+	}
+	if tt := tStatistic(durations, classes); tt > Threshold {
+		t.Fatalf("same distributions, got |t| = %f", tt)
+	}
+
+	// class 1 is slower
+	for i := range durations {
+		durations[i] += float64(classes[i]) * 5
+	}
+	if tt := tStatistic(durations, classes); tt <= Threshold {
+		t.Fatalf("different distributions, got |t| = %f", tt)
+	}
+}
@@ -0,0 +1,111 @@
+//go:build dudect
+
+package dudect
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"unsafe"
+
+	bn254fr "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	secp256k1fp "github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/koalabear"
+)
+
+// These tests measure the constant-time field primitives; run them locally with
+//
+//	go test -tags dudect -v ./internal/dudect/
+//
+// For reference, the variable-time counterparts are measured too and their t statistic is logged.
+
+const (
+	nbMeasurements = 200000
+	nbRepeat       = 1
+)
+
+// fieldOps is the subset of the field element API under test
+type fieldOps[E any] interface {
+	*E
+	SetOne() *E
+	SetRandom() (*E, error)
+	Square(x *E) *E
+	Inverse(x *E) *E
+	InverseCT(x *E) *E
+	Exp(x E, k *big.Int) *E
+	ExpCT(x E, k *big.Int) *E
+	Sqrt(x *E) *E
+	SqrtCT(x *E) *E
+	Legendre() int
+	LegendreCT() int
+}
+
+func TestFieldsConstantTime(t *testing.T) {
+	t.Run("bn254/fr", testField[bn254fr.Element])
+	t.Run("secp256k1/fp", testField[secp256k1fp.Element])
+	t.Run("goldilocks", testField[goldilocks.Element])
+	t.Run("koalabear", testField[koalabear.Element])
+}
+
+func testField[E any, PE fieldOps[E]](t *testing.T) {
+	inputs := make([]E, nbMeasurements)
+	exponents := make([]big.Int, nbMeasurements)
+	var fixed E
+	PE(&fixed).SetOne()
+
+	// class 0: fixed input 1, class 1: random input
+	prepareElement := func(i, class int) {
+		if class == 0 {
+			inputs[i] = fixed
+		} else {
+			PE(&inputs[i]).SetRandom()
+		}
+	}
+	// class 0 and 1: squares, such that Sqrt doesn't branch on the existence of a root
+	prepareSquare := func(i, class int) {
+		prepareElement(i, class)
+		PE(&inputs[i]).Square(&inputs[i])
+	}
+	// class 0: fixed exponent, class 1: random exponent; both have the same number of words
+	rng := rand.New(rand.NewSource(42)) //#nosec G404 weak rng is fine here
+	fixedExponent := make([]byte, unsafe.Sizeof(fixed))
+	rng.Read(fixedExponent)
+	fixedExponent[0] |= 0x80
+	prepareExponent := func(i, class int) {
+		PE(&inputs[i]).SetRandom()
+		e := make([]byte, len(fixedExponent))
+		if class == 0 {
+			copy(e, fixedExponent)
+		} else {
+			rng.Read(e)
+			e[0] |= 0x80
+		}
+		exponents[i].SetBytes(e)
+	}
+
+	var res E
+	tests := []struct {
+		name    string
+		ct      bool
+		prepare func(i, class int)
+		run     func(i int)
+	}{
+		{"InverseCT", true, prepareElement, func(i int) { PE(&res).InverseCT(&inputs[i]) }},
+		{"Inverse", false, prepareElement, func(i int) { PE(&res).Inverse(&inputs[i]) }},
+		{"ExpCT", true, prepareExponent, func(i int) { PE(&res).ExpCT(inputs[i], &exponents[i]) }},
+		{"Exp", false, prepareExponent, func(i int) { PE(&res).Exp(inputs[i], &exponents[i]) }},
+		{"SqrtCT", true, prepareSquare, func(i int) { PE(&res).SqrtCT(&inputs[i]) }},
+		{"Sqrt", false, prepareSquare, func(i int) { PE(&res).Sqrt(&inputs[i]) }},
+		{"LegendreCT", true, prepareElement, func(i int) { PE(&inputs[i]).LegendreCT() }},
+		{"Legendre", false, prepareElement, func(i int) { PE(&inputs[i]).Legendre() }},
+	}
+
+	for _, tt := range tests {
+		r := Test(nbMeasurements, nbRepeat, tt.prepare, tt.run)
+		t.Logf("%-10s |t| = %6.2f (%d measurements)", tt.name, r.T, r.Measurements)
+		if tt.ct && r.Leaks() {
+			t.Errorf("%s: timing leak detected, |t| = %.2f > %.1f", tt.name, r.T, Threshold)
+		}
+	}
+}
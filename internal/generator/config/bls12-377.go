@@ -4,8 +4,14 @@ var BLS12_377 = Curve{
 	Name:         "bls12-377",
 	CurvePackage: "bls12377",
 	EnumID:       "BLS12_377",
+	Family:       "bls12",
 	FrModulus:    "8444461749428370424248824938781546531375899335154063827935233455917409239041",
 	FpModulus:    "258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177",
+	FFT: &FFT{
+		GeneratorFullMultiplicativeGroup: 22,
+		GeneratorMaxTwoAdicSubgroup:      "8065159656716812877374967518403273466521432693661810619979959746626482506078",
+		LogTwoOrderMaxTwoAdicSubgroup:    47,
+	},
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
@@ -4,8 +4,15 @@ var BLS12_381 = Curve{
 	Name:         "bls12-381",
 	CurvePackage: "bls12381",
 	EnumID:       "BLS12_381",
+	Family:       "bls12",
+	SeedNeg:      true,
 	FrModulus:    "52435875175126190479447740508185965837690552500527637822603658699938581184513",
 	FpModulus:    "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
+	FFT: &FFT{
+		GeneratorFullMultiplicativeGroup: 7,
+		GeneratorMaxTwoAdicSubgroup:      "10238227357739495823651030575849232062558860180284477541189508159991286009131",
+		LogTwoOrderMaxTwoAdicSubgroup:    32,
+	},
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
@@ -1,7 +1,9 @@
 package config
 
 import (
+	"fmt"
 	"math/big"
+	"path"
 
 	"github.com/consensys/gnark-crypto/field/generator/config"
 )
@@ -19,12 +21,27 @@ type Curve struct {
 	Fr           *config.FieldConfig
 	FpUnusedBits int
 
+	// FpSpecialReduction is set if the multiplication in Fp uses the reduction dedicated to
+	// the special form of the modulus (see field/generator/config.SpecialForm)
+	FpSpecialReduction bool
+
 	FpInfo, FrInfo Field
 	G1             Point
 	G2             Point
 
 	HashE1 HashSuite
 	HashE2 HashSuite
+
+	FFT *FFT // set if the fft package is generated on fr
+
+	// FpPackagePath and FrPackagePath are set if the curve reuses the fields of another curve
+	// (e.g. a cycle partner) instead of generating its own fp and fr packages
+	FpPackagePath, FrPackagePath string
+
+	// Family is set for the pairing-friendly curves whose G1 and G2 arithmetic only depends on the
+	// seed x₀ of their family ("bls12"), and SeedNeg if x₀ < 0 (xGen is then -x₀)
+	Family  string
+	SeedNeg bool
 }
 
 type TwistedEdwardsCurve struct {
@@ -50,6 +67,42 @@ func (c Curve) Equal(other Curve) bool {
 	return c.Name == other.Name
 }
 
+// HasG2 returns true if the curve has a second group (i.e. is pairing-friendly)
+func (c Curve) HasG2() bool {
+	return c.G2.PointName != ""
+}
+
+// FrFullLastWord returns true if the elements of fr use all the bits of their last 64-bit word
+// (e.g. secp256k1 or p256); the multi-exponentiation digits must then fit on 15 bits
+func (c Curve) FrFullLastWord() bool {
+	return c.Fr.NbBits%64 == 0
+}
+
+// OwnFields returns true if the fp and fr packages are generated for this curve
+func (c Curve) OwnFields() bool {
+	return c.FpPackagePath == "" && c.FrPackagePath == ""
+}
+
+// FpImport returns the import statement of the base field package, named fp
+func (c Curve) FpImport() string {
+	return fieldImport("fp", c.FpPackagePath, c.Name)
+}
+
+// FrImport returns the import statement of the scalar field package, named fr
+func (c Curve) FrImport() string {
+	return fieldImport("fr", c.FrPackagePath, c.Name)
+}
+
+func fieldImport(name, packagePath, curveName string) string {
+	if packagePath == "" {
+		return fmt.Sprintf("%q", "github.com/consensys/gnark-crypto/ecc/"+curveName+"/"+name)
+	}
+	if path.Base(packagePath) == name {
+		return fmt.Sprintf("%q", packagePath)
+	}
+	return fmt.Sprintf("%s %q", name, packagePath)
+}
+
 type Point struct {
 	CoordType        string
 	CoordExtDegree   uint8 // value n, such that q = pⁿ
@@ -59,7 +112,7 @@ type Point struct {
 	CofactorCleaning bool     // flag telling if the Cofactor cleaning is available
 	CRange           []int    // multiexp bucket method: generate inner methods (with const arrays) for each c
 	Projective       bool     // generate projective coordinates
-	A                []string //A linear coefficient in Weierstrass form
+	A                []string //A linear coefficient in Weierstrass form, if non-zero (the arithmetic then uses aCurveCoeff)
 	B                []string //B constant term in Weierstrass form
 }
 
@@ -0,0 +1,172 @@
+package config
+
+import (
+	"errors"
+	"math/big"
+)
+
+// FFT holds the field parameters needed to generate an fft package
+type FFT struct {
+	GeneratorFullMultiplicativeGroup uint64 // generator of the multiplicative group of the field
+	GeneratorMaxTwoAdicSubgroup      string // generator of the largest 2-adic subgroup, in base 10
+	LogTwoOrderMaxTwoAdicSubgroup    uint64 // log2 of the order of the largest 2-adic subgroup
+}
+
+// NewFFT computes the fft parameters of the prime field of modulus q.
+//
+// If generator is 0, the smallest generator of the multiplicative group is used; finding it requires
+// the factorization of q-1, by trial division and Pollard's rho, which fails if q-1 has two large prime factors.
+// Otherwise, generator must generate the multiplicative group; this can't be checked without the factorization
+// of q-1, and NewFFT only checks that it is a quadratic non-residue.
+//
+// The generator of the largest 2-adic subgroup is then g^((q-1)/2ˢ), where 2ˢ is its order.
+func NewFFT(q *big.Int, generator uint64) (*FFT, error) {
+	var qMinusOne, g, e, t big.Int
+	qMinusOne.Sub(q, big.NewInt(1))
+	one := big.NewInt(1)
+
+	if generator != 0 {
+		g.SetUint64(generator)
+		e.Rsh(&qMinusOne, 1)
+		if g.Cmp(q) >= 0 || t.Exp(&g, &e, q).Cmp(one) == 0 {
+			return nil, errors.New("the generator of the multiplicative group must be a quadratic non-residue")
+		}
+	} else {
+		factors, err := primeFactors(&qMinusOne)
+		if err != nil {
+			return nil, err
+		}
+		for g.SetUint64(2); ; g.Add(&g, one) {
+			if g.Cmp(q) >= 0 {
+				return nil, errors.New("no generator of the multiplicative group")
+			}
+			isGenerator := true
+			for _, f := range factors {
+				e.Div(&qMinusOne, f)
+				if t.Exp(&g, &e, q).Cmp(one) == 0 {
+					isGenerator = false
+					break
+				}
+			}
+			if isGenerator {
+				break
+			}
+		}
+		if !g.IsUint64() {
+			return nil, errors.New("no small generator of the multiplicative group")
+		}
+	}
+
+	s := qMinusOne.TrailingZeroBits()
+	e.Rsh(&qMinusOne, s)
+	t.Exp(&g, &e, q)
+
+	return &FFT{
+		GeneratorFullMultiplicativeGroup: g.Uint64(),
+		GeneratorMaxTwoAdicSubgroup:      t.String(),
+		LogTwoOrderMaxTwoAdicSubgroup:    uint64(s),
+	}, nil
+}
+
+// primeFactors returns the distinct prime factors of n > 1
+func primeFactors(n *big.Int) ([]*big.Int, error) {
+	var factors []*big.Int
+	var r, m big.Int
+	r.Set(n)
+
+	// trial division by small odd numbers, and 2
+	const bound = 1 << 16
+	for p := int64(2); p < bound && r.Cmp(big.NewInt(1)) > 0; p++ {
+		if p > 2 && p%2 == 0 {
+			continue
+		}
+		bp := big.NewInt(p)
+		if m.Mod(&r, bp).Sign() != 0 {
+			continue
+		}
+		factors = append(factors, bp)
+		for m.Mod(&r, bp).Sign() == 0 {
+			r.Div(&r, bp)
+		}
+	}
+
+	// the remaining factors are larger than bound
+	toFactor := []*big.Int{&r}
+	for len(toFactor) > 0 {
+		c := toFactor[len(toFactor)-1]
+		toFactor = toFactor[:len(toFactor)-1]
+		if c.Cmp(big.NewInt(1)) == 0 {
+			continue
+		}
+		if c.ProbablyPrime(20) {
+			factors = appendDistinct(factors, c)
+			continue
+		}
+		d := pollardRho(c)
+		if d == nil {
+			return nil, errors.New("failed to factor " + c.String() + "; the generator of the multiplicative group must be provided")
+		}
+		toFactor = append(toFactor, d, new(big.Int).Div(c, d))
+	}
+	return factors, nil
+}
+
+func appendDistinct(factors []*big.Int, f *big.Int) []*big.Int {
+	for _, g := range factors {
+		if g.Cmp(f) == 0 {
+			return factors
+		}
+	}
+	return append(factors, f)
+}
+
+// pollardRho returns a non-trivial factor of the odd composite n, or nil if none was found
+// within the iteration budget (Brent's variant).
+func pollardRho(n *big.Int) *big.Int {
+	const maxIterations = 1 << 24
+	var x, y, ys, q, d, tmp big.Int
+	one := big.NewInt(1)
+	f := func(z, c *big.Int) {
+		z.Mul(z, z).Add(z, c).Mod(z, n)
+	}
+
+	for c := int64(1); c < 3; c++ {
+		bc := big.NewInt(c)
+		y.SetUint64(2)
+		q.SetUint64(1)
+		d.SetUint64(1)
+		const batch = 128
+		iterations := 0
+		for r := 1; d.Cmp(one) == 0 && iterations < maxIterations; r *= 2 {
+			x.Set(&y)
+			for i := 0; i < r; i++ {
+				f(&y, bc)
+			}
+			for k := 0; k < r && d.Cmp(one) == 0; k += batch {
+				ys.Set(&y)
+				for i := 0; i < batch && i < r-k; i++ {
+					f(&y, bc)
+					tmp.Sub(&x, &y).Abs(&tmp)
+					q.Mul(&q, &tmp).Mod(&q, n)
+				}
+				d.GCD(nil, nil, &q, n)
+				iterations += batch
+			}
+		}
+		if d.Cmp(n) == 0 {
+			// backtrack
+			for {
+				f(&ys, bc)
+				tmp.Sub(&x, &ys).Abs(&tmp)
+				d.GCD(nil, nil, &tmp, n)
+				if d.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if d.Cmp(one) != 0 && d.Cmp(n) != 0 {
+			return new(big.Int).Set(&d)
+		}
+	}
+	return nil
+}
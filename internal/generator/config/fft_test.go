@@ -0,0 +1,44 @@
+package config
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNewFFT(t *testing.T) {
+	for _, c := range []Curve{BLS12_381} {
+		fft, err := NewFFT(c.FrInfo.Modulus(), 0)
+		if err != nil {
+			t.Fatal(c.Name, err)
+		}
+		if *fft != *c.FFT {
+			t.Fatal(c.Name, "wrong parameters", fft, c.FFT)
+		}
+	}
+
+	goldilocks := new(big.Int).SetUint64(0xffffffff00000001)
+	fft, err := NewFFT(goldilocks, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := FFT{
+		GeneratorFullMultiplicativeGroup: 7,
+		GeneratorMaxTwoAdicSubgroup:      "1753635133440165772",
+		LogTwoOrderMaxTwoAdicSubgroup:    32,
+	}
+	if *fft != expected {
+		t.Fatal("wrong goldilocks parameters", fft)
+	}
+
+	// q-1 has two ~90-bit prime factors: the generator must be provided
+	q := BLS12_377.FrInfo.Modulus()
+	if _, err = NewFFT(q, 4); err == nil {
+		t.Fatal("4 is a square and can't generate the multiplicative group")
+	}
+	if fft, err = NewFFT(q, BLS12_377.FFT.GeneratorFullMultiplicativeGroup); err != nil {
+		t.Fatal(err)
+	}
+	if *fft != *BLS12_377.FFT {
+		t.Fatal("wrong bls12-377 parameters", fft, BLS12_377.FFT)
+	}
+}
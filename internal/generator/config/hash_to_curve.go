@@ -54,6 +54,18 @@ type HashSuiteSvdw struct {
 	c4 []string
 }
 
+// NewHashSuiteSvdw returns the Shallue-van de Woestijne suite of parameter z and precomputed
+// constants c1, c2, c3, c4 (as defined in RFC 9380, section 6.6.1)
+func NewHashSuiteSvdw(z, c1, c2, c3, c4 []string) *HashSuiteSvdw {
+	return &HashSuiteSvdw{
+		z:  z,
+		c1: c1,
+		c2: c2,
+		c3: c3,
+		c4: c4,
+	}
+}
+
 func (parameters *HashSuiteSvdw) GetInfo(baseField *field.FieldConfig, g *Point, name string) HashSuiteInfo {
 	f := field.NewTower(baseField, g.CoordExtDegree, g.CoordExtRoot)
 	c := []field.Element{
@@ -193,6 +205,7 @@ type HashSuiteInfo struct {
 	Field             *field.Extension
 	FieldCoordName    string
 	Name              string
+	FpImport          string // import statement of the base field package
 	FieldSizeMod256   uint8
 	PrecomputedParams []field.Element // PrecomputedParams[0][n] correspond to integer cₙ₋₁ in std doc
 	// PrecomputedParams[n≥1] correspond to field element c_( len(PrecomputedParams[0]) + n - 1 ) in std doc
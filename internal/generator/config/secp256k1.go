@@ -6,6 +6,8 @@ var SECP256K1 = Curve{
 	EnumID:       "SECP256k1",
 	FrModulus:    "115792089237316195423570985008687907852837564279074904382605163141518161494337",
 	FpModulus:    "115792089237316195423570985008687907853269984665640564039457584007908834671663",
+	// p = 2²⁵⁶ - 2³² - 977
+	FpSpecialReduction: true,
 	G1: Point{
 		CoordType:        "fp.Element",
 		CoordExtDegree:   1,
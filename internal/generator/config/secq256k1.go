@@ -0,0 +1,32 @@
+package config
+
+// SECQ256K1 is the cycle partner of SECP256K1: its base field is the scalar field of secp256k1
+// and its scalar field is the base field of secp256k1, so it reuses the fr and fp packages of secp256k1.
+var SECQ256K1 = Curve{
+	Name:          "secq256k1",
+	CurvePackage:  "secq256k1",
+	EnumID:        "SECQ256K1",
+	FrModulus:     "115792089237316195423570985008687907853269984665640564039457584007908834671663",
+	FpModulus:     "115792089237316195423570985008687907852837564279074904382605163141518161494337",
+	FpPackagePath: "github.com/consensys/gnark-crypto/ecc/secp256k1/fr",
+	FrPackagePath: "github.com/consensys/gnark-crypto/ecc/secp256k1/fp",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              true,
+		CofactorCleaning: false,
+		CRange:           []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	},
+	HashE1: &HashSuiteSvdw{
+		z:  []string{"1"},
+		c1: []string{"8"},
+		c2: []string{"57896044618658097711785492504343953926418782139537452191302581570759080747168"},
+		c3: []string{"111622419961974647277388942821201583511323228018882454100460498314852037701986"},
+		c4: []string{"77194726158210796949047323339125271901891709519383269588403442094345440996214"},
+	},
+}
+
+func init() {
+	addCurve(&SECQ256K1)
+}
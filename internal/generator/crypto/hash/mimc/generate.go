@@ -1,6 +1,11 @@
 package mimc
 
 import (
+	"embed"
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
 	"os"
 	"path/filepath"
 
@@ -8,7 +13,82 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+// Templates holds the templates of the package, for the generators that run outside of internal/generator
+//
+//go:embed template
+var Templates embed.FS
+
+// Config describes the field over which the mimc package is generated
+type Config struct {
+	Package          string // name of the generated package
+	FF               string // name of the field package
+	FieldPackagePath string // import path of the field package
+	NbRounds         int    // number of rounds of the permutation
+	Exponent         uint64 // the round function is x ↦ x^Exponent
+	ExponentBits     []bool // bits of Exponent after the leading one, most significant first
+}
+
+// curveParams lists the number of rounds and the exponent of the mimc permutation on the scalar field of each curve
+var curveParams = map[string]struct {
+	nbRounds int
+	exponent uint64
+}{
+	"bn254":     {110, 5},
+	"bls12-381": {111, 5},
+	"bls12-377": {62, 17},
+	"bls12-378": {109, 5},
+	"bls24-315": {109, 5},
+	"bls24-317": {91, 7},
+	"bw6-633":   {136, 5},
+	"bw6-761":   {163, 5},
+	"bw6-756":   {163, 5},
+}
+
+// NewConfig returns the mimc Config of the scalar field of the curve
+func NewConfig(conf config.Curve) Config {
+	params, ok := curveParams[conf.Name]
+	if !ok {
+		panic("no mimc parameters for " + conf.Name)
+	}
+	return newConfig("fr", "github.com/consensys/gnark-crypto/ecc/"+conf.Name+"/fr", params.nbRounds, params.exponent)
+}
+
+// NewFieldConfig returns the mimc Config of the prime field of modulus q, whose package is ff.
+//
+// The exponent is the smallest odd e ⩾ 3 such that x ↦ xᵉ is a permutation, that is gcd(e, q-1) = 1,
+// and the number of rounds is ⌈log_e(q)⌉.
+func NewFieldConfig(ff, fieldPackagePath string, q *big.Int) (Config, error) {
+	var qMinusOne, gcd big.Int
+	qMinusOne.Sub(q, big.NewInt(1))
+	for e := uint64(3); e < 256; e += 2 {
+		if gcd.GCD(nil, nil, &qMinusOne, new(big.Int).SetUint64(e)).IsUint64() && gcd.Uint64() == 1 {
+			nbRounds := int(math.Ceil(float64(q.BitLen()) / math.Log2(float64(e))))
+			return newConfig(ff, fieldPackagePath, nbRounds, e), nil
+		}
+	}
+	return Config{}, errors.New("no small exponent e such that x ↦ xᵉ is a permutation of the field")
+}
+
+func newConfig(ff, fieldPackagePath string, nbRounds int, exponent uint64) Config {
+	conf := Config{
+		FF:               ff,
+		FieldPackagePath: fieldPackagePath,
+		NbRounds:         nbRounds,
+		Exponent:         exponent,
+	}
+	for i := bits.Len64(exponent) - 2; i >= 0; i-- {
+		conf.ExponentBits = append(conf.ExponentBits, (exponent>>uint(i))&1 == 1)
+	}
+	return conf
+}
+
+// Generate generates the mimc package in baseDir, from the templates of ./crypto/hash/mimc/template
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+	return GenerateFromDir(conf, baseDir, "./crypto/hash/mimc/template", bgen)
+}
+
+// GenerateFromDir generates the mimc package in baseDir, from the templates of templateDir
+func GenerateFromDir(conf Config, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
 
 	conf.Package = "mimc"
 	entries := []bavard.Entry{
@@ -18,6 +98,6 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	os.Remove(filepath.Join(baseDir, "utils.go"))
 	os.Remove(filepath.Join(baseDir, "utils_test.go"))
 
-	return bgen.Generate(conf, conf.Package, "./crypto/hash/mimc/template", entries...)
+	return bgen.Generate(conf, conf.Package, templateDir, entries...)
 
 }
@@ -1,6 +1,7 @@
 package ecc
 
 import (
+	"embed"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,6 +14,11 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// Templates holds the templates of the package, for the generators that run outside of internal/generator
+//
+//go:embed template
+var Templates embed.FS
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
@@ -30,6 +36,7 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			{File: filepath.Join(baseDir, fmt.Sprintf("hash_to_%s_test.go", point.PointName)), Templates: []string{"tests/hash_to_curve.go.tmpl"}}}
 
 		hashConf := suite.GetInfo(conf.Fp, point, conf.Name)
+		hashConf.FpImport = conf.FpImport()
 
 		funcs := make(template.FuncMap)
 		funcs["asElement"] = hashConf.Field.Base.WriteElement
@@ -68,7 +75,9 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "multiexp.go"), Templates: []string{"multiexp.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multiexp_affine.go"), Templates: []string{"multiexp_affine.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multiexp_jacobian.go"), Templates: []string{"multiexp_jacobian.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_precomputed.go"), Templates: []string{"multiexp_precomputed.go.tmpl"}},
 		{File: filepath.Join(baseDir, "multiexp_test.go"), Templates: []string{"tests/multiexp.go.tmpl"}},
+		{File: filepath.Join(baseDir, "multiexp_precomputed_test.go"), Templates: []string{"tests/multiexp_precomputed.go.tmpl"}},
 	}
 	conf.Package = packageName
 	funcs := make(template.FuncMap)
@@ -173,7 +182,17 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return err
 	}
 
-	// No G2 for secp256k1
+	// runtime facade, only for the curves with an ecc.ID
+	if isImplemented(conf) {
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "facade.go"), Templates: []string{"facade.go.tmpl"}},
+		}
+		if err := bgen.Generate(conf, packageName, "./ecc/template", entries...); err != nil {
+			return err
+		}
+	}
+
+	// the marshal of secp256k1 is hand written
 	if conf.Equal(config.SECP256K1) {
 		return nil
 	}
@@ -189,6 +208,10 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return err
 	}
 
+	if !conf.HasG2() {
+		return nil
+	}
+
 	// G2
 	entries = []bavard.Entry{
 		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
@@ -203,6 +226,15 @@ type pconf struct {
 	config.Point
 }
 
+func isImplemented(conf config.Curve) bool {
+	for _, c := range config.Curves {
+		if c.Equal(conf) {
+			return true
+		}
+	}
+	return false
+}
+
 func contains(slice []int, v int) bool {
 	for i := 0; i < len(slice); i++ {
 		if slice[i] == v {
@@ -1,6 +1,7 @@
 package fft
 
 import (
+	"embed"
 	"math/bits"
 	"path/filepath"
 
@@ -8,7 +9,37 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+// Templates holds the templates of the package, for the generators that run outside of internal/generator
+//
+//go:embed template
+var Templates embed.FS
+
+// Config describes the field over which the fft package is generated
+type Config struct {
+	Package          string // name of the generated package
+	FF               string // name of the field package
+	FieldPackagePath string // import path of the field package
+	CurvePackagePath string // import path of the curve package, if any, whose Encoder serializes the Domain
+	config.FFT
+}
+
+// NewConfig returns the fft Config of the scalar field of the curve
+func NewConfig(conf config.Curve) Config {
+	return Config{
+		FF:               "fr",
+		FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
+		CurvePackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name,
+		FFT:              *conf.FFT,
+	}
+}
+
+// Generate generates the fft package in baseDir, from the templates of ./fft/template
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+	return GenerateFromDir(conf, baseDir, "./fft/template/", bgen)
+}
+
+// GenerateFromDir generates the fft package in baseDir, from the templates of templateDir
+func GenerateFromDir(conf Config, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
 
 	conf.Package = "fft"
 
@@ -23,6 +54,34 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
 	}
 
+	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(templateFuncs())}
+
+	if err := bgen.GenerateWithOptions(conf, conf.Package, templateDir, bavardOpts, entries...); err != nil {
+		return err
+	}
+
+	// put the generator in the parent dir (the field package)
+	fieldDir := filepath.Dir(baseDir)
+	entries = []bavard.Entry{
+		{File: filepath.Join(fieldDir, "generator.go"), Templates: []string{"fr.generator.go.tmpl"}},
+	}
+	return bgen.GenerateWithOptions(conf, conf.FF, templateDir, bavardOpts, entries...)
+}
+
+// GenerateBitReverse generates the bit-reversal permutation of the fft package only, for the fields
+// without a large 2-adic subgroup, whose Fourier transforms are implemented next to it.
+func GenerateBitReverse(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+	conf.Package = "fft"
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "bitreverse_test.go"), Templates: []string{"tests/bitreverse.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "bitreverse.go"), Templates: []string{"bitreverse.go.tmpl", "imports.go.tmpl"}},
+	}
+	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(templateFuncs())}
+	return bgen.GenerateWithOptions(conf, conf.Package, "./fft/template/", bavardOpts, entries...)
+}
+
+func templateFuncs() map[string]interface{} {
 	funcs := make(map[string]interface{})
 	funcs["bitReverse"] = func(n, i int64) uint64 {
 		nn := uint64(64 - bits.TrailingZeros64(uint64(n)))
@@ -47,19 +106,7 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	funcs["logicalOr"] = func(x, y any) uint64 {
 		return anyToUint64(x) | anyToUint64(y)
 	}
-
-	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-
-	if err := bgen.GenerateWithOptions(conf, conf.Package, "./fft/template/", bavardOpts, entries...); err != nil {
-		return err
-	}
-
-	// put the generator in the parent dir (fr)
-	frDir := filepath.Dir(baseDir)
-	entries = []bavard.Entry{
-		{File: filepath.Join(frDir, "generator.go"), Templates: []string{"fr.generator.go.tmpl"}},
-	}
-	return bgen.GenerateWithOptions(conf, "fr", "./fft/template/", bavardOpts, entries...)
+	return funcs
 }
 
 func anyToUint64(x any) uint64 {
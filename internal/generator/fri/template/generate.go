@@ -1,13 +1,40 @@
 package fri
 
 import (
+	"embed"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+// Templates holds the templates of the package, for the generators that run outside of internal/generator
+//
+//go:embed *.tmpl
+var Templates embed.FS
+
+// Config describes the field over which the fri package is generated
+type Config struct {
+	Package          string // name of the generated package
+	FF               string // name of the field package
+	FieldPackagePath string // import path of the field package, whose fft sub-package is used
+}
+
+// NewConfig returns the fri Config of the scalar field of the curve
+func NewConfig(conf config.Curve) Config {
+	return Config{
+		FF:               "fr",
+		FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
+	}
+}
+
+// Generate generates the fri package in baseDir, from the templates of ./fri/template
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+	return GenerateFromDir(conf, baseDir, "./fri/template/", bgen)
+}
+
+// GenerateFromDir generates the fri package in baseDir, from the templates of templateDir
+func GenerateFromDir(conf Config, baseDir, templateDir string, bgen *bavard.BatchGenerator) error {
 
 	// fri commitment scheme
 	conf.Package = "fri"
@@ -16,6 +43,6 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fri_test.go"), Templates: []string{"fri.test.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./fri/template/", entries...)
+	return bgen.Generate(conf, conf.Package, templateDir, entries...)
 
 }
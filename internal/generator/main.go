@@ -57,17 +57,32 @@ func main() {
 			// generate base field
 			conf.Fp, err = field.NewFieldConfig("fp", "Element", conf.FpModulus, true)
 			assertNoError(err)
+			if conf.FpSpecialReduction {
+				assertNoError(conf.Fp.EnableSpecialReduction())
+			}
 
 			conf.Fr, err = field.NewFieldConfig("fr", "Element", conf.FrModulus, !conf.Equal(config.STARK_CURVE))
 			assertNoError(err)
 
-			conf.FpUnusedBits = 64 - (conf.Fp.NbBits % 64)
+			conf.FpUnusedBits = (64 - conf.Fp.NbBits%64) % 64
+
+			if !conf.OwnFields() {
+				// the fields are generated with the curve they belong to;
+				// generate G1, multiExp, hash to curve and marshal only
+				assertNoError(ecc.Generate(conf, curveDir, bgen))
+				if conf.Equal(config.SECQ256K1) {
+					assertNoError(ecdsa.Generate(conf, curveDir, bgen))
+				}
+				return
+			}
 
 			assertNoError(generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr")))
 			assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp")))
 
 			// generate ecdsa
-			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
+			if !conf.Equal(config.PALLAS) {
+				assertNoError(ecdsa.Generate(conf, curveDir, bgen))
+			}
 
 			if conf.Equal(config.STARK_CURVE) {
 				return // TODO @yelhousni
@@ -76,7 +91,7 @@ func main() {
 			// generate G1, G2, multiExp, ...
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
-			if conf.Equal(config.SECP256K1) {
+			if !conf.HasG2() {
 				return
 			}
 
@@ -87,10 +102,10 @@ func main() {
 			assertNoError(pairing.Generate(conf, curveDir, bgen))
 
 			// generate fri on fr
-			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
+			assertNoError(fri.Generate(fri.NewConfig(conf), filepath.Join(curveDir, "fr", "fri"), bgen))
 
 			// generate fft on fr
-			assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
+			assertNoError(fft.Generate(fft.NewConfig(conf), filepath.Join(curveDir, "fr", "fft"), bgen))
 
 			if conf.Equal(config.BN254) || conf.Equal(config.BLS12_377) {
 				assertNoError(sis.Generate(conf, filepath.Join(curveDir, "fr", "sis"), bgen))
@@ -109,7 +124,7 @@ func main() {
 			assertNoError(permutation.Generate(conf, filepath.Join(curveDir, "fr", "permutation"), bgen))
 
 			// generate mimc on fr
-			assertNoError(mimc.Generate(conf, filepath.Join(curveDir, "fr", "mimc"), bgen))
+			assertNoError(mimc.Generate(mimc.NewConfig(conf), filepath.Join(curveDir, "fr", "mimc"), bgen))
 
 			frInfo := config.FieldDependency{
 				FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/" + conf.Name + "/fr",
@@ -121,7 +136,7 @@ func main() {
 			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
 
 			// generate eddsa on companion curves
-			assertNoError(fri.Generate(conf, filepath.Join(curveDir, "fr", "fri"), bgen))
+			assertNoError(fri.Generate(fri.NewConfig(conf), filepath.Join(curveDir, "fr", "fri"), bgen))
 
 			// generate sumcheck on fr
 			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), bgen))
@@ -172,6 +187,67 @@ func main() {
 
 	}
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// generate the fields of curve25519; the twisted Edwards and Montgomery arithmetic,
+		// Ed25519 and X25519 are hand-written in ecc/curve25519
+		curveDir := filepath.Join(baseDir, "ecc", "curve25519")
+
+		fp, err := field.NewFieldConfig("fp", "Element", "57896044618658097711785492504343953926634992332820282019728792003956564819949", true) // 2²⁵⁵ - 19
+		assertNoError(err)
+		assertNoError(fp.EnableSpecialReduction())
+		assertNoError(generator.GenerateFF(fp, filepath.Join(curveDir, "fp")))
+
+		fr, err := field.NewFieldConfig("fr", "Element", "7237005577332262213973186563042994240857116359379907606001950938285454250989", true) // 2²⁵² + 27742317777372353535851937790883648493
+		assertNoError(err)
+		assertNoError(generator.GenerateFF(fr, filepath.Join(curveDir, "fr")))
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// generate fft on goldilocks; the field itself is generated in field/goldilocks/internal
+		assertNoError(fft.Generate(fft.Config{
+			FF:               "goldilocks",
+			FieldPackagePath: "github.com/consensys/gnark-crypto/field/goldilocks",
+			FFT: config.FFT{
+				GeneratorFullMultiplicativeGroup: 7,
+				GeneratorMaxTwoAdicSubgroup:      "1753635133440165772",
+				LogTwoOrderMaxTwoAdicSubgroup:    32,
+			},
+		}, filepath.Join(baseDir, "field", "goldilocks", "fft"), bgen))
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// generate fft on babybear and koalabear; the fields are generated in field/{babybear,koalabear}/internal.
+		assertNoError(fft.Generate(fft.Config{
+			FF:               "babybear",
+			FieldPackagePath: "github.com/consensys/gnark-crypto/field/babybear",
+			FFT: config.FFT{
+				GeneratorFullMultiplicativeGroup: 31,
+				GeneratorMaxTwoAdicSubgroup:      "440564289",
+				LogTwoOrderMaxTwoAdicSubgroup:    27,
+			},
+		}, filepath.Join(baseDir, "field", "babybear", "fft"), bgen))
+		assertNoError(fft.Generate(fft.Config{
+			FF:               "koalabear",
+			FieldPackagePath: "github.com/consensys/gnark-crypto/field/koalabear",
+			FFT: config.FFT{
+				GeneratorFullMultiplicativeGroup: 3,
+				GeneratorMaxTwoAdicSubgroup:      "1791270792",
+				LogTwoOrderMaxTwoAdicSubgroup:    24,
+			},
+		}, filepath.Join(baseDir, "field", "koalabear", "fft"), bgen))
+		// mersenne31 has a 2-adicity of 1: its fft package implements the circle FFT, and only reuses the bit reversal.
+		assertNoError(fft.GenerateBitReverse(fft.Config{
+			FF:               "mersenne31",
+			FieldPackagePath: "github.com/consensys/gnark-crypto/field/mersenne31",
+		}, filepath.Join(baseDir, "field", "mersenne31", "fft"), bgen))
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -1,6 +1,7 @@
 package pairing
 
 import (
+	"embed"
 	"path/filepath"
 	"strings"
 
@@ -8,6 +9,11 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// Templates holds the templates of the package, for the generators that run outside of internal/generator
+//
+//go:embed template
+var Templates embed.FS
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
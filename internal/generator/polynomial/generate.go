@@ -1,13 +1,25 @@
 package polynomial
 
 import (
+	"embed"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// Templates holds the templates of the package, for the generators that run outside of internal/generator
+//
+//go:embed template
+var Templates embed.FS
+
+// Generate generates the polynomial package in baseDir, from the templates of ./polynomial/template
 func Generate(conf config.FieldDependency, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
+	return GenerateFromDir(conf, baseDir, "./polynomial/template/", generateTests, bgen)
+}
+
+// GenerateFromDir generates the polynomial package in baseDir, from the templates of templateDir
+func GenerateFromDir(conf config.FieldDependency, baseDir, templateDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
 
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
@@ -23,5 +35,5 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, b
 		)
 	}
 
-	return bgen.Generate(conf, "polynomial", "./polynomial/template/", entries...)
+	return bgen.Generate(conf, "polynomial", templateDir, entries...)
 }
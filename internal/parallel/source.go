@@ -0,0 +1,9 @@
+package parallel
+
+import _ "embed"
+
+// Source is the source code of the package, for the generators whose output lives outside of
+// gnark-crypto and can't import an internal package
+//
+//go:embed execute.go
+var Source []byte
@@ -29,7 +29,9 @@ import (
 	ecdsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/ecdsa"
 	ecdsa_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/ecdsa"
 	ecdsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/ecdsa"
+	ecdsa_p256 "github.com/consensys/gnark-crypto/ecc/p256/ecdsa"
 	ecdsa_secp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/ecdsa"
+	ecdsa_secq256k1 "github.com/consensys/gnark-crypto/ecc/secq256k1/ecdsa"
 	ecdsa_starkcurve "github.com/consensys/gnark-crypto/ecc/stark-curve/ecdsa"
 	"github.com/consensys/gnark-crypto/signature"
 )
@@ -57,6 +59,10 @@ func New(ss ecc.ID, r io.Reader) (signature.Signer, error) {
 		return ecdsa_bw6633.GenerateKey(r)
 	case ecc.SECP256K1:
 		return ecdsa_secp256k1.GenerateKey(r)
+	case ecc.SECQ256K1:
+		return ecdsa_secq256k1.GenerateKey(r)
+	case ecc.P256:
+		return ecdsa_p256.GenerateKey(r)
 	case ecc.STARK_CURVE:
 		return ecdsa_starkcurve.GenerateKey(r)
 	default:
@@ -0,0 +1,57 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecdsa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+// TestNew checks that New is wired for every curve with an ecc/<curve>/ecdsa
+// package.
+func TestNew(t *testing.T) {
+	for _, id := range ecc.Implemented() {
+		dir := filepath.Join("..", "..", "ecc", strings.ReplaceAll(id.String(), "_", "-"), "ecdsa")
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		t.Run(id.String(), func(t *testing.T) {
+			signer, err := New(id, rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg := []byte("testing ECDSA")
+			sig, err := signer.Sign(msg, sha256.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			ok, err := signer.Public().Verify(sig, msg, sha256.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				t.Fatal("signature verification failed")
+			}
+		})
+	}
+}